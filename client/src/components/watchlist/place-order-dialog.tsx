@@ -240,7 +240,7 @@ export function PlaceOrderDialog({
 
     const payload: Omit<PlaceOrderPayload, "angel_one_jwt"> = {
       // Omit angel_one_jwt as it's added by API service
-      variety: "NORMAL", // For standard and SL orders
+      variety: formState.activeTab === "stoploss" ? "STOPLOSS" : "NORMAL", // SL orders need the STOPLOSS variety
       tradingsymbol:
         symbolToUse.tradingSymbol || `${symbolToUse.symbolToken}-EQ`, // Fallback if not provided
       symboltoken: symbolToUse.symbolToken,
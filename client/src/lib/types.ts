@@ -153,7 +153,7 @@ export interface OrderDialogSymbolData {
 
 // Fields for the PlaceOrder API call (matching broker.proto PlaceOrderRequest)
 export interface PlaceOrderPayload {
-  variety: string; // "NORMAL"
+  variety: string; // "NORMAL", or "STOPLOSS" for SL orders
   tradingsymbol: string; // e.g., "SBIN-EQ" - this needs to be derived or passed
   symboltoken: string;
   transactiontype: "BUY" | "SELL";
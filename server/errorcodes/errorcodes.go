@@ -0,0 +1,16 @@
+// Package errorcodes lists Angel One errorcodes that both the API gateway and
+// the broker service act on. Broker responses carry them unchanged in their
+// errorcode field.
+package errorcodes
+
+// Angel One's errorcodes for a JWT it no longer accepts.
+const (
+	InvalidToken = "AG8001"
+	TokenExpired = "AG8002"
+)
+
+// IsSessionError reports whether errorcode means Angel One no longer accepts
+// the JWT, so the session has to be refreshed.
+func IsSessionError(errorcode string) bool {
+	return errorcode == InvalidToken || errorcode == TokenExpired
+}
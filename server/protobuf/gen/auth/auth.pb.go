@@ -221,6 +221,126 @@ func (x *LogoutResponse) GetMessage() string {
 	return ""
 }
 
+type RefreshRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserToken     string                 `protobuf:"bytes,1,opt,name=user_token,proto3" json:"user_token,omitempty"`
+	JwtToken      string                 `protobuf:"bytes,2,opt,name=jwt_token,proto3" json:"jwt_token,omitempty"`
+	FeedToken     string                 `protobuf:"bytes,3,opt,name=feed_token,proto3" json:"feed_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,4,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
+	mi := &file_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRequest) ProtoMessage() {}
+
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RefreshRequest) GetUserToken() string {
+	if x != nil {
+		return x.UserToken
+	}
+	return ""
+}
+
+func (x *RefreshRequest) GetJwtToken() string {
+	if x != nil {
+		return x.JwtToken
+	}
+	return ""
+}
+
+func (x *RefreshRequest) GetFeedToken() string {
+	if x != nil {
+		return x.FeedToken
+	}
+	return ""
+}
+
+func (x *RefreshRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshResponse) Reset() {
+	*x = RefreshResponse{}
+	mi := &file_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshResponse) ProtoMessage() {}
+
+func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
+func (*RefreshResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefreshResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RefreshResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type VerifyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -231,7 +351,7 @@ type VerifyResponse struct {
 
 func (x *VerifyResponse) Reset() {
 	*x = VerifyResponse{}
-	mi := &file_auth_proto_msgTypes[4]
+	mi := &file_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -243,7 +363,7 @@ func (x *VerifyResponse) String() string {
 func (*VerifyResponse) ProtoMessage() {}
 
 func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[4]
+	mi := &file_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +376,7 @@ func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyResponse.ProtoReflect.Descriptor instead.
 func (*VerifyResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{4}
+	return file_auth_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *VerifyResponse) GetSuccess() bool {
@@ -295,14 +415,27 @@ const file_auth_proto_rawDesc = "" +
 	"user_token\"D\n" +
 	"\x0eLogoutResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x94\x01\n" +
+	"\x0eRefreshRequest\x12\x1e\n" +
+	"\n" +
+	"user_token\x18\x01 \x01(\tR\n" +
+	"user_token\x12\x1c\n" +
+	"\tjwt_token\x18\x02 \x01(\tR\tjwt_token\x12\x1e\n" +
+	"\n" +
+	"feed_token\x18\x03 \x01(\tR\n" +
+	"feed_token\x12$\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\rrefresh_token\"E\n" +
+	"\x0fRefreshResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"B\n" +
 	"\x0eVerifyResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
-	"\x06tokens\x18\x03 \x03(\tR\x06tokens2\xb5\x01\n" +
+	"\x06tokens\x18\x03 \x03(\tR\x06tokens2\xed\x01\n" +
 	"\x04Auth\x129\n" +
 	"\bGenerate\x12\x15.auth.GenerateRequest\x1a\x16.auth.GenerateResponse\x128\n" +
 	"\x06Verify\x12\x18.auth.TokenActionRequest\x1a\x14.auth.VerifyResponse\x128\n" +
-	"\x06Logout\x12\x18.auth.TokenActionRequest\x1a\x14.auth.LogoutResponseB1Z/github.com/Sagar-v4/Angel-Two/protobuf/gen/authb\x06proto3"
+	"\x06Logout\x12\x18.auth.TokenActionRequest\x1a\x14.auth.LogoutResponse\x126\n" +
+	"\aRefresh\x12\x14.auth.RefreshRequest\x1a\x15.auth.RefreshResponseB1Z/github.com/Sagar-v4/Angel-Two/protobuf/gen/authb\x06proto3"
 
 var (
 	file_auth_proto_rawDescOnce sync.Once
@@ -316,23 +449,27 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_auth_proto_goTypes = []any{
 	(*GenerateRequest)(nil),    // 0: auth.GenerateRequest
 	(*GenerateResponse)(nil),   // 1: auth.GenerateResponse
 	(*TokenActionRequest)(nil), // 2: auth.TokenActionRequest
 	(*LogoutResponse)(nil),     // 3: auth.LogoutResponse
-	(*VerifyResponse)(nil),     // 4: auth.VerifyResponse
+	(*RefreshRequest)(nil),     // 4: auth.RefreshRequest
+	(*RefreshResponse)(nil),    // 5: auth.RefreshResponse
+	(*VerifyResponse)(nil),     // 6: auth.VerifyResponse
 }
 var file_auth_proto_depIdxs = []int32{
 	0, // 0: auth.Auth.Generate:input_type -> auth.GenerateRequest
 	2, // 1: auth.Auth.Verify:input_type -> auth.TokenActionRequest
 	2, // 2: auth.Auth.Logout:input_type -> auth.TokenActionRequest
-	1, // 3: auth.Auth.Generate:output_type -> auth.GenerateResponse
-	4, // 4: auth.Auth.Verify:output_type -> auth.VerifyResponse
-	3, // 5: auth.Auth.Logout:output_type -> auth.LogoutResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	4, // 3: auth.Auth.Refresh:input_type -> auth.RefreshRequest
+	1, // 4: auth.Auth.Generate:output_type -> auth.GenerateResponse
+	6, // 5: auth.Auth.Verify:output_type -> auth.VerifyResponse
+	3, // 6: auth.Auth.Logout:output_type -> auth.LogoutResponse
+	5, // 7: auth.Auth.Refresh:output_type -> auth.RefreshResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -349,7 +486,7 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,490 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// --- Order Updates (order-status WebSocket) ---
+type OrderEventType int32
+
+const (
+	OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED OrderEventType = 0
+	OrderEventType_PLACED                       OrderEventType = 1 // Accepted by Angel One, not yet open at the exchange
+	OrderEventType_OPEN                         OrderEventType = 2 // Open at the exchange (including trigger pending and modified orders)
+	OrderEventType_PARTIALLY_FILLED             OrderEventType = 3 // Open with some quantity filled
+	OrderEventType_COMPLETE                     OrderEventType = 4
+	OrderEventType_REJECTED                     OrderEventType = 5
+	OrderEventType_CANCELLED                    OrderEventType = 6
+)
+
+// Enum value maps for OrderEventType.
+var (
+	OrderEventType_name = map[int32]string{
+		0: "ORDER_EVENT_TYPE_UNSPECIFIED",
+		1: "PLACED",
+		2: "OPEN",
+		3: "PARTIALLY_FILLED",
+		4: "COMPLETE",
+		5: "REJECTED",
+		6: "CANCELLED",
+	}
+	OrderEventType_value = map[string]int32{
+		"ORDER_EVENT_TYPE_UNSPECIFIED": 0,
+		"PLACED":                       1,
+		"OPEN":                         2,
+		"PARTIALLY_FILLED":             3,
+		"COMPLETE":                     4,
+		"REJECTED":                     5,
+		"CANCELLED":                    6,
+	}
+)
+
+func (x OrderEventType) Enum() *OrderEventType {
+	p := new(OrderEventType)
+	*p = x
+	return p
+}
+
+func (x OrderEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_broker_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderEventType) Type() protoreflect.EnumType {
+	return &file_broker_proto_enumTypes[0]
+}
+
+func (x OrderEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderEventType.Descriptor instead.
+func (OrderEventType) EnumDescriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{0}
+}
+
+// --- Conditional order rules (evaluated by the broker service) ---
+// Unlike GTT rules these never reach Angel One until they fire, so they have
+// no broker-side limits. A rule fires once, when all of its conditions hold.
+type AlertConditionType int32
+
+const (
+	AlertConditionType_ALERT_CONDITION_TYPE_UNSPECIFIED AlertConditionType = 0
+	AlertConditionType_PRICE_ABOVE                      AlertConditionType = 1 // LTP >= value
+	AlertConditionType_PRICE_BELOW                      AlertConditionType = 2 // LTP <= value
+	AlertConditionType_PERCENT_CHANGE_ABOVE             AlertConditionType = 3 // Change from the reference price >= value percent
+	AlertConditionType_PERCENT_CHANGE_BELOW             AlertConditionType = 4 // Change from the reference price <= value percent (e.g. -2)
+	AlertConditionType_TIME_AFTER                       AlertConditionType = 5 // IST time of day >= time
+	AlertConditionType_TIME_BEFORE                      AlertConditionType = 6 // IST time of day < time
+)
+
+// Enum value maps for AlertConditionType.
+var (
+	AlertConditionType_name = map[int32]string{
+		0: "ALERT_CONDITION_TYPE_UNSPECIFIED",
+		1: "PRICE_ABOVE",
+		2: "PRICE_BELOW",
+		3: "PERCENT_CHANGE_ABOVE",
+		4: "PERCENT_CHANGE_BELOW",
+		5: "TIME_AFTER",
+		6: "TIME_BEFORE",
+	}
+	AlertConditionType_value = map[string]int32{
+		"ALERT_CONDITION_TYPE_UNSPECIFIED": 0,
+		"PRICE_ABOVE":                      1,
+		"PRICE_BELOW":                      2,
+		"PERCENT_CHANGE_ABOVE":             3,
+		"PERCENT_CHANGE_BELOW":             4,
+		"TIME_AFTER":                       5,
+		"TIME_BEFORE":                      6,
+	}
+)
+
+func (x AlertConditionType) Enum() *AlertConditionType {
+	p := new(AlertConditionType)
+	*p = x
+	return p
+}
+
+func (x AlertConditionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertConditionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_broker_proto_enumTypes[1].Descriptor()
+}
+
+func (AlertConditionType) Type() protoreflect.EnumType {
+	return &file_broker_proto_enumTypes[1]
+}
+
+func (x AlertConditionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertConditionType.Descriptor instead.
+func (AlertConditionType) EnumDescriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{1}
+}
+
+type AlertRuleState int32
+
+const (
+	AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED AlertRuleState = 0
+	AlertRuleState_RULE_ACTIVE                  AlertRuleState = 1
+	AlertRuleState_RULE_FIRING                  AlertRuleState = 2 // Order sent, outcome not yet known
+	AlertRuleState_RULE_FIRED                   AlertRuleState = 3 // Order placed; see orderid
+	AlertRuleState_RULE_FAILED                  AlertRuleState = 4 // Order rejected or not confirmed; see message
+	AlertRuleState_RULE_CANCELLED               AlertRuleState = 5
+)
+
+// Enum value maps for AlertRuleState.
+var (
+	AlertRuleState_name = map[int32]string{
+		0: "ALERT_RULE_STATE_UNSPECIFIED",
+		1: "RULE_ACTIVE",
+		2: "RULE_FIRING",
+		3: "RULE_FIRED",
+		4: "RULE_FAILED",
+		5: "RULE_CANCELLED",
+	}
+	AlertRuleState_value = map[string]int32{
+		"ALERT_RULE_STATE_UNSPECIFIED": 0,
+		"RULE_ACTIVE":                  1,
+		"RULE_FIRING":                  2,
+		"RULE_FIRED":                   3,
+		"RULE_FAILED":                  4,
+		"RULE_CANCELLED":               5,
+	}
+)
+
+func (x AlertRuleState) Enum() *AlertRuleState {
+	p := new(AlertRuleState)
+	*p = x
+	return p
+}
+
+func (x AlertRuleState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertRuleState) Descriptor() protoreflect.EnumDescriptor {
+	return file_broker_proto_enumTypes[2].Descriptor()
+}
+
+func (AlertRuleState) Type() protoreflect.EnumType {
+	return &file_broker_proto_enumTypes[2]
+}
+
+func (x AlertRuleState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertRuleState.Descriptor instead.
+func (AlertRuleState) EnumDescriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{2}
+}
+
+// --- Price alerts (notifications only) ---
+type PriceAlertState int32
+
+const (
+	PriceAlertState_PRICE_ALERT_STATE_UNSPECIFIED PriceAlertState = 0
+	PriceAlertState_PRICE_ALERT_ACTIVE            PriceAlertState = 1
+	PriceAlertState_PRICE_ALERT_TRIGGERED         PriceAlertState = 2 // Notified; repeating alerts re-arm once the condition stops holding
+	PriceAlertState_PRICE_ALERT_PAUSED            PriceAlertState = 3
+)
+
+// Enum value maps for PriceAlertState.
+var (
+	PriceAlertState_name = map[int32]string{
+		0: "PRICE_ALERT_STATE_UNSPECIFIED",
+		1: "PRICE_ALERT_ACTIVE",
+		2: "PRICE_ALERT_TRIGGERED",
+		3: "PRICE_ALERT_PAUSED",
+	}
+	PriceAlertState_value = map[string]int32{
+		"PRICE_ALERT_STATE_UNSPECIFIED": 0,
+		"PRICE_ALERT_ACTIVE":            1,
+		"PRICE_ALERT_TRIGGERED":         2,
+		"PRICE_ALERT_PAUSED":            3,
+	}
+)
+
+func (x PriceAlertState) Enum() *PriceAlertState {
+	p := new(PriceAlertState)
+	*p = x
+	return p
+}
+
+func (x PriceAlertState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceAlertState) Descriptor() protoreflect.EnumDescriptor {
+	return file_broker_proto_enumTypes[3].Descriptor()
+}
+
+func (PriceAlertState) Type() protoreflect.EnumType {
+	return &file_broker_proto_enumTypes[3]
+}
+
+func (x PriceAlertState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceAlertState.Descriptor instead.
+func (PriceAlertState) EnumDescriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{3}
+}
+
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED DeliveryStatus = 0
+	DeliveryStatus_DELIVERY_PENDING            DeliveryStatus = 1
+	DeliveryStatus_DELIVERY_DELIVERED          DeliveryStatus = 2
+	DeliveryStatus_DELIVERY_FAILED             DeliveryStatus = 3 // Gave up after the last retry
+)
+
+// Enum value maps for DeliveryStatus.
+var (
+	DeliveryStatus_name = map[int32]string{
+		0: "DELIVERY_STATUS_UNSPECIFIED",
+		1: "DELIVERY_PENDING",
+		2: "DELIVERY_DELIVERED",
+		3: "DELIVERY_FAILED",
+	}
+	DeliveryStatus_value = map[string]int32{
+		"DELIVERY_STATUS_UNSPECIFIED": 0,
+		"DELIVERY_PENDING":            1,
+		"DELIVERY_DELIVERED":          2,
+		"DELIVERY_FAILED":             3,
+	}
+)
+
+func (x DeliveryStatus) Enum() *DeliveryStatus {
+	p := new(DeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x DeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_broker_proto_enumTypes[4].Descriptor()
+}
+
+func (DeliveryStatus) Type() protoreflect.EnumType {
+	return &file_broker_proto_enumTypes[4]
+}
+
+func (x DeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryStatus.Descriptor instead.
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{4}
+}
+
+// --- Bracket (OCO) orders for delivery, emulated by the broker service ---
+// Only the stop-loss rests at the exchange. Angel One reserves the holdings
+// for the first SELL, so the target is emulated: the broker polls the LTP
+// (BRACKET_POLL_SECONDS, 10 by default) and only then cancels the stop-loss
+// and places a LIMIT SELL at the target. The target therefore does not fill
+// on a spike shorter than the polling interval, and while the swap is in
+// flight, and for up to three 30 second retries if the new order is rejected
+// for insufficient holdings, no exit rests at all: a gap down in that window
+// sells at the stop-loss late, or not at all.
+type BracketState int32
+
+const (
+	BracketState_BRACKET_STATE_UNSPECIFIED BracketState = 0
+	BracketState_BRACKET_ENTRY_PENDING     BracketState = 1 // Entry order sent, waiting for it to fill
+	BracketState_BRACKET_PLACING_EXITS     BracketState = 2 // Entry filled; the exit order being placed
+	BracketState_BRACKET_ACTIVE            BracketState = 3 // The stop-loss working, or the target once a polled LTP reached it
+	BracketState_BRACKET_CLOSING           BracketState = 4 // An exit executed or a cancel was requested; cancelling the rest
+	BracketState_BRACKET_COMPLETED         BracketState = 5 // An exit executed
+	BracketState_BRACKET_CANCELLED         BracketState = 6 // Cancelled, or the entry never filled
+	BracketState_BRACKET_FAILED            BracketState = 7 // Needs attention; see message
+)
+
+// Enum value maps for BracketState.
+var (
+	BracketState_name = map[int32]string{
+		0: "BRACKET_STATE_UNSPECIFIED",
+		1: "BRACKET_ENTRY_PENDING",
+		2: "BRACKET_PLACING_EXITS",
+		3: "BRACKET_ACTIVE",
+		4: "BRACKET_CLOSING",
+		5: "BRACKET_COMPLETED",
+		6: "BRACKET_CANCELLED",
+		7: "BRACKET_FAILED",
+	}
+	BracketState_value = map[string]int32{
+		"BRACKET_STATE_UNSPECIFIED": 0,
+		"BRACKET_ENTRY_PENDING":     1,
+		"BRACKET_PLACING_EXITS":     2,
+		"BRACKET_ACTIVE":            3,
+		"BRACKET_CLOSING":           4,
+		"BRACKET_COMPLETED":         5,
+		"BRACKET_CANCELLED":         6,
+		"BRACKET_FAILED":            7,
+	}
+)
+
+func (x BracketState) Enum() *BracketState {
+	p := new(BracketState)
+	*p = x
+	return p
+}
+
+func (x BracketState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BracketState) Descriptor() protoreflect.EnumDescriptor {
+	return file_broker_proto_enumTypes[5].Descriptor()
+}
+
+func (BracketState) Type() protoreflect.EnumType {
+	return &file_broker_proto_enumTypes[5]
+}
+
+func (x BracketState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BracketState.Descriptor instead.
+func (BracketState) EnumDescriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{5}
+}
+
+// --- Historical Candles ---
+// Value names match Angel One's interval strings.
+type CandleInterval int32
+
+const (
+	CandleInterval_CANDLE_INTERVAL_UNSPECIFIED CandleInterval = 0
+	CandleInterval_ONE_MINUTE                  CandleInterval = 1
+	CandleInterval_THREE_MINUTE                CandleInterval = 2
+	CandleInterval_FIVE_MINUTE                 CandleInterval = 3
+	CandleInterval_TEN_MINUTE                  CandleInterval = 4
+	CandleInterval_FIFTEEN_MINUTE              CandleInterval = 5
+	CandleInterval_THIRTY_MINUTE               CandleInterval = 6
+	CandleInterval_ONE_HOUR                    CandleInterval = 7
+	CandleInterval_ONE_DAY                     CandleInterval = 8
+)
+
+// Enum value maps for CandleInterval.
+var (
+	CandleInterval_name = map[int32]string{
+		0: "CANDLE_INTERVAL_UNSPECIFIED",
+		1: "ONE_MINUTE",
+		2: "THREE_MINUTE",
+		3: "FIVE_MINUTE",
+		4: "TEN_MINUTE",
+		5: "FIFTEEN_MINUTE",
+		6: "THIRTY_MINUTE",
+		7: "ONE_HOUR",
+		8: "ONE_DAY",
+	}
+	CandleInterval_value = map[string]int32{
+		"CANDLE_INTERVAL_UNSPECIFIED": 0,
+		"ONE_MINUTE":                  1,
+		"THREE_MINUTE":                2,
+		"FIVE_MINUTE":                 3,
+		"TEN_MINUTE":                  4,
+		"FIFTEEN_MINUTE":              5,
+		"THIRTY_MINUTE":               6,
+		"ONE_HOUR":                    7,
+		"ONE_DAY":                     8,
+	}
+)
+
+func (x CandleInterval) Enum() *CandleInterval {
+	p := new(CandleInterval)
+	*p = x
+	return p
+}
+
+func (x CandleInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CandleInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_broker_proto_enumTypes[6].Descriptor()
+}
+
+func (CandleInterval) Type() protoreflect.EnumType {
+	return &file_broker_proto_enumTypes[6]
+}
+
+func (x CandleInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CandleInterval.Descriptor instead.
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{6}
+}
+
+// --- Tick Streaming (SmartWebSocket V2) ---
+type TickMode int32
+
+const (
+	TickMode_TICK_MODE_UNSPECIFIED TickMode = 0
+	TickMode_LTP                   TickMode = 1
+	TickMode_QUOTE                 TickMode = 2 // LTP plus OHLC, volume and traded quantities
+	TickMode_SNAP_QUOTE            TickMode = 3 // QUOTE plus best five depth, open interest and circuit limits
+)
+
+// Enum value maps for TickMode.
+var (
+	TickMode_name = map[int32]string{
+		0: "TICK_MODE_UNSPECIFIED",
+		1: "LTP",
+		2: "QUOTE",
+		3: "SNAP_QUOTE",
+	}
+	TickMode_value = map[string]int32{
+		"TICK_MODE_UNSPECIFIED": 0,
+		"LTP":                   1,
+		"QUOTE":                 2,
+		"SNAP_QUOTE":            3,
+	}
+)
+
+func (x TickMode) Enum() *TickMode {
+	p := new(TickMode)
+	*p = x
+	return p
+}
+
+func (x TickMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TickMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_broker_proto_enumTypes[7].Descriptor()
+}
+
+func (TickMode) Type() protoreflect.EnumType {
+	return &file_broker_proto_enumTypes[7]
+}
+
+func (x TickMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TickMode.Descriptor instead.
+func (TickMode) EnumDescriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{7}
+}
+
 // --- Profile Data ---
 type AngelOneProfileData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -265,18 +749,25 @@ type PlaceOrderRequest struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
 	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
 	// Angel One Order Params
-	Variety         string  `protobuf:"bytes,2,opt,name=variety,proto3" json:"variety,omitempty"`
-	Tradingsymbol   string  `protobuf:"bytes,3,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Variety         string  `protobuf:"bytes,2,opt,name=variety,proto3" json:"variety,omitempty"`             // NORMAL, STOPLOSS, AMO or ROBO
+	Tradingsymbol   string  `protobuf:"bytes,3,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"` // Either tradingsymbol or symboltoken is enough; the other is filled in
 	Symboltoken     string  `protobuf:"bytes,4,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
 	Transactiontype string  `protobuf:"bytes,5,opt,name=transactiontype,proto3" json:"transactiontype,omitempty"`
 	Exchange        string  `protobuf:"bytes,6,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Ordertype       string  `protobuf:"bytes,7,opt,name=ordertype,proto3" json:"ordertype,omitempty"`
-	Producttype     string  `protobuf:"bytes,8,opt,name=producttype,proto3" json:"producttype,omitempty"`
-	Duration        string  `protobuf:"bytes,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	Ordertype       string  `protobuf:"bytes,7,opt,name=ordertype,proto3" json:"ordertype,omitempty"`     // MARKET, LIMIT, STOPLOSS_LIMIT or STOPLOSS_MARKET
+	Producttype     string  `protobuf:"bytes,8,opt,name=producttype,proto3" json:"producttype,omitempty"` // DELIVERY, CARRYFORWARD, MARGIN, INTRADAY or BO
+	Duration        string  `protobuf:"bytes,9,opt,name=duration,proto3" json:"duration,omitempty"`       // DAY or IOC
 	Price           float64 `protobuf:"fixed64,10,opt,name=price,proto3" json:"price,omitempty"`
-	Squareoff       float64 `protobuf:"fixed64,11,opt,name=squareoff,proto3" json:"squareoff,omitempty"`
-	Stoploss        float64 `protobuf:"fixed64,12,opt,name=stoploss,proto3" json:"stoploss,omitempty"`
+	Squareoff       float64 `protobuf:"fixed64,11,opt,name=squareoff,proto3" json:"squareoff,omitempty"` // ROBO (bracket) orders only
+	Stoploss        float64 `protobuf:"fixed64,12,opt,name=stoploss,proto3" json:"stoploss,omitempty"`   // ROBO (bracket) orders only
 	Quantity        int32   `protobuf:"varint,13,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Optional params, required only for some variety/ordertype combinations
+	Triggerprice      float64 `protobuf:"fixed64,14,opt,name=triggerprice,proto3" json:"triggerprice,omitempty"`                 // Required for STOPLOSS_LIMIT and STOPLOSS_MARKET orders
+	Disclosedquantity int32   `protobuf:"varint,15,opt,name=disclosedquantity,proto3" json:"disclosedquantity,omitempty"`        // Iceberg-style quantity shown to the market, must not exceed quantity
+	Trailingstoploss  float64 `protobuf:"fixed64,16,opt,name=trailingstoploss,proto3" json:"trailingstoploss,omitempty"`         // ROBO (bracket) orders only
+	Ordertag          string  `protobuf:"bytes,17,opt,name=ordertag,proto3" json:"ordertag,omitempty"`                           // Free-form tag echoed back in the order book
+	Marketprotection  float64 `protobuf:"fixed64,18,opt,name=marketprotection,proto3" json:"marketprotection,omitempty"`         // Market protection percentage for MARKET orders
+	CheckMargin       bool    `protobuf:"varint,19,opt,name=check_margin,json=checkMargin,proto3" json:"check_margin,omitempty"` // Reject with FAILED_PRECONDITION if the required margin exceeds available funds
 	// Headers from API service if needed
 	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
 	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
@@ -406,6 +897,48 @@ func (x *PlaceOrderRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *PlaceOrderRequest) GetTriggerprice() float64 {
+	if x != nil {
+		return x.Triggerprice
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetDisclosedquantity() int32 {
+	if x != nil {
+		return x.Disclosedquantity
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetTrailingstoploss() float64 {
+	if x != nil {
+		return x.Trailingstoploss
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetOrdertag() string {
+	if x != nil {
+		return x.Ordertag
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetMarketprotection() float64 {
+	if x != nil {
+		return x.Marketprotection
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetCheckMargin() bool {
+	if x != nil {
+		return x.CheckMargin
+	}
+	return false
+}
+
 func (x *PlaceOrderRequest) GetClientLocalIp() string {
 	if x != nil {
 		return x.ClientLocalIp
@@ -430,7 +963,8 @@ func (x *PlaceOrderRequest) GetMacAddress() string {
 type PlaceOrderAngelData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Script        string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	Orderid       string                 `protobuf:"bytes,2,opt,name=orderid,proto3" json:"orderid,omitempty"` // Add other fields from Angel One's "data" object if present and needed
+	Orderid       string                 `protobuf:"bytes,2,opt,name=orderid,proto3" json:"orderid,omitempty"`
+	Uniqueorderid string                 `protobuf:"bytes,3,opt,name=uniqueorderid,proto3" json:"uniqueorderid,omitempty"` // Add other fields from Angel One's "data" object if present and needed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -479,6 +1013,13 @@ func (x *PlaceOrderAngelData) GetOrderid() string {
 	return ""
 }
 
+func (x *PlaceOrderAngelData) GetUniqueorderid() string {
+	if x != nil {
+		return x.Uniqueorderid
+	}
+	return ""
+}
+
 type PlaceOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -547,34 +1088,35 @@ func (x *PlaceOrderResponse) GetData() *PlaceOrderAngelData {
 	return nil
 }
 
-// --- Cancel Order ---
-type CancelOrderRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
-	Variety     string                 `protobuf:"bytes,2,opt,name=variety,proto3" json:"variety,omitempty"`
-	Orderid     string                 `protobuf:"bytes,3,opt,name=orderid,proto3" json:"orderid,omitempty"`
+// --- Basket Orders ---
+type PlaceBasketRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt       string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Legs              []*PlaceOrderRequest   `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`                                                       // Each leg's JWT, headers, check_margin and ordertag are replaced
+	RollbackOnFailure bool                   `protobuf:"varint,3,opt,name=rollback_on_failure,json=rollbackOnFailure,proto3" json:"rollback_on_failure,omitempty"` // Cancel the legs that were placed when any leg fails
+	MaxParallel       int32                  `protobuf:"varint,4,opt,name=max_parallel,json=maxParallel,proto3" json:"max_parallel,omitempty"`                     // Legs placed at once; defaults to 4
 	// Headers
-	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
-	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
-	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CancelOrderRequest) Reset() {
-	*x = CancelOrderRequest{}
+func (x *PlaceBasketRequest) Reset() {
+	*x = PlaceBasketRequest{}
 	mi := &file_broker_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderRequest) String() string {
+func (x *PlaceBasketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderRequest) ProtoMessage() {}
+func (*PlaceBasketRequest) ProtoMessage() {}
 
-func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *PlaceBasketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_broker_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -586,74 +1128,91 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
-func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlaceBasketRequest.ProtoReflect.Descriptor instead.
+func (*PlaceBasketRequest) Descriptor() ([]byte, []int) {
 	return file_broker_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CancelOrderRequest) GetAngelOneJwt() string {
+func (x *PlaceBasketRequest) GetAngelOneJwt() string {
 	if x != nil {
 		return x.AngelOneJwt
 	}
 	return ""
 }
 
-func (x *CancelOrderRequest) GetVariety() string {
+func (x *PlaceBasketRequest) GetLegs() []*PlaceOrderRequest {
 	if x != nil {
-		return x.Variety
+		return x.Legs
 	}
-	return ""
+	return nil
 }
 
-func (x *CancelOrderRequest) GetOrderid() string {
+func (x *PlaceBasketRequest) GetRollbackOnFailure() bool {
 	if x != nil {
-		return x.Orderid
+		return x.RollbackOnFailure
 	}
-	return ""
+	return false
 }
 
-func (x *CancelOrderRequest) GetClientLocalIp() string {
+func (x *PlaceBasketRequest) GetMaxParallel() int32 {
+	if x != nil {
+		return x.MaxParallel
+	}
+	return 0
+}
+
+func (x *PlaceBasketRequest) GetClientLocalIp() string {
 	if x != nil {
 		return x.ClientLocalIp
 	}
 	return ""
 }
 
-func (x *CancelOrderRequest) GetClientPublicIp() string {
+func (x *PlaceBasketRequest) GetClientPublicIp() string {
 	if x != nil {
 		return x.ClientPublicIp
 	}
 	return ""
 }
 
-func (x *CancelOrderRequest) GetMacAddress() string {
+func (x *PlaceBasketRequest) GetMacAddress() string {
 	if x != nil {
 		return x.MacAddress
 	}
 	return ""
 }
 
-type CancelOrderAngelData struct {
+type BasketLegResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orderid       string                 `protobuf:"bytes,1,opt,name=orderid,proto3" json:"orderid,omitempty"` // Add other fields if present
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Position of the leg in the request
+	Placed        bool                   `protobuf:"varint,2,opt,name=placed,proto3" json:"placed,omitempty"`
+	Orderid       string                 `protobuf:"bytes,3,opt,name=orderid,proto3" json:"orderid,omitempty"`
+	Uniqueorderid string                 `protobuf:"bytes,4,opt,name=uniqueorderid,proto3" json:"uniqueorderid,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"` // Angel One's message, or why the leg was not placed
+	Errorcode     string                 `protobuf:"bytes,6,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Skipped       bool                   `protobuf:"varint,7,opt,name=skipped,proto3" json:"skipped,omitempty"`                           // Not attempted because another leg had already failed
+	Cancelled     bool                   `protobuf:"varint,8,opt,name=cancelled,proto3" json:"cancelled,omitempty"`                       // Placed, then cancelled by the rollback
+	CancelError   string                 `protobuf:"bytes,9,opt,name=cancel_error,json=cancelError,proto3" json:"cancel_error,omitempty"` // Why the rollback could not cancel this leg
+	Ordertag      string                 `protobuf:"bytes,10,opt,name=ordertag,proto3" json:"ordertag,omitempty"`                         // The leg's ordertag, which finds it in the order book
+	Unconfirmed   bool                   `protobuf:"varint,11,opt,name=unconfirmed,proto3" json:"unconfirmed,omitempty"`                  // The placement reply was lost and the order book could not settle whether it was placed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelOrderAngelData) Reset() {
-	*x = CancelOrderAngelData{}
+func (x *BasketLegResult) Reset() {
+	*x = BasketLegResult{}
 	mi := &file_broker_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderAngelData) String() string {
+func (x *BasketLegResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderAngelData) ProtoMessage() {}
+func (*BasketLegResult) ProtoMessage() {}
 
-func (x *CancelOrderAngelData) ProtoReflect() protoreflect.Message {
+func (x *BasketLegResult) ProtoReflect() protoreflect.Message {
 	mi := &file_broker_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -665,43 +1224,8042 @@ func (x *CancelOrderAngelData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderAngelData.ProtoReflect.Descriptor instead.
-func (*CancelOrderAngelData) Descriptor() ([]byte, []int) {
+// Deprecated: Use BasketLegResult.ProtoReflect.Descriptor instead.
+func (*BasketLegResult) Descriptor() ([]byte, []int) {
 	return file_broker_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CancelOrderAngelData) GetOrderid() string {
+func (x *BasketLegResult) GetIndex() int32 {
 	if x != nil {
-		return x.Orderid
+		return x.Index
 	}
-	return ""
+	return 0
+}
+
+func (x *BasketLegResult) GetPlaced() bool {
+	if x != nil {
+		return x.Placed
+	}
+	return false
+}
+
+func (x *BasketLegResult) GetOrderid() string {
+	if x != nil {
+		return x.Orderid
+	}
+	return ""
+}
+
+func (x *BasketLegResult) GetUniqueorderid() string {
+	if x != nil {
+		return x.Uniqueorderid
+	}
+	return ""
+}
+
+func (x *BasketLegResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BasketLegResult) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *BasketLegResult) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *BasketLegResult) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *BasketLegResult) GetCancelError() string {
+	if x != nil {
+		return x.CancelError
+	}
+	return ""
+}
+
+func (x *BasketLegResult) GetOrdertag() string {
+	if x != nil {
+		return x.Ordertag
+	}
+	return ""
+}
+
+func (x *BasketLegResult) GetUnconfirmed() bool {
+	if x != nil {
+		return x.Unconfirmed
+	}
+	return false
+}
+
+type PlaceBasketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // True only when every leg was placed
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Legs          []*BasketLegResult     `protobuf:"bytes,4,rep,name=legs,proto3" json:"legs,omitempty"`
+	RolledBack    bool                   `protobuf:"varint,5,opt,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"` // A rollback was attempted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceBasketResponse) Reset() {
+	*x = PlaceBasketResponse{}
+	mi := &file_broker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceBasketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceBasketResponse) ProtoMessage() {}
+
+func (x *PlaceBasketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceBasketResponse.ProtoReflect.Descriptor instead.
+func (*PlaceBasketResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlaceBasketResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *PlaceBasketResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlaceBasketResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *PlaceBasketResponse) GetLegs() []*BasketLegResult {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *PlaceBasketResponse) GetRolledBack() bool {
+	if x != nil {
+		return x.RolledBack
+	}
+	return false
+}
+
+// --- Cancel Order ---
+type CancelOrderRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Variety     string                 `protobuf:"bytes,2,opt,name=variety,proto3" json:"variety,omitempty"`
+	Orderid     string                 `protobuf:"bytes,3,opt,name=orderid,proto3" json:"orderid,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_broker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelOrderRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetVariety() string {
+	if x != nil {
+		return x.Variety
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetOrderid() string {
+	if x != nil {
+		return x.Orderid
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type CancelOrderAngelData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orderid       string                 `protobuf:"bytes,1,opt,name=orderid,proto3" json:"orderid,omitempty"` // Add other fields if present
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderAngelData) Reset() {
+	*x = CancelOrderAngelData{}
+	mi := &file_broker_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderAngelData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderAngelData) ProtoMessage() {}
+
+func (x *CancelOrderAngelData) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderAngelData.ProtoReflect.Descriptor instead.
+func (*CancelOrderAngelData) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelOrderAngelData) GetOrderid() string {
+	if x != nil {
+		return x.Orderid
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *CancelOrderAngelData  `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_broker_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelOrderResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *CancelOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelOrderResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *CancelOrderResponse) GetData() *CancelOrderAngelData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// --- Modify Order ---
+type ModifyOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt   string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Variety       string                 `protobuf:"bytes,2,opt,name=variety,proto3" json:"variety,omitempty"`
+	Orderid       string                 `protobuf:"bytes,3,opt,name=orderid,proto3" json:"orderid,omitempty"`
+	Ordertype     string                 `protobuf:"bytes,4,opt,name=ordertype,proto3" json:"ordertype,omitempty"`
+	Producttype   string                 `protobuf:"bytes,5,opt,name=producttype,proto3" json:"producttype,omitempty"`
+	Duration      string                 `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Price         float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int32                  `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Triggerprice  float64                `protobuf:"fixed64,9,opt,name=triggerprice,proto3" json:"triggerprice,omitempty"`
+	Tradingsymbol string                 `protobuf:"bytes,10,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Symboltoken   string                 `protobuf:"bytes,11,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Exchange      string                 `protobuf:"bytes,12,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ModifyOrderRequest) Reset() {
+	*x = ModifyOrderRequest{}
+	mi := &file_broker_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderRequest) ProtoMessage() {}
+
+func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
+func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ModifyOrderRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetVariety() string {
+	if x != nil {
+		return x.Variety
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetOrderid() string {
+	if x != nil {
+		return x.Orderid
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetOrdertype() string {
+	if x != nil {
+		return x.Ordertype
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetProducttype() string {
+	if x != nil {
+		return x.Producttype
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ModifyOrderRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ModifyOrderRequest) GetTriggerprice() float64 {
+	if x != nil {
+		return x.Triggerprice
+	}
+	return 0
+}
+
+func (x *ModifyOrderRequest) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ModifyOrderAngelData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orderid       string                 `protobuf:"bytes,1,opt,name=orderid,proto3" json:"orderid,omitempty"`
+	Uniqueorderid string                 `protobuf:"bytes,2,opt,name=uniqueorderid,proto3" json:"uniqueorderid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyOrderAngelData) Reset() {
+	*x = ModifyOrderAngelData{}
+	mi := &file_broker_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderAngelData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderAngelData) ProtoMessage() {}
+
+func (x *ModifyOrderAngelData) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderAngelData.ProtoReflect.Descriptor instead.
+func (*ModifyOrderAngelData) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ModifyOrderAngelData) GetOrderid() string {
+	if x != nil {
+		return x.Orderid
+	}
+	return ""
+}
+
+func (x *ModifyOrderAngelData) GetUniqueorderid() string {
+	if x != nil {
+		return x.Uniqueorderid
+	}
+	return ""
+}
+
+type ModifyOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *ModifyOrderAngelData  `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyOrderResponse) Reset() {
+	*x = ModifyOrderResponse{}
+	mi := &file_broker_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderResponse) ProtoMessage() {}
+
+func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
+func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ModifyOrderResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ModifyOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ModifyOrderResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *ModifyOrderResponse) GetData() *ModifyOrderAngelData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// --- Order Book ---
+type OrderBookItem struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Variety             string                 `protobuf:"bytes,1,opt,name=variety,proto3" json:"variety,omitempty"`
+	Ordertype           string                 `protobuf:"bytes,2,opt,name=ordertype,proto3" json:"ordertype,omitempty"`
+	Producttype         string                 `protobuf:"bytes,3,opt,name=producttype,proto3" json:"producttype,omitempty"`
+	Duration            string                 `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Price               float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Triggerprice        float64                `protobuf:"fixed64,6,opt,name=triggerprice,proto3" json:"triggerprice,omitempty"`
+	Quantity            string                 `protobuf:"bytes,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Disclosedquantity   string                 `protobuf:"bytes,8,opt,name=disclosedquantity,proto3" json:"disclosedquantity,omitempty"`
+	Squareoff           float64                `protobuf:"fixed64,9,opt,name=squareoff,proto3" json:"squareoff,omitempty"`
+	Stoploss            float64                `protobuf:"fixed64,10,opt,name=stoploss,proto3" json:"stoploss,omitempty"`
+	Trailingstoploss    float64                `protobuf:"fixed64,11,opt,name=trailingstoploss,proto3" json:"trailingstoploss,omitempty"`
+	Tradingsymbol       string                 `protobuf:"bytes,12,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Transactiontype     string                 `protobuf:"bytes,13,opt,name=transactiontype,proto3" json:"transactiontype,omitempty"`
+	Exchange            string                 `protobuf:"bytes,14,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Symboltoken         string                 `protobuf:"bytes,15,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Instrumenttype      string                 `protobuf:"bytes,16,opt,name=instrumenttype,proto3" json:"instrumenttype,omitempty"`
+	Strikeprice         float64                `protobuf:"fixed64,17,opt,name=strikeprice,proto3" json:"strikeprice,omitempty"`
+	Optiontype          string                 `protobuf:"bytes,18,opt,name=optiontype,proto3" json:"optiontype,omitempty"`
+	Expirydate          string                 `protobuf:"bytes,19,opt,name=expirydate,proto3" json:"expirydate,omitempty"`
+	Lotsize             string                 `protobuf:"bytes,20,opt,name=lotsize,proto3" json:"lotsize,omitempty"`
+	Cancelsize          string                 `protobuf:"bytes,21,opt,name=cancelsize,proto3" json:"cancelsize,omitempty"`
+	Averageprice        float64                `protobuf:"fixed64,22,opt,name=averageprice,proto3" json:"averageprice,omitempty"`
+	Filledshares        string                 `protobuf:"bytes,23,opt,name=filledshares,proto3" json:"filledshares,omitempty"`
+	Unfilledshares      string                 `protobuf:"bytes,24,opt,name=unfilledshares,proto3" json:"unfilledshares,omitempty"`
+	Orderid             string                 `protobuf:"bytes,25,opt,name=orderid,proto3" json:"orderid,omitempty"`
+	Text                string                 `protobuf:"bytes,26,opt,name=text,proto3" json:"text,omitempty"`
+	Status              string                 `protobuf:"bytes,27,opt,name=status,proto3" json:"status,omitempty"`
+	Orderstatus         string                 `protobuf:"bytes,28,opt,name=orderstatus,proto3" json:"orderstatus,omitempty"`
+	Updatetime          string                 `protobuf:"bytes,29,opt,name=updatetime,proto3" json:"updatetime,omitempty"`
+	Exchtime            string                 `protobuf:"bytes,30,opt,name=exchtime,proto3" json:"exchtime,omitempty"`
+	Exchorderupdatetime string                 `protobuf:"bytes,31,opt,name=exchorderupdatetime,proto3" json:"exchorderupdatetime,omitempty"`
+	Fillid              string                 `protobuf:"bytes,32,opt,name=fillid,proto3" json:"fillid,omitempty"`
+	Filltime            string                 `protobuf:"bytes,33,opt,name=filltime,proto3" json:"filltime,omitempty"`
+	Parentorderid       string                 `protobuf:"bytes,34,opt,name=parentorderid,proto3" json:"parentorderid,omitempty"`
+	Uniqueorderid       string                 `protobuf:"bytes,35,opt,name=uniqueorderid,proto3" json:"uniqueorderid,omitempty"`
+	Exchangeorderid     string                 `protobuf:"bytes,36,opt,name=exchangeorderid,proto3" json:"exchangeorderid,omitempty"`
+	Ordertag            string                 `protobuf:"bytes,37,opt,name=ordertag,proto3" json:"ordertag,omitempty"`
+	Trades              []*TradeBookItem       `protobuf:"bytes,38,rep,name=trades,proto3" json:"trades,omitempty"` // Fills for this order; only set when include_trades is requested
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OrderBookItem) Reset() {
+	*x = OrderBookItem{}
+	mi := &file_broker_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookItem) ProtoMessage() {}
+
+func (x *OrderBookItem) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookItem.ProtoReflect.Descriptor instead.
+func (*OrderBookItem) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OrderBookItem) GetVariety() string {
+	if x != nil {
+		return x.Variety
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetOrdertype() string {
+	if x != nil {
+		return x.Ordertype
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetProducttype() string {
+	if x != nil {
+		return x.Producttype
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderBookItem) GetTriggerprice() float64 {
+	if x != nil {
+		return x.Triggerprice
+	}
+	return 0
+}
+
+func (x *OrderBookItem) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetDisclosedquantity() string {
+	if x != nil {
+		return x.Disclosedquantity
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetSquareoff() float64 {
+	if x != nil {
+		return x.Squareoff
+	}
+	return 0
+}
+
+func (x *OrderBookItem) GetStoploss() float64 {
+	if x != nil {
+		return x.Stoploss
+	}
+	return 0
+}
+
+func (x *OrderBookItem) GetTrailingstoploss() float64 {
+	if x != nil {
+		return x.Trailingstoploss
+	}
+	return 0
+}
+
+func (x *OrderBookItem) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetTransactiontype() string {
+	if x != nil {
+		return x.Transactiontype
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetInstrumenttype() string {
+	if x != nil {
+		return x.Instrumenttype
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetStrikeprice() float64 {
+	if x != nil {
+		return x.Strikeprice
+	}
+	return 0
+}
+
+func (x *OrderBookItem) GetOptiontype() string {
+	if x != nil {
+		return x.Optiontype
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetExpirydate() string {
+	if x != nil {
+		return x.Expirydate
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetLotsize() string {
+	if x != nil {
+		return x.Lotsize
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetCancelsize() string {
+	if x != nil {
+		return x.Cancelsize
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetAverageprice() float64 {
+	if x != nil {
+		return x.Averageprice
+	}
+	return 0
+}
+
+func (x *OrderBookItem) GetFilledshares() string {
+	if x != nil {
+		return x.Filledshares
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetUnfilledshares() string {
+	if x != nil {
+		return x.Unfilledshares
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetOrderid() string {
+	if x != nil {
+		return x.Orderid
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetOrderstatus() string {
+	if x != nil {
+		return x.Orderstatus
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetUpdatetime() string {
+	if x != nil {
+		return x.Updatetime
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetExchtime() string {
+	if x != nil {
+		return x.Exchtime
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetExchorderupdatetime() string {
+	if x != nil {
+		return x.Exchorderupdatetime
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetFillid() string {
+	if x != nil {
+		return x.Fillid
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetFilltime() string {
+	if x != nil {
+		return x.Filltime
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetParentorderid() string {
+	if x != nil {
+		return x.Parentorderid
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetUniqueorderid() string {
+	if x != nil {
+		return x.Uniqueorderid
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetExchangeorderid() string {
+	if x != nil {
+		return x.Exchangeorderid
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetOrdertag() string {
+	if x != nil {
+		return x.Ordertag
+	}
+	return ""
+}
+
+func (x *OrderBookItem) GetTrades() []*TradeBookItem {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+type GetOrderBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt   string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	IncludeTrades bool                   `protobuf:"varint,2,opt,name=include_trades,json=includeTrades,proto3" json:"include_trades,omitempty"` // Join the trade book onto each order by orderid
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrderBookRequest) Reset() {
+	*x = GetOrderBookRequest{}
+	mi := &file_broker_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookRequest) ProtoMessage() {}
+
+func (x *GetOrderBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderBookRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderBookRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetOrderBookRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *GetOrderBookRequest) GetIncludeTrades() bool {
+	if x != nil {
+		return x.IncludeTrades
+	}
+	return false
+}
+
+func (x *GetOrderBookRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *GetOrderBookRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *GetOrderBookRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type GetOrderBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*OrderBookItem       `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Data is an array of order items, or null/empty if no orders
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderBookResponse) Reset() {
+	*x = GetOrderBookResponse{}
+	mi := &file_broker_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderBookResponse) ProtoMessage() {}
+
+func (x *GetOrderBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderBookResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderBookResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetOrderBookResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetOrderBookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetOrderBookResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *GetOrderBookResponse) GetData() []*OrderBookItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// --- Order Details ---
+type GetOrderDetailsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt    string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Uniqueorderid  string                 `protobuf:"bytes,2,opt,name=uniqueorderid,proto3" json:"uniqueorderid,omitempty"` // The uniqueorderid returned by PlaceOrder/GetOrderBook
+	ClientLocalIp  string                 `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string                 `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string                 `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrderDetailsRequest) Reset() {
+	*x = GetOrderDetailsRequest{}
+	mi := &file_broker_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderDetailsRequest) ProtoMessage() {}
+
+func (x *GetOrderDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetOrderDetailsRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *GetOrderDetailsRequest) GetUniqueorderid() string {
+	if x != nil {
+		return x.Uniqueorderid
+	}
+	return ""
+}
+
+func (x *GetOrderDetailsRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *GetOrderDetailsRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *GetOrderDetailsRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type GetOrderDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *OrderBookItem         `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderDetailsResponse) Reset() {
+	*x = GetOrderDetailsResponse{}
+	mi := &file_broker_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderDetailsResponse) ProtoMessage() {}
+
+func (x *GetOrderDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetOrderDetailsResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetOrderDetailsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetOrderDetailsResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *GetOrderDetailsResponse) GetData() *OrderBookItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// --- Trade Book ---
+type TradeBookItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Orderid         string                 `protobuf:"bytes,1,opt,name=orderid,proto3" json:"orderid,omitempty"`
+	Fillid          string                 `protobuf:"bytes,2,opt,name=fillid,proto3" json:"fillid,omitempty"`
+	Filltime        string                 `protobuf:"bytes,3,opt,name=filltime,proto3" json:"filltime,omitempty"` // Exchange fill time, e.g. "13:27:53"
+	Fillprice       float64                `protobuf:"fixed64,4,opt,name=fillprice,proto3" json:"fillprice,omitempty"`
+	Fillsize        int64                  `protobuf:"varint,5,opt,name=fillsize,proto3" json:"fillsize,omitempty"`
+	Tradevalue      float64                `protobuf:"fixed64,6,opt,name=tradevalue,proto3" json:"tradevalue,omitempty"`
+	Transactiontype string                 `protobuf:"bytes,7,opt,name=transactiontype,proto3" json:"transactiontype,omitempty"`
+	Exchange        string                 `protobuf:"bytes,8,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Producttype     string                 `protobuf:"bytes,9,opt,name=producttype,proto3" json:"producttype,omitempty"`
+	Tradingsymbol   string                 `protobuf:"bytes,10,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Instrumenttype  string                 `protobuf:"bytes,11,opt,name=instrumenttype,proto3" json:"instrumenttype,omitempty"`
+	Symbolgroup     string                 `protobuf:"bytes,12,opt,name=symbolgroup,proto3" json:"symbolgroup,omitempty"`
+	Strikeprice     float64                `protobuf:"fixed64,13,opt,name=strikeprice,proto3" json:"strikeprice,omitempty"`
+	Optiontype      string                 `protobuf:"bytes,14,opt,name=optiontype,proto3" json:"optiontype,omitempty"`
+	Expirydate      string                 `protobuf:"bytes,15,opt,name=expirydate,proto3" json:"expirydate,omitempty"`
+	Marketlot       int64                  `protobuf:"varint,16,opt,name=marketlot,proto3" json:"marketlot,omitempty"`
+	Precision       int32                  `protobuf:"varint,17,opt,name=precision,proto3" json:"precision,omitempty"`
+	Multiplier      int32                  `protobuf:"varint,18,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TradeBookItem) Reset() {
+	*x = TradeBookItem{}
+	mi := &file_broker_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeBookItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeBookItem) ProtoMessage() {}
+
+func (x *TradeBookItem) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeBookItem.ProtoReflect.Descriptor instead.
+func (*TradeBookItem) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TradeBookItem) GetOrderid() string {
+	if x != nil {
+		return x.Orderid
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetFillid() string {
+	if x != nil {
+		return x.Fillid
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetFilltime() string {
+	if x != nil {
+		return x.Filltime
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetFillprice() float64 {
+	if x != nil {
+		return x.Fillprice
+	}
+	return 0
+}
+
+func (x *TradeBookItem) GetFillsize() int64 {
+	if x != nil {
+		return x.Fillsize
+	}
+	return 0
+}
+
+func (x *TradeBookItem) GetTradevalue() float64 {
+	if x != nil {
+		return x.Tradevalue
+	}
+	return 0
+}
+
+func (x *TradeBookItem) GetTransactiontype() string {
+	if x != nil {
+		return x.Transactiontype
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetProducttype() string {
+	if x != nil {
+		return x.Producttype
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetInstrumenttype() string {
+	if x != nil {
+		return x.Instrumenttype
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetSymbolgroup() string {
+	if x != nil {
+		return x.Symbolgroup
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetStrikeprice() float64 {
+	if x != nil {
+		return x.Strikeprice
+	}
+	return 0
+}
+
+func (x *TradeBookItem) GetOptiontype() string {
+	if x != nil {
+		return x.Optiontype
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetExpirydate() string {
+	if x != nil {
+		return x.Expirydate
+	}
+	return ""
+}
+
+func (x *TradeBookItem) GetMarketlot() int64 {
+	if x != nil {
+		return x.Marketlot
+	}
+	return 0
+}
+
+func (x *TradeBookItem) GetPrecision() int32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *TradeBookItem) GetMultiplier() int32 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type GetTradeBookRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt    string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	ClientLocalIp  string                 `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string                 `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string                 `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTradeBookRequest) Reset() {
+	*x = GetTradeBookRequest{}
+	mi := &file_broker_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeBookRequest) ProtoMessage() {}
+
+func (x *GetTradeBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeBookRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeBookRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTradeBookRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *GetTradeBookRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *GetTradeBookRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *GetTradeBookRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type GetTradeBookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*TradeBookItem       `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Today's fills, oldest first as sent by Angel One
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTradeBookResponse) Reset() {
+	*x = GetTradeBookResponse{}
+	mi := &file_broker_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTradeBookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeBookResponse) ProtoMessage() {}
+
+func (x *GetTradeBookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeBookResponse.ProtoReflect.Descriptor instead.
+func (*GetTradeBookResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTradeBookResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetTradeBookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTradeBookResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *GetTradeBookResponse) GetData() []*TradeBookItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StreamOrderUpdatesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt    string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	ClientLocalIp  string                 `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string                 `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string                 `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamOrderUpdatesRequest) Reset() {
+	*x = StreamOrderUpdatesRequest{}
+	mi := &file_broker_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOrderUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOrderUpdatesRequest) ProtoMessage() {}
+
+func (x *StreamOrderUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOrderUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*StreamOrderUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StreamOrderUpdatesRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *StreamOrderUpdatesRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *StreamOrderUpdatesRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *StreamOrderUpdatesRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type OrderUpdate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            OrderEventType         `protobuf:"varint,1,opt,name=type,proto3,enum=broker.OrderEventType" json:"type,omitempty"`
+	OrderStatusCode string                 `protobuf:"bytes,2,opt,name=order_status_code,json=orderStatusCode,proto3" json:"order_status_code,omitempty"` // Angel One's code, e.g. AB01 (open) or AB05 (complete)
+	ReceivedAt      int64                  `protobuf:"varint,3,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`                 // Unix milliseconds
+	Order           *OrderBookItem         `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderUpdate) Reset() {
+	*x = OrderUpdate{}
+	mi := &file_broker_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderUpdate) ProtoMessage() {}
+
+func (x *OrderUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderUpdate.ProtoReflect.Descriptor instead.
+func (*OrderUpdate) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OrderUpdate) GetType() OrderEventType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *OrderUpdate) GetOrderStatusCode() string {
+	if x != nil {
+		return x.OrderStatusCode
+	}
+	return ""
+}
+
+func (x *OrderUpdate) GetReceivedAt() int64 {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return 0
+}
+
+func (x *OrderUpdate) GetOrder() *OrderBookItem {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// --- Portfolio Holdings ---
+type HoldingItemData struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Tradingsymbol      string                 `protobuf:"bytes,1,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Exchange           string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Isin               string                 `protobuf:"bytes,3,opt,name=isin,proto3" json:"isin,omitempty"`
+	T1Quantity         int32                  `protobuf:"varint,4,opt,name=t1quantity,proto3" json:"t1quantity,omitempty"`                 // from JSON t1quantity
+	Realisedquantity   int32                  `protobuf:"varint,5,opt,name=realisedquantity,proto3" json:"realisedquantity,omitempty"`     // from JSON realisedquantity
+	Quantity           int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`                     // from JSON quantity
+	Authorisedquantity int32                  `protobuf:"varint,7,opt,name=authorisedquantity,proto3" json:"authorisedquantity,omitempty"` // from JSON authorisedquantity
+	Product            string                 `protobuf:"bytes,8,opt,name=product,proto3" json:"product,omitempty"`
+	// collateralquantity and collateraltype can be null, handle as optional or string
+	// For simplicity, using string and checking for null during unmarshal if needed,
+	// or use google.protobuf.Int32Value for nullable integers if you add it.
+	// Protobuf basic types are not inherently nullable in proto3 unless wrapped.
+	// For now, let's try int32 for collateralquantity and see if nulls are treated as 0.
+	// If Angel sends 'null', and Go's json unmarshaller tries to put it into int32, it will error.
+	// A safer bet for fields that can be 'null' is to use string or wrapper types.
+	// Let's assume for now `collateralquantity` will be 0 if null.
+	Collateralquantity int32   `protobuf:"varint,9,opt,name=collateralquantity,proto3" json:"collateralquantity,omitempty"` // from JSON collateralquantity (handle null)
+	Collateraltype     string  `protobuf:"bytes,10,opt,name=collateraltype,proto3" json:"collateraltype,omitempty"`         // from JSON collateraltype (handle null)
+	Haircut            float64 `protobuf:"fixed64,11,opt,name=haircut,proto3" json:"haircut,omitempty"`                     // from JSON haircut
+	Averageprice       float64 `protobuf:"fixed64,12,opt,name=averageprice,proto3" json:"averageprice,omitempty"`
+	Ltp                float64 `protobuf:"fixed64,13,opt,name=ltp,proto3" json:"ltp,omitempty"`
+	Symboltoken        string  `protobuf:"bytes,14,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Close              float64 `protobuf:"fixed64,15,opt,name=close,proto3" json:"close,omitempty"` // JSON close is a number
+	Profitandloss      float64 `protobuf:"fixed64,16,opt,name=profitandloss,proto3" json:"profitandloss,omitempty"`
+	Pnlpercentage      float64 `protobuf:"fixed64,17,opt,name=pnlpercentage,proto3" json:"pnlpercentage,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *HoldingItemData) Reset() {
+	*x = HoldingItemData{}
+	mi := &file_broker_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldingItemData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldingItemData) ProtoMessage() {}
+
+func (x *HoldingItemData) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldingItemData.ProtoReflect.Descriptor instead.
+func (*HoldingItemData) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HoldingItemData) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *HoldingItemData) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *HoldingItemData) GetIsin() string {
+	if x != nil {
+		return x.Isin
+	}
+	return ""
+}
+
+func (x *HoldingItemData) GetT1Quantity() int32 {
+	if x != nil {
+		return x.T1Quantity
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetRealisedquantity() int32 {
+	if x != nil {
+		return x.Realisedquantity
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetAuthorisedquantity() int32 {
+	if x != nil {
+		return x.Authorisedquantity
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *HoldingItemData) GetCollateralquantity() int32 {
+	if x != nil {
+		return x.Collateralquantity
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetCollateraltype() string {
+	if x != nil {
+		return x.Collateraltype
+	}
+	return ""
+}
+
+func (x *HoldingItemData) GetHaircut() float64 {
+	if x != nil {
+		return x.Haircut
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetAverageprice() float64 {
+	if x != nil {
+		return x.Averageprice
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetLtp() float64 {
+	if x != nil {
+		return x.Ltp
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *HoldingItemData) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetProfitandloss() float64 {
+	if x != nil {
+		return x.Profitandloss
+	}
+	return 0
+}
+
+func (x *HoldingItemData) GetPnlpercentage() float64 {
+	if x != nil {
+		return x.Pnlpercentage
+	}
+	return 0
+}
+
+type TotalHoldingValue struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Totalholdingvalue  float64                `protobuf:"fixed64,1,opt,name=totalholdingvalue,proto3" json:"totalholdingvalue,omitempty"`
+	Totalinvvalue      float64                `protobuf:"fixed64,2,opt,name=totalinvvalue,proto3" json:"totalinvvalue,omitempty"`
+	Totalprofitandloss float64                `protobuf:"fixed64,3,opt,name=totalprofitandloss,proto3" json:"totalprofitandloss,omitempty"`
+	Totalpnlpercentage float64                `protobuf:"fixed64,4,opt,name=totalpnlpercentage,proto3" json:"totalpnlpercentage,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TotalHoldingValue) Reset() {
+	*x = TotalHoldingValue{}
+	mi := &file_broker_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TotalHoldingValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalHoldingValue) ProtoMessage() {}
+
+func (x *TotalHoldingValue) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TotalHoldingValue.ProtoReflect.Descriptor instead.
+func (*TotalHoldingValue) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TotalHoldingValue) GetTotalholdingvalue() float64 {
+	if x != nil {
+		return x.Totalholdingvalue
+	}
+	return 0
+}
+
+func (x *TotalHoldingValue) GetTotalinvvalue() float64 {
+	if x != nil {
+		return x.Totalinvvalue
+	}
+	return 0
+}
+
+func (x *TotalHoldingValue) GetTotalprofitandloss() float64 {
+	if x != nil {
+		return x.Totalprofitandloss
+	}
+	return 0
+}
+
+func (x *TotalHoldingValue) GetTotalpnlpercentage() float64 {
+	if x != nil {
+		return x.Totalpnlpercentage
+	}
+	return 0
+}
+
+type PortfolioHoldingsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holdings      []*HoldingItemData     `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	Totalholding  *TotalHoldingValue     `protobuf:"bytes,2,opt,name=totalholding,proto3" json:"totalholding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioHoldingsData) Reset() {
+	*x = PortfolioHoldingsData{}
+	mi := &file_broker_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioHoldingsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioHoldingsData) ProtoMessage() {}
+
+func (x *PortfolioHoldingsData) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioHoldingsData.ProtoReflect.Descriptor instead.
+func (*PortfolioHoldingsData) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PortfolioHoldingsData) GetHoldings() []*HoldingItemData {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *PortfolioHoldingsData) GetTotalholding() *TotalHoldingValue {
+	if x != nil {
+		return x.Totalholding
+	}
+	return nil
+}
+
+type GetHoldingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt    string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	ClientLocalIp  string                 `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string                 `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string                 `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetHoldingsRequest) Reset() {
+	*x = GetHoldingsRequest{}
+	mi := &file_broker_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldingsRequest) ProtoMessage() {}
+
+func (x *GetHoldingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldingsRequest.ProtoReflect.Descriptor instead.
+func (*GetHoldingsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetHoldingsRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *GetHoldingsRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *GetHoldingsRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *GetHoldingsRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type GetHoldingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *PortfolioHoldingsData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"` // <<< CHANGED to use the new wrapper
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldingsResponse) Reset() {
+	*x = GetHoldingsResponse{}
+	mi := &file_broker_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldingsResponse) ProtoMessage() {}
+
+func (x *GetHoldingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldingsResponse.ProtoReflect.Descriptor instead.
+func (*GetHoldingsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetHoldingsResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetHoldingsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetHoldingsResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *GetHoldingsResponse) GetData() *PortfolioHoldingsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// --- Positions ---
+// Angel One returns every number as a string; the broker service parses them.
+type PositionItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Symboltoken    string                 `protobuf:"bytes,2,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Tradingsymbol  string                 `protobuf:"bytes,3,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Symbolname     string                 `protobuf:"bytes,4,opt,name=symbolname,proto3" json:"symbolname,omitempty"`
+	Instrumenttype string                 `protobuf:"bytes,5,opt,name=instrumenttype,proto3" json:"instrumenttype,omitempty"`
+	Producttype    string                 `protobuf:"bytes,6,opt,name=producttype,proto3" json:"producttype,omitempty"`
+	Optiontype     string                 `protobuf:"bytes,7,opt,name=optiontype,proto3" json:"optiontype,omitempty"`
+	Strikeprice    float64                `protobuf:"fixed64,8,opt,name=strikeprice,proto3" json:"strikeprice,omitempty"`
+	Expirydate     string                 `protobuf:"bytes,9,opt,name=expirydate,proto3" json:"expirydate,omitempty"`
+	Lotsize        int32                  `protobuf:"varint,10,opt,name=lotsize,proto3" json:"lotsize,omitempty"`
+	Buyqty         int64                  `protobuf:"varint,11,opt,name=buyqty,proto3" json:"buyqty,omitempty"`
+	Sellqty        int64                  `protobuf:"varint,12,opt,name=sellqty,proto3" json:"sellqty,omitempty"`
+	Netqty         int64                  `protobuf:"varint,13,opt,name=netqty,proto3" json:"netqty,omitempty"` // Positive for long, negative for short
+	Buyavgprice    float64                `protobuf:"fixed64,14,opt,name=buyavgprice,proto3" json:"buyavgprice,omitempty"`
+	Sellavgprice   float64                `protobuf:"fixed64,15,opt,name=sellavgprice,proto3" json:"sellavgprice,omitempty"`
+	Netprice       float64                `protobuf:"fixed64,16,opt,name=netprice,proto3" json:"netprice,omitempty"` // Average price of the open quantity
+	Buyamount      float64                `protobuf:"fixed64,17,opt,name=buyamount,proto3" json:"buyamount,omitempty"`
+	Sellamount     float64                `protobuf:"fixed64,18,opt,name=sellamount,proto3" json:"sellamount,omitempty"`
+	Netvalue       float64                `protobuf:"fixed64,19,opt,name=netvalue,proto3" json:"netvalue,omitempty"`
+	Ltp            float64                `protobuf:"fixed64,20,opt,name=ltp,proto3" json:"ltp,omitempty"`               // 0 when Angel One does not send it
+	Realised       float64                `protobuf:"fixed64,21,opt,name=realised,proto3" json:"realised,omitempty"`     // P&L of the quantity already squared off
+	Unrealised     float64                `protobuf:"fixed64,22,opt,name=unrealised,proto3" json:"unrealised,omitempty"` // Mark-to-market P&L of the open quantity (needs ltp)
+	Cfbuyqty       int64                  `protobuf:"varint,23,opt,name=cfbuyqty,proto3" json:"cfbuyqty,omitempty"`      // Carried forward from previous sessions (net positions only)
+	Cfsellqty      int64                  `protobuf:"varint,24,opt,name=cfsellqty,proto3" json:"cfsellqty,omitempty"`
+	Cfbuyavgprice  float64                `protobuf:"fixed64,25,opt,name=cfbuyavgprice,proto3" json:"cfbuyavgprice,omitempty"`
+	Cfsellavgprice float64                `protobuf:"fixed64,26,opt,name=cfsellavgprice,proto3" json:"cfsellavgprice,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PositionItem) Reset() {
+	*x = PositionItem{}
+	mi := &file_broker_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionItem) ProtoMessage() {}
+
+func (x *PositionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionItem.ProtoReflect.Descriptor instead.
+func (*PositionItem) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PositionItem) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *PositionItem) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *PositionItem) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *PositionItem) GetSymbolname() string {
+	if x != nil {
+		return x.Symbolname
+	}
+	return ""
+}
+
+func (x *PositionItem) GetInstrumenttype() string {
+	if x != nil {
+		return x.Instrumenttype
+	}
+	return ""
+}
+
+func (x *PositionItem) GetProducttype() string {
+	if x != nil {
+		return x.Producttype
+	}
+	return ""
+}
+
+func (x *PositionItem) GetOptiontype() string {
+	if x != nil {
+		return x.Optiontype
+	}
+	return ""
+}
+
+func (x *PositionItem) GetStrikeprice() float64 {
+	if x != nil {
+		return x.Strikeprice
+	}
+	return 0
+}
+
+func (x *PositionItem) GetExpirydate() string {
+	if x != nil {
+		return x.Expirydate
+	}
+	return ""
+}
+
+func (x *PositionItem) GetLotsize() int32 {
+	if x != nil {
+		return x.Lotsize
+	}
+	return 0
+}
+
+func (x *PositionItem) GetBuyqty() int64 {
+	if x != nil {
+		return x.Buyqty
+	}
+	return 0
+}
+
+func (x *PositionItem) GetSellqty() int64 {
+	if x != nil {
+		return x.Sellqty
+	}
+	return 0
+}
+
+func (x *PositionItem) GetNetqty() int64 {
+	if x != nil {
+		return x.Netqty
+	}
+	return 0
+}
+
+func (x *PositionItem) GetBuyavgprice() float64 {
+	if x != nil {
+		return x.Buyavgprice
+	}
+	return 0
+}
+
+func (x *PositionItem) GetSellavgprice() float64 {
+	if x != nil {
+		return x.Sellavgprice
+	}
+	return 0
+}
+
+func (x *PositionItem) GetNetprice() float64 {
+	if x != nil {
+		return x.Netprice
+	}
+	return 0
+}
+
+func (x *PositionItem) GetBuyamount() float64 {
+	if x != nil {
+		return x.Buyamount
+	}
+	return 0
+}
+
+func (x *PositionItem) GetSellamount() float64 {
+	if x != nil {
+		return x.Sellamount
+	}
+	return 0
+}
+
+func (x *PositionItem) GetNetvalue() float64 {
+	if x != nil {
+		return x.Netvalue
+	}
+	return 0
+}
+
+func (x *PositionItem) GetLtp() float64 {
+	if x != nil {
+		return x.Ltp
+	}
+	return 0
+}
+
+func (x *PositionItem) GetRealised() float64 {
+	if x != nil {
+		return x.Realised
+	}
+	return 0
+}
+
+func (x *PositionItem) GetUnrealised() float64 {
+	if x != nil {
+		return x.Unrealised
+	}
+	return 0
+}
+
+func (x *PositionItem) GetCfbuyqty() int64 {
+	if x != nil {
+		return x.Cfbuyqty
+	}
+	return 0
+}
+
+func (x *PositionItem) GetCfsellqty() int64 {
+	if x != nil {
+		return x.Cfsellqty
+	}
+	return 0
+}
+
+func (x *PositionItem) GetCfbuyavgprice() float64 {
+	if x != nil {
+		return x.Cfbuyavgprice
+	}
+	return 0
+}
+
+func (x *PositionItem) GetCfsellavgprice() float64 {
+	if x != nil {
+		return x.Cfsellavgprice
+	}
+	return 0
+}
+
+type PositionsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Net           []*PositionItem        `protobuf:"bytes,1,rep,name=net,proto3" json:"net,omitempty"` // Overall position including carried forward quantity
+	Day           []*PositionItem        `protobuf:"bytes,2,rep,name=day,proto3" json:"day,omitempty"` // Only today's buys and sells
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PositionsData) Reset() {
+	*x = PositionsData{}
+	mi := &file_broker_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionsData) ProtoMessage() {}
+
+func (x *PositionsData) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionsData.ProtoReflect.Descriptor instead.
+func (*PositionsData) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PositionsData) GetNet() []*PositionItem {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *PositionsData) GetDay() []*PositionItem {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+type GetPositionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt    string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	ClientLocalIp  string                 `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string                 `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string                 `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPositionsRequest) Reset() {
+	*x = GetPositionsRequest{}
+	mi := &file_broker_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionsRequest) ProtoMessage() {}
+
+func (x *GetPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPositionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPositionsRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *GetPositionsRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *GetPositionsRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *GetPositionsRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type GetPositionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *PositionsData         `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPositionsResponse) Reset() {
+	*x = GetPositionsResponse{}
+	mi := &file_broker_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionsResponse) ProtoMessage() {}
+
+func (x *GetPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPositionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetPositionsResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetPositionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetPositionsResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *GetPositionsResponse) GetData() *PositionsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// --- Convert Position ---
+type ConvertPositionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt     string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Exchange        string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Symboltoken     string                 `protobuf:"bytes,3,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Tradingsymbol   string                 `protobuf:"bytes,4,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Oldproducttype  string                 `protobuf:"bytes,5,opt,name=oldproducttype,proto3" json:"oldproducttype,omitempty"`   // e.g. INTRADAY
+	Newproducttype  string                 `protobuf:"bytes,6,opt,name=newproducttype,proto3" json:"newproducttype,omitempty"`   // e.g. DELIVERY
+	Transactiontype string                 `protobuf:"bytes,7,opt,name=transactiontype,proto3" json:"transactiontype,omitempty"` // BUY for long, SELL for short; derived from the position when empty
+	Quantity        int32                  `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`              // Must not exceed the open quantity of the position
+	Type            string                 `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`                       // DAY (today's position) or CARRYFORWARD; defaults to DAY
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConvertPositionRequest) Reset() {
+	*x = ConvertPositionRequest{}
+	mi := &file_broker_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertPositionRequest) ProtoMessage() {}
+
+func (x *ConvertPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertPositionRequest.ProtoReflect.Descriptor instead.
+func (*ConvertPositionRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConvertPositionRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetOldproducttype() string {
+	if x != nil {
+		return x.Oldproducttype
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetNewproducttype() string {
+	if x != nil {
+		return x.Newproducttype
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetTransactiontype() string {
+	if x != nil {
+		return x.Transactiontype
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ConvertPositionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *ConvertPositionRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ConvertPositionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertPositionResponse) Reset() {
+	*x = ConvertPositionResponse{}
+	mi := &file_broker_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertPositionResponse) ProtoMessage() {}
+
+func (x *ConvertPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertPositionResponse.ProtoReflect.Descriptor instead.
+func (*ConvertPositionResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ConvertPositionResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ConvertPositionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConvertPositionResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+// --- Funds (RMS Limits) ---
+type RMSLimits struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Net                    float64                `protobuf:"fixed64,1,opt,name=net,proto3" json:"net,omitempty"` // Net funds available for trading
+	Availablecash          float64                `protobuf:"fixed64,2,opt,name=availablecash,proto3" json:"availablecash,omitempty"`
+	Availableintradaypayin float64                `protobuf:"fixed64,3,opt,name=availableintradaypayin,proto3" json:"availableintradaypayin,omitempty"`
+	Availablelimitmargin   float64                `protobuf:"fixed64,4,opt,name=availablelimitmargin,proto3" json:"availablelimitmargin,omitempty"`
+	Collateral             float64                `protobuf:"fixed64,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	M2Munrealized          float64                `protobuf:"fixed64,6,opt,name=m2munrealized,proto3" json:"m2munrealized,omitempty"`
+	M2Mrealized            float64                `protobuf:"fixed64,7,opt,name=m2mrealized,proto3" json:"m2mrealized,omitempty"`
+	Utiliseddebits         float64                `protobuf:"fixed64,8,opt,name=utiliseddebits,proto3" json:"utiliseddebits,omitempty"`
+	Utilisedspan           float64                `protobuf:"fixed64,9,opt,name=utilisedspan,proto3" json:"utilisedspan,omitempty"`
+	Utilisedoptionpremium  float64                `protobuf:"fixed64,10,opt,name=utilisedoptionpremium,proto3" json:"utilisedoptionpremium,omitempty"`
+	Utilisedholdingsales   float64                `protobuf:"fixed64,11,opt,name=utilisedholdingsales,proto3" json:"utilisedholdingsales,omitempty"`
+	Utilisedexposure       float64                `protobuf:"fixed64,12,opt,name=utilisedexposure,proto3" json:"utilisedexposure,omitempty"`
+	Utilisedturnover       float64                `protobuf:"fixed64,13,opt,name=utilisedturnover,proto3" json:"utilisedturnover,omitempty"`
+	Utilisedpayout         float64                `protobuf:"fixed64,14,opt,name=utilisedpayout,proto3" json:"utilisedpayout,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RMSLimits) Reset() {
+	*x = RMSLimits{}
+	mi := &file_broker_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RMSLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RMSLimits) ProtoMessage() {}
+
+func (x *RMSLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RMSLimits.ProtoReflect.Descriptor instead.
+func (*RMSLimits) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RMSLimits) GetNet() float64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetAvailablecash() float64 {
+	if x != nil {
+		return x.Availablecash
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetAvailableintradaypayin() float64 {
+	if x != nil {
+		return x.Availableintradaypayin
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetAvailablelimitmargin() float64 {
+	if x != nil {
+		return x.Availablelimitmargin
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetCollateral() float64 {
+	if x != nil {
+		return x.Collateral
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetM2Munrealized() float64 {
+	if x != nil {
+		return x.M2Munrealized
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetM2Mrealized() float64 {
+	if x != nil {
+		return x.M2Mrealized
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetUtiliseddebits() float64 {
+	if x != nil {
+		return x.Utiliseddebits
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetUtilisedspan() float64 {
+	if x != nil {
+		return x.Utilisedspan
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetUtilisedoptionpremium() float64 {
+	if x != nil {
+		return x.Utilisedoptionpremium
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetUtilisedholdingsales() float64 {
+	if x != nil {
+		return x.Utilisedholdingsales
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetUtilisedexposure() float64 {
+	if x != nil {
+		return x.Utilisedexposure
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetUtilisedturnover() float64 {
+	if x != nil {
+		return x.Utilisedturnover
+	}
+	return 0
+}
+
+func (x *RMSLimits) GetUtilisedpayout() float64 {
+	if x != nil {
+		return x.Utilisedpayout
+	}
+	return 0
+}
+
+type GetRMSLimitsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt    string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	ClientLocalIp  string                 `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string                 `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string                 `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetRMSLimitsRequest) Reset() {
+	*x = GetRMSLimitsRequest{}
+	mi := &file_broker_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRMSLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRMSLimitsRequest) ProtoMessage() {}
+
+func (x *GetRMSLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRMSLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetRMSLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetRMSLimitsRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *GetRMSLimitsRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *GetRMSLimitsRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *GetRMSLimitsRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type GetRMSLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *RMSLimits             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRMSLimitsResponse) Reset() {
+	*x = GetRMSLimitsResponse{}
+	mi := &file_broker_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRMSLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRMSLimitsResponse) ProtoMessage() {}
+
+func (x *GetRMSLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRMSLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetRMSLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetRMSLimitsResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetRMSLimitsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetRMSLimitsResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *GetRMSLimitsResponse) GetData() *RMSLimits {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// --- Margin Calculator ---
+type CalculateMarginRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	// Only exchange, symboltoken, transactiontype, ordertype, producttype,
+	// price and quantity of each leg are used; tradingsymbol is echoed back.
+	Legs           []*PlaceOrderRequest `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	ClientLocalIp  string               `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string               `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string               `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CalculateMarginRequest) Reset() {
+	*x = CalculateMarginRequest{}
+	mi := &file_broker_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateMarginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateMarginRequest) ProtoMessage() {}
+
+func (x *CalculateMarginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateMarginRequest.ProtoReflect.Descriptor instead.
+func (*CalculateMarginRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CalculateMarginRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *CalculateMarginRequest) GetLegs() []*PlaceOrderRequest {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *CalculateMarginRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *CalculateMarginRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *CalculateMarginRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type MarginComponents struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Netpremium        float64                `protobuf:"fixed64,1,opt,name=netpremium,proto3" json:"netpremium,omitempty"`
+	Spanmargin        float64                `protobuf:"fixed64,2,opt,name=spanmargin,proto3" json:"spanmargin,omitempty"`
+	Marginbenefit     float64                `protobuf:"fixed64,3,opt,name=marginbenefit,proto3" json:"marginbenefit,omitempty"` // Hedge benefit of the legs taken together
+	Deliverymargin    float64                `protobuf:"fixed64,4,opt,name=deliverymargin,proto3" json:"deliverymargin,omitempty"`
+	Nonnfomargin      float64                `protobuf:"fixed64,5,opt,name=nonnfomargin,proto3" json:"nonnfomargin,omitempty"`
+	Totoptionspremium float64                `protobuf:"fixed64,6,opt,name=totoptionspremium,proto3" json:"totoptionspremium,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MarginComponents) Reset() {
+	*x = MarginComponents{}
+	mi := &file_broker_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginComponents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginComponents) ProtoMessage() {}
+
+func (x *MarginComponents) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginComponents.ProtoReflect.Descriptor instead.
+func (*MarginComponents) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MarginComponents) GetNetpremium() float64 {
+	if x != nil {
+		return x.Netpremium
+	}
+	return 0
+}
+
+func (x *MarginComponents) GetSpanmargin() float64 {
+	if x != nil {
+		return x.Spanmargin
+	}
+	return 0
+}
+
+func (x *MarginComponents) GetMarginbenefit() float64 {
+	if x != nil {
+		return x.Marginbenefit
+	}
+	return 0
+}
+
+func (x *MarginComponents) GetDeliverymargin() float64 {
+	if x != nil {
+		return x.Deliverymargin
+	}
+	return 0
+}
+
+func (x *MarginComponents) GetNonnfomargin() float64 {
+	if x != nil {
+		return x.Nonnfomargin
+	}
+	return 0
+}
+
+func (x *MarginComponents) GetTotoptionspremium() float64 {
+	if x != nil {
+		return x.Totoptionspremium
+	}
+	return 0
+}
+
+type LegMargin struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Index          int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Position of the leg in the request
+	Exchange       string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Symboltoken    string                 `protobuf:"bytes,3,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Tradingsymbol  string                 `protobuf:"bytes,4,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Marginrequired float64                `protobuf:"fixed64,5,opt,name=marginrequired,proto3" json:"marginrequired,omitempty"` // Margin for this leg on its own
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LegMargin) Reset() {
+	*x = LegMargin{}
+	mi := &file_broker_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegMargin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegMargin) ProtoMessage() {}
+
+func (x *LegMargin) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegMargin.ProtoReflect.Descriptor instead.
+func (*LegMargin) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LegMargin) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LegMargin) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *LegMargin) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *LegMargin) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *LegMargin) GetMarginrequired() float64 {
+	if x != nil {
+		return x.Marginrequired
+	}
+	return 0
+}
+
+type MarginData struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Totalmarginrequired float64                `protobuf:"fixed64,1,opt,name=totalmarginrequired,proto3" json:"totalmarginrequired,omitempty"` // Margin for all legs together, after hedge benefit
+	Components          *MarginComponents      `protobuf:"bytes,2,opt,name=components,proto3" json:"components,omitempty"`
+	Legs                []*LegMargin           `protobuf:"bytes,3,rep,name=legs,proto3" json:"legs,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MarginData) Reset() {
+	*x = MarginData{}
+	mi := &file_broker_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginData) ProtoMessage() {}
+
+func (x *MarginData) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginData.ProtoReflect.Descriptor instead.
+func (*MarginData) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MarginData) GetTotalmarginrequired() float64 {
+	if x != nil {
+		return x.Totalmarginrequired
+	}
+	return 0
+}
+
+func (x *MarginData) GetComponents() *MarginComponents {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *MarginData) GetLegs() []*LegMargin {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+type CalculateMarginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *MarginData            `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateMarginResponse) Reset() {
+	*x = CalculateMarginResponse{}
+	mi := &file_broker_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateMarginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateMarginResponse) ProtoMessage() {}
+
+func (x *CalculateMarginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateMarginResponse.ProtoReflect.Descriptor instead.
+func (*CalculateMarginResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CalculateMarginResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *CalculateMarginResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CalculateMarginResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *CalculateMarginResponse) GetData() *MarginData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// --- GTT (good-till-triggered) rules ---
+type GTTRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status          string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // NEW, ACTIVE, SENTTOEXCHANGE or CANCELLED
+	Tradingsymbol   string                 `protobuf:"bytes,3,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Symboltoken     string                 `protobuf:"bytes,4,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Exchange        string                 `protobuf:"bytes,5,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Producttype     string                 `protobuf:"bytes,6,opt,name=producttype,proto3" json:"producttype,omitempty"` // DELIVERY or MARGIN
+	Transactiontype string                 `protobuf:"bytes,7,opt,name=transactiontype,proto3" json:"transactiontype,omitempty"`
+	Price           float64                `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"` // Limit price of the order placed when triggered
+	Qty             int32                  `protobuf:"varint,9,opt,name=qty,proto3" json:"qty,omitempty"`
+	Triggerprice    float64                `protobuf:"fixed64,10,opt,name=triggerprice,proto3" json:"triggerprice,omitempty"`
+	Disclosedqty    int32                  `protobuf:"varint,11,opt,name=disclosedqty,proto3" json:"disclosedqty,omitempty"`
+	Createddate     string                 `protobuf:"bytes,12,opt,name=createddate,proto3" json:"createddate,omitempty"`
+	Updateddate     string                 `protobuf:"bytes,13,opt,name=updateddate,proto3" json:"updateddate,omitempty"`
+	Expirydate      string                 `protobuf:"bytes,14,opt,name=expirydate,proto3" json:"expirydate,omitempty"`
+	Clientid        string                 `protobuf:"bytes,15,opt,name=clientid,proto3" json:"clientid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GTTRule) Reset() {
+	*x = GTTRule{}
+	mi := &file_broker_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GTTRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GTTRule) ProtoMessage() {}
+
+func (x *GTTRule) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GTTRule.ProtoReflect.Descriptor instead.
+func (*GTTRule) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GTTRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GTTRule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GTTRule) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *GTTRule) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *GTTRule) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GTTRule) GetProducttype() string {
+	if x != nil {
+		return x.Producttype
+	}
+	return ""
+}
+
+func (x *GTTRule) GetTransactiontype() string {
+	if x != nil {
+		return x.Transactiontype
+	}
+	return ""
+}
+
+func (x *GTTRule) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GTTRule) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *GTTRule) GetTriggerprice() float64 {
+	if x != nil {
+		return x.Triggerprice
+	}
+	return 0
+}
+
+func (x *GTTRule) GetDisclosedqty() int32 {
+	if x != nil {
+		return x.Disclosedqty
+	}
+	return 0
+}
+
+func (x *GTTRule) GetCreateddate() string {
+	if x != nil {
+		return x.Createddate
+	}
+	return ""
+}
+
+func (x *GTTRule) GetUpdateddate() string {
+	if x != nil {
+		return x.Updateddate
+	}
+	return ""
+}
+
+func (x *GTTRule) GetExpirydate() string {
+	if x != nil {
+		return x.Expirydate
+	}
+	return ""
+}
+
+func (x *GTTRule) GetClientid() string {
+	if x != nil {
+		return x.Clientid
+	}
+	return ""
+}
+
+type CreateGTTRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt     string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Tradingsymbol   string                 `protobuf:"bytes,2,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Symboltoken     string                 `protobuf:"bytes,3,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Exchange        string                 `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Transactiontype string                 `protobuf:"bytes,5,opt,name=transactiontype,proto3" json:"transactiontype,omitempty"`
+	Producttype     string                 `protobuf:"bytes,6,opt,name=producttype,proto3" json:"producttype,omitempty"` // DELIVERY or MARGIN
+	Price           float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	Qty             int32                  `protobuf:"varint,8,opt,name=qty,proto3" json:"qty,omitempty"`
+	Triggerprice    float64                `protobuf:"fixed64,9,opt,name=triggerprice,proto3" json:"triggerprice,omitempty"`
+	Disclosedqty    int32                  `protobuf:"varint,10,opt,name=disclosedqty,proto3" json:"disclosedqty,omitempty"`
+	Timeperiod      int32                  `protobuf:"varint,11,opt,name=timeperiod,proto3" json:"timeperiod,omitempty"` // Days the rule stays active; Angel One's default (365) when 0
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateGTTRequest) Reset() {
+	*x = CreateGTTRequest{}
+	mi := &file_broker_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGTTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGTTRequest) ProtoMessage() {}
+
+func (x *CreateGTTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGTTRequest.ProtoReflect.Descriptor instead.
+func (*CreateGTTRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateGTTRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *CreateGTTRequest) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *CreateGTTRequest) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *CreateGTTRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CreateGTTRequest) GetTransactiontype() string {
+	if x != nil {
+		return x.Transactiontype
+	}
+	return ""
+}
+
+func (x *CreateGTTRequest) GetProducttype() string {
+	if x != nil {
+		return x.Producttype
+	}
+	return ""
+}
+
+func (x *CreateGTTRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateGTTRequest) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *CreateGTTRequest) GetTriggerprice() float64 {
+	if x != nil {
+		return x.Triggerprice
+	}
+	return 0
+}
+
+func (x *CreateGTTRequest) GetDisclosedqty() int32 {
+	if x != nil {
+		return x.Disclosedqty
+	}
+	return 0
+}
+
+func (x *CreateGTTRequest) GetTimeperiod() int32 {
+	if x != nil {
+		return x.Timeperiod
+	}
+	return 0
+}
+
+func (x *CreateGTTRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *CreateGTTRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *CreateGTTRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type CreateGTTResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGTTResponse) Reset() {
+	*x = CreateGTTResponse{}
+	mi := &file_broker_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGTTResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGTTResponse) ProtoMessage() {}
+
+func (x *CreateGTTResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGTTResponse.ProtoReflect.Descriptor instead.
+func (*CreateGTTResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateGTTResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *CreateGTTResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateGTTResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *CreateGTTResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ModifyGTTRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt  string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Id           string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Symboltoken  string                 `protobuf:"bytes,3,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"` // Looked up from the rule when empty
+	Exchange     string                 `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"`       // Looked up from the rule when empty
+	Price        float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Qty          int32                  `protobuf:"varint,6,opt,name=qty,proto3" json:"qty,omitempty"`
+	Triggerprice float64                `protobuf:"fixed64,7,opt,name=triggerprice,proto3" json:"triggerprice,omitempty"`
+	Disclosedqty int32                  `protobuf:"varint,8,opt,name=disclosedqty,proto3" json:"disclosedqty,omitempty"`
+	Timeperiod   int32                  `protobuf:"varint,9,opt,name=timeperiod,proto3" json:"timeperiod,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ModifyGTTRequest) Reset() {
+	*x = ModifyGTTRequest{}
+	mi := &file_broker_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyGTTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyGTTRequest) ProtoMessage() {}
+
+func (x *ModifyGTTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyGTTRequest.ProtoReflect.Descriptor instead.
+func (*ModifyGTTRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ModifyGTTRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *ModifyGTTRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModifyGTTRequest) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *ModifyGTTRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ModifyGTTRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ModifyGTTRequest) GetQty() int32 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *ModifyGTTRequest) GetTriggerprice() float64 {
+	if x != nil {
+		return x.Triggerprice
+	}
+	return 0
+}
+
+func (x *ModifyGTTRequest) GetDisclosedqty() int32 {
+	if x != nil {
+		return x.Disclosedqty
+	}
+	return 0
+}
+
+func (x *ModifyGTTRequest) GetTimeperiod() int32 {
+	if x != nil {
+		return x.Timeperiod
+	}
+	return 0
+}
+
+func (x *ModifyGTTRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *ModifyGTTRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *ModifyGTTRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ModifyGTTResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyGTTResponse) Reset() {
+	*x = ModifyGTTResponse{}
+	mi := &file_broker_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyGTTResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyGTTResponse) ProtoMessage() {}
+
+func (x *ModifyGTTResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyGTTResponse.ProtoReflect.Descriptor instead.
+func (*ModifyGTTResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ModifyGTTResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ModifyGTTResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ModifyGTTResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *ModifyGTTResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelGTTRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Symboltoken string                 `protobuf:"bytes,3,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"` // Looked up from the rule when empty
+	Exchange    string                 `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"`       // Looked up from the rule when empty
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelGTTRequest) Reset() {
+	*x = CancelGTTRequest{}
+	mi := &file_broker_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGTTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGTTRequest) ProtoMessage() {}
+
+func (x *CancelGTTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGTTRequest.ProtoReflect.Descriptor instead.
+func (*CancelGTTRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CancelGTTRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *CancelGTTRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelGTTRequest) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *CancelGTTRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CancelGTTRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *CancelGTTRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *CancelGTTRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type CancelGTTResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelGTTResponse) Reset() {
+	*x = CancelGTTResponse{}
+	mi := &file_broker_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelGTTResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelGTTResponse) ProtoMessage() {}
+
+func (x *CancelGTTResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelGTTResponse.ProtoReflect.Descriptor instead.
+func (*CancelGTTResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CancelGTTResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *CancelGTTResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelGTTResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *CancelGTTResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGTTDetailsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGTTDetailsRequest) Reset() {
+	*x = GetGTTDetailsRequest{}
+	mi := &file_broker_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGTTDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGTTDetailsRequest) ProtoMessage() {}
+
+func (x *GetGTTDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGTTDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetGTTDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetGTTDetailsRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *GetGTTDetailsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGTTDetailsRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *GetGTTDetailsRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *GetGTTDetailsRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type GetGTTDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *GTTRule               `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGTTDetailsResponse) Reset() {
+	*x = GetGTTDetailsResponse{}
+	mi := &file_broker_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGTTDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGTTDetailsResponse) ProtoMessage() {}
+
+func (x *GetGTTDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGTTDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetGTTDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetGTTDetailsResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetGTTDetailsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetGTTDetailsResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *GetGTTDetailsResponse) GetData() *GTTRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListGTTRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Status      []string               `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty"` // NEW, ACTIVE, SENTTOEXCHANGE, CANCELLED or FORALL; all rules when empty
+	Page        int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`    // Defaults to 1
+	Count       int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`  // Defaults to 10
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListGTTRequest) Reset() {
+	*x = ListGTTRequest{}
+	mi := &file_broker_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGTTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGTTRequest) ProtoMessage() {}
+
+func (x *ListGTTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGTTRequest.ProtoReflect.Descriptor instead.
+func (*ListGTTRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListGTTRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *ListGTTRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListGTTRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListGTTRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ListGTTRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *ListGTTRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *ListGTTRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ListGTTResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*GTTRule             `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGTTResponse) Reset() {
+	*x = ListGTTResponse{}
+	mi := &file_broker_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGTTResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGTTResponse) ProtoMessage() {}
+
+func (x *ListGTTResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGTTResponse.ProtoReflect.Descriptor instead.
+func (*ListGTTResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListGTTResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ListGTTResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListGTTResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *ListGTTResponse) GetData() []*GTTRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AlertCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          AlertConditionType     `protobuf:"varint,1,opt,name=type,proto3,enum=broker.AlertConditionType" json:"type,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"` // Price or percentage; unused for TIME_*
+	Time          string                 `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`     // HH:MM in IST, for TIME_* only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertCondition) Reset() {
+	*x = AlertCondition{}
+	mi := &file_broker_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertCondition) ProtoMessage() {}
+
+func (x *AlertCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertCondition.ProtoReflect.Descriptor instead.
+func (*AlertCondition) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AlertCondition) GetType() AlertConditionType {
+	if x != nil {
+		return x.Type
+	}
+	return AlertConditionType_ALERT_CONDITION_TYPE_UNSPECIFIED
+}
+
+func (x *AlertCondition) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AlertCondition) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type AlertRule struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientCode string                 `protobuf:"bytes,2,opt,name=client_code,json=clientCode,proto3" json:"client_code,omitempty"` // Owner, looked up from the session's profile
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Instrument whose LTP is watched; defaults to the order's
+	Exchange          string             `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Tradingsymbol     string             `protobuf:"bytes,5,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Symboltoken       string             `protobuf:"bytes,6,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Conditions        []*AlertCondition  `protobuf:"bytes,7,rep,name=conditions,proto3" json:"conditions,omitempty"`                                 // All must hold
+	Order             *PlaceOrderRequest `protobuf:"bytes,8,opt,name=order,proto3" json:"order,omitempty"`                                           // Stored without the JWT and headers
+	ReferencePrice    float64            `protobuf:"fixed64,9,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"` // Base for PERCENT_CHANGE_*; the LTP at creation unless given
+	State             AlertRuleState     `protobuf:"varint,10,opt,name=state,proto3,enum=broker.AlertRuleState" json:"state,omitempty"`
+	Ordertag          string             `protobuf:"bytes,11,opt,name=ordertag,proto3" json:"ordertag,omitempty"`                     // Set on the order so a firing can be matched in the order book
+	CreatedAt         int64              `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix milliseconds
+	TriggeredAt       int64              `protobuf:"varint,13,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
+	TriggerPrice      float64            `protobuf:"fixed64,14,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"` // LTP the rule fired at; 0 for time-only rules
+	Orderid           string             `protobuf:"bytes,15,opt,name=orderid,proto3" json:"orderid,omitempty"`
+	Uniqueorderid     string             `protobuf:"bytes,16,opt,name=uniqueorderid,proto3" json:"uniqueorderid,omitempty"`
+	Message           string             `protobuf:"bytes,17,opt,name=message,proto3" json:"message,omitempty"`                                                 // Angel One's reply, or why the firing failed
+	LastPrice         float64            `protobuf:"fixed64,18,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`                          // Latest LTP seen by the engine; not persisted
+	WaitingForSession bool               `protobuf:"varint,19,opt,name=waiting_for_session,json=waitingForSession,proto3" json:"waiting_for_session,omitempty"` // Pending, but the broker holds no Angel One session for the client; not persisted
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_broker_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AlertRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertRule) GetClientCode() string {
+	if x != nil {
+		return x.ClientCode
+	}
+	return ""
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *AlertRule) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *AlertRule) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *AlertRule) GetConditions() []*AlertCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *AlertRule) GetOrder() *PlaceOrderRequest {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *AlertRule) GetReferencePrice() float64 {
+	if x != nil {
+		return x.ReferencePrice
+	}
+	return 0
+}
+
+func (x *AlertRule) GetState() AlertRuleState {
+	if x != nil {
+		return x.State
+	}
+	return AlertRuleState_ALERT_RULE_STATE_UNSPECIFIED
+}
+
+func (x *AlertRule) GetOrdertag() string {
+	if x != nil {
+		return x.Ordertag
+	}
+	return ""
+}
+
+func (x *AlertRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AlertRule) GetTriggeredAt() int64 {
+	if x != nil {
+		return x.TriggeredAt
+	}
+	return 0
+}
+
+func (x *AlertRule) GetTriggerPrice() float64 {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return 0
+}
+
+func (x *AlertRule) GetOrderid() string {
+	if x != nil {
+		return x.Orderid
+	}
+	return ""
+}
+
+func (x *AlertRule) GetUniqueorderid() string {
+	if x != nil {
+		return x.Uniqueorderid
+	}
+	return ""
+}
+
+func (x *AlertRule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AlertRule) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *AlertRule) GetWaitingForSession() bool {
+	if x != nil {
+		return x.WaitingForSession
+	}
+	return false
+}
+
+type CreateAlertRuleRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	FeedToken   string                 `protobuf:"bytes,2,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"` // Lets the engine use the tick stream instead of polling
+	Rule        *AlertRule             `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`                            // name, instrument, conditions, order and reference_price are read
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_broker_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateAlertRuleRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetFeedToken() string {
+	if x != nil {
+		return x.FeedToken
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *CreateAlertRuleRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type CreateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *AlertRule             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleResponse) Reset() {
+	*x = CreateAlertRuleResponse{}
+	mi := &file_broker_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleResponse) ProtoMessage() {}
+
+func (x *CreateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateAlertRuleResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *CreateAlertRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleResponse) GetData() *AlertRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListAlertRulesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	FeedToken   string                 `protobuf:"bytes,2,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"`
+	ActiveOnly  bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Only RULE_ACTIVE and RULE_FIRING rules
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_broker_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListAlertRulesRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *ListAlertRulesRequest) GetFeedToken() string {
+	if x != nil {
+		return x.FeedToken
+	}
+	return ""
+}
+
+func (x *ListAlertRulesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListAlertRulesRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *ListAlertRulesRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *ListAlertRulesRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*AlertRule           `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_broker_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListAlertRulesResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ListAlertRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListAlertRulesResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *ListAlertRulesResponse) GetData() []*AlertRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetAlertRuleRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	FeedToken   string                 `protobuf:"bytes,2,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"`
+	Id          string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAlertRuleRequest) Reset() {
+	*x = GetAlertRuleRequest{}
+	mi := &file_broker_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRuleRequest) ProtoMessage() {}
+
+func (x *GetAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetAlertRuleRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *GetAlertRuleRequest) GetFeedToken() string {
+	if x != nil {
+		return x.FeedToken
+	}
+	return ""
+}
+
+func (x *GetAlertRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAlertRuleRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *GetAlertRuleRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *GetAlertRuleRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type GetAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *AlertRule             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertRuleResponse) Reset() {
+	*x = GetAlertRuleResponse{}
+	mi := &file_broker_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRuleResponse) ProtoMessage() {}
+
+func (x *GetAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetAlertRuleResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *GetAlertRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetAlertRuleResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *GetAlertRuleResponse) GetData() *AlertRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CancelAlertRuleRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	FeedToken   string                 `protobuf:"bytes,2,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"`
+	Id          string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelAlertRuleRequest) Reset() {
+	*x = CancelAlertRuleRequest{}
+	mi := &file_broker_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAlertRuleRequest) ProtoMessage() {}
+
+func (x *CancelAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CancelAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CancelAlertRuleRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *CancelAlertRuleRequest) GetFeedToken() string {
+	if x != nil {
+		return x.FeedToken
+	}
+	return ""
+}
+
+func (x *CancelAlertRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelAlertRuleRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *CancelAlertRuleRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *CancelAlertRuleRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type CancelAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *AlertRule             `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAlertRuleResponse) Reset() {
+	*x = CancelAlertRuleResponse{}
+	mi := &file_broker_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAlertRuleResponse) ProtoMessage() {}
+
+func (x *CancelAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CancelAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CancelAlertRuleResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *CancelAlertRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelAlertRuleResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *CancelAlertRuleResponse) GetData() *AlertRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PriceAlert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientCode    string                 `protobuf:"bytes,2,opt,name=client_code,json=clientCode,proto3" json:"client_code,omitempty"`
+	Exchange      string                 `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Tradingsymbol string                 `protobuf:"bytes,4,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
+	Symboltoken   string                 `protobuf:"bytes,5,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	// PRICE_ABOVE, PRICE_BELOW, PERCENT_CHANGE_ABOVE or PERCENT_CHANGE_BELOW;
+	// percent change is from the previous close
+	Condition         *AlertCondition `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`
+	Note              string          `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	Repeat            bool            `protobuf:"varint,8,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	State             PriceAlertState `protobuf:"varint,9,opt,name=state,proto3,enum=broker.PriceAlertState" json:"state,omitempty"`
+	CreatedAt         int64           `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // Unix milliseconds
+	TriggeredAt       int64           `protobuf:"varint,11,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"` // Last time the alert fired
+	TriggerPrice      float64         `protobuf:"fixed64,12,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	TriggerCount      int32           `protobuf:"varint,13,opt,name=trigger_count,json=triggerCount,proto3" json:"trigger_count,omitempty"`
+	LastPrice         float64         `protobuf:"fixed64,14,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`                          // Latest LTP seen by the monitor; not persisted
+	WaitingForSession bool            `protobuf:"varint,15,opt,name=waiting_for_session,json=waitingForSession,proto3" json:"waiting_for_session,omitempty"` // Watched, but the broker holds no Angel One session for the client; not persisted
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PriceAlert) Reset() {
+	*x = PriceAlert{}
+	mi := &file_broker_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAlert) ProtoMessage() {}
+
+func (x *PriceAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAlert.ProtoReflect.Descriptor instead.
+func (*PriceAlert) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PriceAlert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetClientCode() string {
+	if x != nil {
+		return x.ClientCode
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetTradingsymbol() string {
+	if x != nil {
+		return x.Tradingsymbol
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetCondition() *AlertCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *PriceAlert) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetRepeat() bool {
+	if x != nil {
+		return x.Repeat
+	}
+	return false
+}
+
+func (x *PriceAlert) GetState() PriceAlertState {
+	if x != nil {
+		return x.State
+	}
+	return PriceAlertState_PRICE_ALERT_STATE_UNSPECIFIED
+}
+
+func (x *PriceAlert) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetTriggeredAt() int64 {
+	if x != nil {
+		return x.TriggeredAt
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetTriggerPrice() float64 {
+	if x != nil {
+		return x.TriggerPrice
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetTriggerCount() int32 {
+	if x != nil {
+		return x.TriggerCount
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetLastPrice() float64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetWaitingForSession() bool {
+	if x != nil {
+		return x.WaitingForSession
+	}
+	return false
+}
+
+// Notifications are POSTed as JSON to every webhook of the alert's owner,
+// signed with the webhook's secret (see the README).
+type AlertWebhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientCode    string                 `protobuf:"bytes,2,opt,name=client_code,json=clientCode,proto3" json:"client_code,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"` // Only returned when the webhook is created
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertWebhook) Reset() {
+	*x = AlertWebhook{}
+	mi := &file_broker_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertWebhook) ProtoMessage() {}
+
+func (x *AlertWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertWebhook.ProtoReflect.Descriptor instead.
+func (*AlertWebhook) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AlertWebhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertWebhook) GetClientCode() string {
+	if x != nil {
+		return x.ClientCode
+	}
+	return ""
+}
+
+func (x *AlertWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AlertWebhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *AlertWebhook) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientCode    string                 `protobuf:"bytes,2,opt,name=client_code,json=clientCode,proto3" json:"client_code,omitempty"`
+	AlertId       string                 `protobuf:"bytes,3,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	WebhookId     string                 `protobuf:"bytes,4,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	Status        DeliveryStatus         `protobuf:"varint,6,opt,name=status,proto3,enum=broker.DeliveryStatus" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseCode  int32                  `protobuf:"varint,8,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"` // HTTP status of the last attempt
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                    // Why the last attempt failed
+	Payload       string                 `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`                               // The JSON body sent
+	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // Unix milliseconds
+	NextAttemptAt int64                  `protobuf:"varint,12,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt   int64                  `protobuf:"varint,13,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_broker_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetClientCode() string {
+	if x != nil {
+		return x.ClientCode
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+type CreatePriceAlertRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Alert       *PriceAlert            `protobuf:"bytes,2,opt,name=alert,proto3" json:"alert,omitempty"` // Instrument, condition, note and repeat are read
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePriceAlertRequest) Reset() {
+	*x = CreatePriceAlertRequest{}
+	mi := &file_broker_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceAlertRequest) ProtoMessage() {}
+
+func (x *CreatePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreatePriceAlertRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *CreatePriceAlertRequest) GetAlert() *PriceAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *CreatePriceAlertRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *CreatePriceAlertRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *CreatePriceAlertRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type UpdatePriceAlertRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Condition   *AlertCondition        `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"` // Unchanged when empty
+	Note        string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Repeat      bool                   `protobuf:"varint,5,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Paused      bool                   `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"` // Any update re-arms the alert unless paused
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePriceAlertRequest) Reset() {
+	*x = UpdatePriceAlertRequest{}
+	mi := &file_broker_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceAlertRequest) ProtoMessage() {}
+
+func (x *UpdatePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdatePriceAlertRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *UpdatePriceAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePriceAlertRequest) GetCondition() *AlertCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *UpdatePriceAlertRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *UpdatePriceAlertRequest) GetRepeat() bool {
+	if x != nil {
+		return x.Repeat
+	}
+	return false
+}
+
+func (x *UpdatePriceAlertRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *UpdatePriceAlertRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *UpdatePriceAlertRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *UpdatePriceAlertRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type PriceAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *PriceAlert            `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceAlertResponse) Reset() {
+	*x = PriceAlertResponse{}
+	mi := &file_broker_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAlertResponse) ProtoMessage() {}
+
+func (x *PriceAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAlertResponse.ProtoReflect.Descriptor instead.
+func (*PriceAlertResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PriceAlertResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *PriceAlertResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PriceAlertResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *PriceAlertResponse) GetData() *PriceAlert {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListPriceAlertsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPriceAlertsRequest) Reset() {
+	*x = ListPriceAlertsRequest{}
+	mi := &file_broker_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceAlertsRequest) ProtoMessage() {}
+
+func (x *ListPriceAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListPriceAlertsRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *ListPriceAlertsRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *ListPriceAlertsRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *ListPriceAlertsRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ListPriceAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*PriceAlert          `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceAlertsResponse) Reset() {
+	*x = ListPriceAlertsResponse{}
+	mi := &file_broker_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceAlertsResponse) ProtoMessage() {}
+
+func (x *ListPriceAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListPriceAlertsResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ListPriceAlertsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListPriceAlertsResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *ListPriceAlertsResponse) GetData() []*PriceAlert {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Used by GetPriceAlert, DeletePriceAlert and DeleteAlertWebhook
+type AlertIDRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AlertIDRequest) Reset() {
+	*x = AlertIDRequest{}
+	mi := &file_broker_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertIDRequest) ProtoMessage() {}
+
+func (x *AlertIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertIDRequest.ProtoReflect.Descriptor instead.
+func (*AlertIDRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AlertIDRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *AlertIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertIDRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *AlertIDRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *AlertIDRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type DeleteAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertResponse) Reset() {
+	*x = DeleteAlertResponse{}
+	mi := &file_broker_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertResponse) ProtoMessage() {}
+
+func (x *DeleteAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteAlertResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *DeleteAlertResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteAlertResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+type CreateAlertWebhookRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Url         string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"` // http or https
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateAlertWebhookRequest) Reset() {
+	*x = CreateAlertWebhookRequest{}
+	mi := &file_broker_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertWebhookRequest) ProtoMessage() {}
+
+func (x *CreateAlertWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateAlertWebhookRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *CreateAlertWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateAlertWebhookRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *CreateAlertWebhookRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *CreateAlertWebhookRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type AlertWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *AlertWebhook          `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertWebhookResponse) Reset() {
+	*x = AlertWebhookResponse{}
+	mi := &file_broker_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertWebhookResponse) ProtoMessage() {}
+
+func (x *AlertWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertWebhookResponse.ProtoReflect.Descriptor instead.
+func (*AlertWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AlertWebhookResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *AlertWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AlertWebhookResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *AlertWebhookResponse) GetData() *AlertWebhook {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListAlertWebhooksRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAlertWebhooksRequest) Reset() {
+	*x = ListAlertWebhooksRequest{}
+	mi := &file_broker_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertWebhooksRequest) ProtoMessage() {}
+
+func (x *ListAlertWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListAlertWebhooksRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *ListAlertWebhooksRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *ListAlertWebhooksRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *ListAlertWebhooksRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ListAlertWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*AlertWebhook        `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Secrets are left out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertWebhooksResponse) Reset() {
+	*x = ListAlertWebhooksResponse{}
+	mi := &file_broker_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertWebhooksResponse) ProtoMessage() {}
+
+func (x *ListAlertWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListAlertWebhooksResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ListAlertWebhooksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListAlertWebhooksResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *ListAlertWebhooksResponse) GetData() []*AlertWebhook {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListAlertDeliveriesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	AlertId     string                 `protobuf:"bytes,2,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"` // Optional filter
+	Limit       int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                   // Defaults to 50, at most 500
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAlertDeliveriesRequest) Reset() {
+	*x = ListAlertDeliveriesRequest{}
+	mi := &file_broker_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListAlertDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListAlertDeliveriesRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *ListAlertDeliveriesRequest) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *ListAlertDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAlertDeliveriesRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *ListAlertDeliveriesRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *ListAlertDeliveriesRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ListAlertDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*WebhookDelivery     `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertDeliveriesResponse) Reset() {
+	*x = ListAlertDeliveriesResponse{}
+	mi := &file_broker_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListAlertDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListAlertDeliveriesResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ListAlertDeliveriesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListAlertDeliveriesResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *ListAlertDeliveriesResponse) GetData() []*WebhookDelivery {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BracketLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ordertag      string                 `protobuf:"bytes,1,opt,name=ordertag,proto3" json:"ordertag,omitempty"` // Sent with the order, to find it again after a lost reply
+	Orderid       string                 `protobuf:"bytes,2,opt,name=orderid,proto3" json:"orderid,omitempty"`
+	Uniqueorderid string                 `protobuf:"bytes,3,opt,name=uniqueorderid,proto3" json:"uniqueorderid,omitempty"`
+	Orderstatus   string                 `protobuf:"bytes,4,opt,name=orderstatus,proto3" json:"orderstatus,omitempty"` // Angel One's status as last seen, e.g. "open" or "complete"
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Filledshares  int32                  `protobuf:"varint,6,opt,name=filledshares,proto3" json:"filledshares,omitempty"`
+	Averageprice  float64                `protobuf:"fixed64,7,opt,name=averageprice,proto3" json:"averageprice,omitempty"`
+	Sent          bool                   `protobuf:"varint,8,opt,name=sent,proto3" json:"sent,omitempty"`                                        // Placement was attempted; the order book is checked before sending again
+	Message       string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`                                   // Angel One's message for the last action on the leg
+	PlacedAt      int64                  `protobuf:"varint,10,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`               // Unix milliseconds
+	LastActionAt  int64                  `protobuf:"varint,11,opt,name=last_action_at,json=lastActionAt,proto3" json:"last_action_at,omitempty"` // Last cancel sent or placement retried, to pace retries
+	CancelSent    bool                   `protobuf:"varint,12,opt,name=cancel_sent,json=cancelSent,proto3" json:"cancel_sent,omitempty"`         // The bracket cancelled the order, e.g. to swap the exits
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BracketLeg) Reset() {
+	*x = BracketLeg{}
+	mi := &file_broker_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketLeg) ProtoMessage() {}
+
+func (x *BracketLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketLeg.ProtoReflect.Descriptor instead.
+func (*BracketLeg) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BracketLeg) GetOrdertag() string {
+	if x != nil {
+		return x.Ordertag
+	}
+	return ""
+}
+
+func (x *BracketLeg) GetOrderid() string {
+	if x != nil {
+		return x.Orderid
+	}
+	return ""
+}
+
+func (x *BracketLeg) GetUniqueorderid() string {
+	if x != nil {
+		return x.Uniqueorderid
+	}
+	return ""
+}
+
+func (x *BracketLeg) GetOrderstatus() string {
+	if x != nil {
+		return x.Orderstatus
+	}
+	return ""
+}
+
+func (x *BracketLeg) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BracketLeg) GetFilledshares() int32 {
+	if x != nil {
+		return x.Filledshares
+	}
+	return 0
+}
+
+func (x *BracketLeg) GetAverageprice() float64 {
+	if x != nil {
+		return x.Averageprice
+	}
+	return 0
+}
+
+func (x *BracketLeg) GetSent() bool {
+	if x != nil {
+		return x.Sent
+	}
+	return false
+}
+
+func (x *BracketLeg) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BracketLeg) GetPlacedAt() int64 {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return 0
+}
+
+func (x *BracketLeg) GetLastActionAt() int64 {
+	if x != nil {
+		return x.LastActionAt
+	}
+	return 0
+}
+
+func (x *BracketLeg) GetCancelSent() bool {
+	if x != nil {
+		return x.CancelSent
+	}
+	return false
+}
+
+type BracketOrder struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClientCode           string                 `protobuf:"bytes,2,opt,name=client_code,json=clientCode,proto3" json:"client_code,omitempty"`
+	Entry                *PlaceOrderRequest     `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`                                  // Stored without the JWT and headers
+	TargetPrice          float64                `protobuf:"fixed64,4,opt,name=target_price,json=targetPrice,proto3" json:"target_price,omitempty"` // SELL LIMIT price of the target; not resting, only placed once a polled LTP reaches it
+	StoplossTriggerprice float64                `protobuf:"fixed64,5,opt,name=stoploss_triggerprice,json=stoplossTriggerprice,proto3" json:"stoploss_triggerprice,omitempty"`
+	StoplossPrice        float64                `protobuf:"fixed64,6,opt,name=stoploss_price,json=stoplossPrice,proto3" json:"stoploss_price,omitempty"` // Limit price of the stop-loss; 0 sends STOPLOSS_MARKET
+	State                BracketState           `protobuf:"varint,7,opt,name=state,proto3,enum=broker.BracketState" json:"state,omitempty"`
+	EntryLeg             *BracketLeg            `protobuf:"bytes,8,opt,name=entry_leg,json=entryLeg,proto3" json:"entry_leg,omitempty"`
+	TargetLeg            *BracketLeg            `protobuf:"bytes,9,opt,name=target_leg,json=targetLeg,proto3" json:"target_leg,omitempty"`
+	StoplossLeg          *BracketLeg            `protobuf:"bytes,10,opt,name=stoploss_leg,json=stoplossLeg,proto3" json:"stoploss_leg,omitempty"`
+	CancelRequested      bool                   `protobuf:"varint,11,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
+	Message              string                 `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt            int64                  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix milliseconds
+	UpdatedAt            int64                  `protobuf:"varint,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExitRound            int32                  `protobuf:"varint,15,opt,name=exit_round,json=exitRound,proto3" json:"exit_round,omitempty"`                           // Times the exits were placed; they are placed again after expiring at the end of a day
+	ExitQuantity         int32                  `protobuf:"varint,16,opt,name=exit_quantity,json=exitQuantity,proto3" json:"exit_quantity,omitempty"`                  // Shares the exits of this round cover
+	SoldQuantity         int32                  `protobuf:"varint,17,opt,name=sold_quantity,json=soldQuantity,proto3" json:"sold_quantity,omitempty"`                  // Shares sold by the exits of earlier rounds
+	WaitingForSession    bool                   `protobuf:"varint,18,opt,name=waiting_for_session,json=waitingForSession,proto3" json:"waiting_for_session,omitempty"` // Open, but the broker holds no Angel One session for the client; not persisted
+	TargetReached        bool                   `protobuf:"varint,19,opt,name=target_reached,json=targetReached,proto3" json:"target_reached,omitempty"`               // A polled LTP reached the target, so the target order works instead of the stop-loss
+	ExitRetries          int32                  `protobuf:"varint,20,opt,name=exit_retries,json=exitRetries,proto3" json:"exit_retries,omitempty"`                     // Exits placed again after Angel One rejected them for insufficient holdings
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BracketOrder) Reset() {
+	*x = BracketOrder{}
+	mi := &file_broker_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketOrder) ProtoMessage() {}
+
+func (x *BracketOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketOrder.ProtoReflect.Descriptor instead.
+func (*BracketOrder) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BracketOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BracketOrder) GetClientCode() string {
+	if x != nil {
+		return x.ClientCode
+	}
+	return ""
+}
+
+func (x *BracketOrder) GetEntry() *PlaceOrderRequest {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *BracketOrder) GetTargetPrice() float64 {
+	if x != nil {
+		return x.TargetPrice
+	}
+	return 0
+}
+
+func (x *BracketOrder) GetStoplossTriggerprice() float64 {
+	if x != nil {
+		return x.StoplossTriggerprice
+	}
+	return 0
+}
+
+func (x *BracketOrder) GetStoplossPrice() float64 {
+	if x != nil {
+		return x.StoplossPrice
+	}
+	return 0
+}
+
+func (x *BracketOrder) GetState() BracketState {
+	if x != nil {
+		return x.State
+	}
+	return BracketState_BRACKET_STATE_UNSPECIFIED
+}
+
+func (x *BracketOrder) GetEntryLeg() *BracketLeg {
+	if x != nil {
+		return x.EntryLeg
+	}
+	return nil
+}
+
+func (x *BracketOrder) GetTargetLeg() *BracketLeg {
+	if x != nil {
+		return x.TargetLeg
+	}
+	return nil
+}
+
+func (x *BracketOrder) GetStoplossLeg() *BracketLeg {
+	if x != nil {
+		return x.StoplossLeg
+	}
+	return nil
+}
+
+func (x *BracketOrder) GetCancelRequested() bool {
+	if x != nil {
+		return x.CancelRequested
+	}
+	return false
+}
+
+func (x *BracketOrder) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BracketOrder) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *BracketOrder) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *BracketOrder) GetExitRound() int32 {
+	if x != nil {
+		return x.ExitRound
+	}
+	return 0
+}
+
+func (x *BracketOrder) GetExitQuantity() int32 {
+	if x != nil {
+		return x.ExitQuantity
+	}
+	return 0
+}
+
+func (x *BracketOrder) GetSoldQuantity() int32 {
+	if x != nil {
+		return x.SoldQuantity
+	}
+	return 0
+}
+
+func (x *BracketOrder) GetWaitingForSession() bool {
+	if x != nil {
+		return x.WaitingForSession
+	}
+	return false
+}
+
+func (x *BracketOrder) GetTargetReached() bool {
+	if x != nil {
+		return x.TargetReached
+	}
+	return false
+}
+
+func (x *BracketOrder) GetExitRetries() int32 {
+	if x != nil {
+		return x.ExitRetries
+	}
+	return 0
+}
+
+type PlaceBracketOrderRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt          string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Entry                *PlaceOrderRequest     `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`                                  // NORMAL DELIVERY BUY on NSE or BSE, MARKET or LIMIT
+	TargetPrice          float64                `protobuf:"fixed64,3,opt,name=target_price,json=targetPrice,proto3" json:"target_price,omitempty"` // Emulated; see the bracket notes above
+	StoplossTriggerprice float64                `protobuf:"fixed64,4,opt,name=stoploss_triggerprice,json=stoplossTriggerprice,proto3" json:"stoploss_triggerprice,omitempty"`
+	StoplossPrice        float64                `protobuf:"fixed64,5,opt,name=stoploss_price,json=stoplossPrice,proto3" json:"stoploss_price,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlaceBracketOrderRequest) Reset() {
+	*x = PlaceBracketOrderRequest{}
+	mi := &file_broker_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceBracketOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceBracketOrderRequest) ProtoMessage() {}
+
+func (x *PlaceBracketOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceBracketOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceBracketOrderRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PlaceBracketOrderRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *PlaceBracketOrderRequest) GetEntry() *PlaceOrderRequest {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *PlaceBracketOrderRequest) GetTargetPrice() float64 {
+	if x != nil {
+		return x.TargetPrice
+	}
+	return 0
+}
+
+func (x *PlaceBracketOrderRequest) GetStoplossTriggerprice() float64 {
+	if x != nil {
+		return x.StoplossTriggerprice
+	}
+	return 0
+}
+
+func (x *PlaceBracketOrderRequest) GetStoplossPrice() float64 {
+	if x != nil {
+		return x.StoplossPrice
+	}
+	return 0
+}
+
+func (x *PlaceBracketOrderRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *PlaceBracketOrderRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *PlaceBracketOrderRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type BracketOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *BracketOrder          `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BracketOrderResponse) Reset() {
+	*x = BracketOrderResponse{}
+	mi := &file_broker_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketOrderResponse) ProtoMessage() {}
+
+func (x *BracketOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketOrderResponse.ProtoReflect.Descriptor instead.
+func (*BracketOrderResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *BracketOrderResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *BracketOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BracketOrderResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *BracketOrderResponse) GetData() *BracketOrder {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListBracketOrdersRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	ActiveOnly  bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Leave out completed, cancelled and failed brackets
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListBracketOrdersRequest) Reset() {
+	*x = ListBracketOrdersRequest{}
+	mi := &file_broker_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBracketOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBracketOrdersRequest) ProtoMessage() {}
+
+func (x *ListBracketOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBracketOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListBracketOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListBracketOrdersRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *ListBracketOrdersRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListBracketOrdersRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *ListBracketOrdersRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *ListBracketOrdersRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ListBracketOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*BracketOrder        `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBracketOrdersResponse) Reset() {
+	*x = ListBracketOrdersResponse{}
+	mi := &file_broker_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBracketOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBracketOrdersResponse) ProtoMessage() {}
+
+func (x *ListBracketOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBracketOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListBracketOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListBracketOrdersResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *ListBracketOrdersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListBracketOrdersResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *ListBracketOrdersResponse) GetData() []*BracketOrder {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Used by GetBracketOrder and CancelBracketOrder
+type BracketOrderIDRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Id          string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,20,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,21,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,22,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BracketOrderIDRequest) Reset() {
+	*x = BracketOrderIDRequest{}
+	mi := &file_broker_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BracketOrderIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BracketOrderIDRequest) ProtoMessage() {}
+
+func (x *BracketOrderIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BracketOrderIDRequest.ProtoReflect.Descriptor instead.
+func (*BracketOrderIDRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *BracketOrderIDRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *BracketOrderIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BracketOrderIDRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *BracketOrderIDRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *BracketOrderIDRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+// --- Market Data ---
+// For LTP Mode
+type LTPData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	TradingSymbol string                 `protobuf:"bytes,2,opt,name=trading_symbol,json=tradingSymbol,proto3" json:"trading_symbol,omitempty"`
+	SymbolToken   string                 `protobuf:"bytes,3,opt,name=symbol_token,json=symbolToken,proto3" json:"symbol_token,omitempty"`
+	Ltp           float64                `protobuf:"fixed64,4,opt,name=ltp,proto3" json:"ltp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LTPData) Reset() {
+	*x = LTPData{}
+	mi := &file_broker_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LTPData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LTPData) ProtoMessage() {}
+
+func (x *LTPData) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LTPData.ProtoReflect.Descriptor instead.
+func (*LTPData) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *LTPData) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *LTPData) GetTradingSymbol() string {
+	if x != nil {
+		return x.TradingSymbol
+	}
+	return ""
+}
+
+func (x *LTPData) GetSymbolToken() string {
+	if x != nil {
+		return x.SymbolToken
+	}
+	return ""
+}
+
+func (x *LTPData) GetLtp() float64 {
+	if x != nil {
+		return x.Ltp
+	}
+	return 0
+}
+
+// For Depth (Buy/Sell Orders)
+type MarketDepthItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Orders        int32                  `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketDepthItem) Reset() {
+	*x = MarketDepthItem{}
+	mi := &file_broker_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketDepthItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDepthItem) ProtoMessage() {}
+
+func (x *MarketDepthItem) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDepthItem.ProtoReflect.Descriptor instead.
+func (*MarketDepthItem) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *MarketDepthItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MarketDepthItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MarketDepthItem) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+type MarketDepth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buy           []*MarketDepthItem     `protobuf:"bytes,1,rep,name=buy,proto3" json:"buy,omitempty"`
+	Sell          []*MarketDepthItem     `protobuf:"bytes,2,rep,name=sell,proto3" json:"sell,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketDepth) Reset() {
+	*x = MarketDepth{}
+	mi := &file_broker_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketDepth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDepth) ProtoMessage() {}
+
+func (x *MarketDepth) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketDepth.ProtoReflect.Descriptor instead.
+func (*MarketDepth) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *MarketDepth) GetBuy() []*MarketDepthItem {
+	if x != nil {
+		return x.Buy
+	}
+	return nil
+}
+
+func (x *MarketDepth) GetSell() []*MarketDepthItem {
+	if x != nil {
+		return x.Sell
+	}
+	return nil
+}
+
+// For Full Quote Mode
+type FullQuoteData struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	TradingSymbol    string                 `protobuf:"bytes,2,opt,name=trading_symbol,json=tradingSymbol,proto3" json:"trading_symbol,omitempty"`
+	SymbolToken      string                 `protobuf:"bytes,3,opt,name=symbol_token,json=symbolToken,proto3" json:"symbol_token,omitempty"`
+	Ltp              float64                `protobuf:"fixed64,4,opt,name=ltp,proto3" json:"ltp,omitempty"`
+	Open             float64                `protobuf:"fixed64,5,opt,name=open,proto3" json:"open,omitempty"`
+	High             float64                `protobuf:"fixed64,6,opt,name=high,proto3" json:"high,omitempty"`
+	Low              float64                `protobuf:"fixed64,7,opt,name=low,proto3" json:"low,omitempty"`
+	Close            float64                `protobuf:"fixed64,8,opt,name=close,proto3" json:"close,omitempty"`
+	LastTradeQty     int64                  `protobuf:"varint,9,opt,name=last_trade_qty,json=lastTradeQty,proto3" json:"last_trade_qty,omitempty"`               // from lastTradeQty
+	ExchFeedTime     string                 `protobuf:"bytes,10,opt,name=exch_feed_time,json=exchFeedTime,proto3" json:"exch_feed_time,omitempty"`               // from exchFeedTime
+	ExchTradeTime    string                 `protobuf:"bytes,11,opt,name=exch_trade_time,json=exchTradeTime,proto3" json:"exch_trade_time,omitempty"`            // from exchTradeTime
+	NetChange        float64                `protobuf:"fixed64,12,opt,name=net_change,json=netChange,proto3" json:"net_change,omitempty"`                        // from netChange
+	PercentChange    float64                `protobuf:"fixed64,13,opt,name=percent_change,json=percentChange,proto3" json:"percent_change,omitempty"`            // from percentChange
+	AvgPrice         float64                `protobuf:"fixed64,14,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`                           // from avgPrice
+	TradeVolume      int64                  `protobuf:"varint,15,opt,name=trade_volume,json=tradeVolume,proto3" json:"trade_volume,omitempty"`                   // from tradeVolume
+	OpnInterest      int64                  `protobuf:"varint,16,opt,name=opn_interest,json=opnInterest,proto3" json:"opn_interest,omitempty"`                   // from opnInterest
+	LowerCircuit     float64                `protobuf:"fixed64,17,opt,name=lower_circuit,json=lowerCircuit,proto3" json:"lower_circuit,omitempty"`               // from lowerCircuit
+	UpperCircuit     float64                `protobuf:"fixed64,18,opt,name=upper_circuit,json=upperCircuit,proto3" json:"upper_circuit,omitempty"`               // from upperCircuit
+	TotBuyQuan       int64                  `protobuf:"varint,19,opt,name=tot_buy_quan,json=totBuyQuan,proto3" json:"tot_buy_quan,omitempty"`                    // from totBuyQuan
+	TotSellQuan      int64                  `protobuf:"varint,20,opt,name=tot_sell_quan,json=totSellQuan,proto3" json:"tot_sell_quan,omitempty"`                 // from totSellQuan
+	FiftyTwoWeekLow  string                 `protobuf:"bytes,21,opt,name=fifty_two_week_low,json=fiftyTwoWeekLow,proto3" json:"fifty_two_week_low,omitempty"`    // from 52WeekLow (Angel sends as string/number, safer as string)
+	FiftyTwoWeekHigh string                 `protobuf:"bytes,22,opt,name=fifty_two_week_high,json=fiftyTwoWeekHigh,proto3" json:"fifty_two_week_high,omitempty"` // from 52WeekHigh (Angel sends as string/number, safer as string)
+	Depth            *MarketDepth           `protobuf:"bytes,23,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FullQuoteData) Reset() {
+	*x = FullQuoteData{}
+	mi := &file_broker_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FullQuoteData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FullQuoteData) ProtoMessage() {}
+
+func (x *FullQuoteData) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FullQuoteData.ProtoReflect.Descriptor instead.
+func (*FullQuoteData) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *FullQuoteData) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FullQuoteData) GetTradingSymbol() string {
+	if x != nil {
+		return x.TradingSymbol
+	}
+	return ""
+}
+
+func (x *FullQuoteData) GetSymbolToken() string {
+	if x != nil {
+		return x.SymbolToken
+	}
+	return ""
+}
+
+func (x *FullQuoteData) GetLtp() float64 {
+	if x != nil {
+		return x.Ltp
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetLastTradeQty() int64 {
+	if x != nil {
+		return x.LastTradeQty
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetExchFeedTime() string {
+	if x != nil {
+		return x.ExchFeedTime
+	}
+	return ""
+}
+
+func (x *FullQuoteData) GetExchTradeTime() string {
+	if x != nil {
+		return x.ExchTradeTime
+	}
+	return ""
+}
+
+func (x *FullQuoteData) GetNetChange() float64 {
+	if x != nil {
+		return x.NetChange
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetPercentChange() float64 {
+	if x != nil {
+		return x.PercentChange
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetAvgPrice() float64 {
+	if x != nil {
+		return x.AvgPrice
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetTradeVolume() int64 {
+	if x != nil {
+		return x.TradeVolume
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetOpnInterest() int64 {
+	if x != nil {
+		return x.OpnInterest
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetLowerCircuit() float64 {
+	if x != nil {
+		return x.LowerCircuit
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetUpperCircuit() float64 {
+	if x != nil {
+		return x.UpperCircuit
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetTotBuyQuan() int64 {
+	if x != nil {
+		return x.TotBuyQuan
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetTotSellQuan() int64 {
+	if x != nil {
+		return x.TotSellQuan
+	}
+	return 0
+}
+
+func (x *FullQuoteData) GetFiftyTwoWeekLow() string {
+	if x != nil {
+		return x.FiftyTwoWeekLow
+	}
+	return ""
+}
+
+func (x *FullQuoteData) GetFiftyTwoWeekHigh() string {
+	if x != nil {
+		return x.FiftyTwoWeekHigh
+	}
+	return ""
+}
+
+func (x *FullQuoteData) GetDepth() *MarketDepth {
+	if x != nil {
+		return x.Depth
+	}
+	return nil
+}
+
+// Common structure for unfetched items
+type UnfetchedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	SymbolToken   string                 `protobuf:"bytes,2,opt,name=symbol_token,json=symbolToken,proto3" json:"symbol_token,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"` // from errorCode
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfetchedItem) Reset() {
+	*x = UnfetchedItem{}
+	mi := &file_broker_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfetchedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfetchedItem) ProtoMessage() {}
+
+func (x *UnfetchedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfetchedItem.ProtoReflect.Descriptor instead.
+func (*UnfetchedItem) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UnfetchedItem) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *UnfetchedItem) GetSymbolToken() string {
+	if x != nil {
+		return x.SymbolToken
+	}
+	return ""
+}
+
+func (x *UnfetchedItem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnfetchedItem) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+// --- GetLTP ---
+type GetLTPRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	// Angel One uses "exchangeTokens": {"NSE": ["3045", "token2"], "BSE": ["token3"]}
+	// We'll keep using ExchangeTokenPair for this structure.
+	ExchangeTokens []*ExchangeTokenPair `protobuf:"bytes,2,rep,name=exchange_tokens,json=exchangeTokens,proto3" json:"exchange_tokens,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLTPRequest) Reset() {
+	*x = GetLTPRequest{}
+	mi := &file_broker_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLTPRequest) ProtoMessage() {}
+
+func (x *GetLTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLTPRequest.ProtoReflect.Descriptor instead.
+func (*GetLTPRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetLTPRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *GetLTPRequest) GetExchangeTokens() []*ExchangeTokenPair {
+	if x != nil {
+		return x.ExchangeTokens
+	}
+	return nil
+}
+
+func (x *GetLTPRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *GetLTPRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *GetLTPRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type ExchangeTokenPair struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Tokens         []string               `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Tradingsymbols []string               `protobuf:"bytes,3,rep,name=tradingsymbols,proto3" json:"tradingsymbols,omitempty"` // e.g. SBIN-EQ; resolved to tokens by the broker service
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExchangeTokenPair) Reset() {
+	*x = ExchangeTokenPair{}
+	mi := &file_broker_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeTokenPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeTokenPair) ProtoMessage() {}
+
+func (x *ExchangeTokenPair) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeTokenPair.ProtoReflect.Descriptor instead.
+func (*ExchangeTokenPair) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ExchangeTokenPair) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ExchangeTokenPair) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *ExchangeTokenPair) GetTradingsymbols() []string {
+	if x != nil {
+		return x.Tradingsymbols
+	}
+	return nil
 }
 
-type CancelOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
-	Data          *CancelOrderAngelData  `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+type GetLTPResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Status        bool                            `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                          `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *GetLTPResponse_LTPResponseData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelOrderResponse) Reset() {
-	*x = CancelOrderResponse{}
-	mi := &file_broker_proto_msgTypes[8]
+func (x *GetLTPResponse) Reset() {
+	*x = GetLTPResponse{}
+	mi := &file_broker_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelOrderResponse) String() string {
+func (x *GetLTPResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelOrderResponse) ProtoMessage() {}
+func (*GetLTPResponse) ProtoMessage() {}
 
-func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[8]
+func (x *GetLTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,98 +9270,69 @@ func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
-func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetLTPResponse.ProtoReflect.Descriptor instead.
+func (*GetLTPResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *CancelOrderResponse) GetStatus() bool {
+func (x *GetLTPResponse) GetStatus() bool {
 	if x != nil {
 		return x.Status
 	}
 	return false
 }
 
-func (x *CancelOrderResponse) GetMessage() string {
+func (x *GetLTPResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *CancelOrderResponse) GetErrorcode() string {
+func (x *GetLTPResponse) GetErrorcode() string {
 	if x != nil {
 		return x.Errorcode
 	}
 	return ""
 }
 
-func (x *CancelOrderResponse) GetData() *CancelOrderAngelData {
+func (x *GetLTPResponse) GetData() *GetLTPResponse_LTPResponseData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// --- Order Book ---
-type OrderBookItem struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Variety             string                 `protobuf:"bytes,1,opt,name=variety,proto3" json:"variety,omitempty"`
-	Ordertype           string                 `protobuf:"bytes,2,opt,name=ordertype,proto3" json:"ordertype,omitempty"`
-	Producttype         string                 `protobuf:"bytes,3,opt,name=producttype,proto3" json:"producttype,omitempty"`
-	Duration            string                 `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
-	Price               float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	Triggerprice        float64                `protobuf:"fixed64,6,opt,name=triggerprice,proto3" json:"triggerprice,omitempty"`
-	Quantity            string                 `protobuf:"bytes,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Disclosedquantity   string                 `protobuf:"bytes,8,opt,name=disclosedquantity,proto3" json:"disclosedquantity,omitempty"`
-	Squareoff           float64                `protobuf:"fixed64,9,opt,name=squareoff,proto3" json:"squareoff,omitempty"`
-	Stoploss            float64                `protobuf:"fixed64,10,opt,name=stoploss,proto3" json:"stoploss,omitempty"`
-	Trailingstoploss    float64                `protobuf:"fixed64,11,opt,name=trailingstoploss,proto3" json:"trailingstoploss,omitempty"`
-	Tradingsymbol       string                 `protobuf:"bytes,12,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
-	Transactiontype     string                 `protobuf:"bytes,13,opt,name=transactiontype,proto3" json:"transactiontype,omitempty"`
-	Exchange            string                 `protobuf:"bytes,14,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Symboltoken         string                 `protobuf:"bytes,15,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
-	Instrumenttype      string                 `protobuf:"bytes,16,opt,name=instrumenttype,proto3" json:"instrumenttype,omitempty"`
-	Strikeprice         float64                `protobuf:"fixed64,17,opt,name=strikeprice,proto3" json:"strikeprice,omitempty"`
-	Optiontype          string                 `protobuf:"bytes,18,opt,name=optiontype,proto3" json:"optiontype,omitempty"`
-	Expirydate          string                 `protobuf:"bytes,19,opt,name=expirydate,proto3" json:"expirydate,omitempty"`
-	Lotsize             string                 `protobuf:"bytes,20,opt,name=lotsize,proto3" json:"lotsize,omitempty"`
-	Cancelsize          string                 `protobuf:"bytes,21,opt,name=cancelsize,proto3" json:"cancelsize,omitempty"`
-	Averageprice        float64                `protobuf:"fixed64,22,opt,name=averageprice,proto3" json:"averageprice,omitempty"`
-	Filledshares        string                 `protobuf:"bytes,23,opt,name=filledshares,proto3" json:"filledshares,omitempty"`
-	Unfilledshares      string                 `protobuf:"bytes,24,opt,name=unfilledshares,proto3" json:"unfilledshares,omitempty"`
-	Orderid             string                 `protobuf:"bytes,25,opt,name=orderid,proto3" json:"orderid,omitempty"`
-	Text                string                 `protobuf:"bytes,26,opt,name=text,proto3" json:"text,omitempty"`
-	Status              string                 `protobuf:"bytes,27,opt,name=status,proto3" json:"status,omitempty"`
-	Orderstatus         string                 `protobuf:"bytes,28,opt,name=orderstatus,proto3" json:"orderstatus,omitempty"`
-	Updatetime          string                 `protobuf:"bytes,29,opt,name=updatetime,proto3" json:"updatetime,omitempty"`
-	Exchtime            string                 `protobuf:"bytes,30,opt,name=exchtime,proto3" json:"exchtime,omitempty"`
-	Exchorderupdatetime string                 `protobuf:"bytes,31,opt,name=exchorderupdatetime,proto3" json:"exchorderupdatetime,omitempty"`
-	Fillid              string                 `protobuf:"bytes,32,opt,name=fillid,proto3" json:"fillid,omitempty"`
-	Filltime            string                 `protobuf:"bytes,33,opt,name=filltime,proto3" json:"filltime,omitempty"`
-	Parentorderid       string                 `protobuf:"bytes,34,opt,name=parentorderid,proto3" json:"parentorderid,omitempty"`
-	Uniqueorderid       string                 `protobuf:"bytes,35,opt,name=uniqueorderid,proto3" json:"uniqueorderid,omitempty"`
-	Exchangeorderid     string                 `protobuf:"bytes,36,opt,name=exchangeorderid,proto3" json:"exchangeorderid,omitempty"`
-	Ordertag            string                 `protobuf:"bytes,37,opt,name=ordertag,proto3" json:"ordertag,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetCandleDataRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt    string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	Exchange       string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Symboltoken    string                 `protobuf:"bytes,3,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Interval       CandleInterval         `protobuf:"varint,4,opt,name=interval,proto3,enum=broker.CandleInterval" json:"interval,omitempty"`
+	Fromdate       string                 `protobuf:"bytes,5,opt,name=fromdate,proto3" json:"fromdate,omitempty"` // "yyyy-MM-dd HH:mm" in IST; ranges longer than Angel One's window for the interval are split
+	Todate         string                 `protobuf:"bytes,6,opt,name=todate,proto3" json:"todate,omitempty"`     // "yyyy-MM-dd HH:mm" in IST
+	ClientLocalIp  string                 `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string                 `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string                 `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *OrderBookItem) Reset() {
-	*x = OrderBookItem{}
-	mi := &file_broker_proto_msgTypes[9]
+func (x *GetCandleDataRequest) Reset() {
+	*x = GetCandleDataRequest{}
+	mi := &file_broker_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderBookItem) String() string {
+func (x *GetCandleDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderBookItem) ProtoMessage() {}
+func (*GetCandleDataRequest) ProtoMessage() {}
 
-func (x *OrderBookItem) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[9]
+func (x *GetCandleDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,296 +9343,384 @@ func (x *OrderBookItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderBookItem.ProtoReflect.Descriptor instead.
-func (*OrderBookItem) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetCandleDataRequest.ProtoReflect.Descriptor instead.
+func (*GetCandleDataRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *OrderBookItem) GetVariety() string {
+func (x *GetCandleDataRequest) GetAngelOneJwt() string {
 	if x != nil {
-		return x.Variety
+		return x.AngelOneJwt
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetOrdertype() string {
+func (x *GetCandleDataRequest) GetExchange() string {
 	if x != nil {
-		return x.Ordertype
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetProducttype() string {
+func (x *GetCandleDataRequest) GetSymboltoken() string {
 	if x != nil {
-		return x.Producttype
+		return x.Symboltoken
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetDuration() string {
+func (x *GetCandleDataRequest) GetInterval() CandleInterval {
 	if x != nil {
-		return x.Duration
+		return x.Interval
 	}
-	return ""
+	return CandleInterval_CANDLE_INTERVAL_UNSPECIFIED
 }
 
-func (x *OrderBookItem) GetPrice() float64 {
+func (x *GetCandleDataRequest) GetFromdate() string {
 	if x != nil {
-		return x.Price
+		return x.Fromdate
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderBookItem) GetTriggerprice() float64 {
+func (x *GetCandleDataRequest) GetTodate() string {
 	if x != nil {
-		return x.Triggerprice
+		return x.Todate
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderBookItem) GetQuantity() string {
+func (x *GetCandleDataRequest) GetClientLocalIp() string {
 	if x != nil {
-		return x.Quantity
+		return x.ClientLocalIp
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetDisclosedquantity() string {
+func (x *GetCandleDataRequest) GetClientPublicIp() string {
 	if x != nil {
-		return x.Disclosedquantity
+		return x.ClientPublicIp
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetSquareoff() float64 {
+func (x *GetCandleDataRequest) GetMacAddress() string {
 	if x != nil {
-		return x.Squareoff
+		return x.MacAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderBookItem) GetStoploss() float64 {
-	if x != nil {
-		return x.Stoploss
-	}
-	return 0
+type Candle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Candle start, RFC 3339 with IST offset as sent by Angel One
+	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        int64                  `protobuf:"varint,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderBookItem) GetTrailingstoploss() float64 {
-	if x != nil {
-		return x.Trailingstoploss
-	}
-	return 0
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_broker_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderBookItem) GetTradingsymbol() string {
-	if x != nil {
-		return x.Tradingsymbol
-	}
-	return ""
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OrderBookItem) GetTransactiontype() string {
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[97]
 	if x != nil {
-		return x.Transactiontype
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OrderBookItem) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *OrderBookItem) GetSymboltoken() string {
+func (x *Candle) GetTimestamp() string {
 	if x != nil {
-		return x.Symboltoken
+		return x.Timestamp
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetInstrumenttype() string {
+func (x *Candle) GetOpen() float64 {
 	if x != nil {
-		return x.Instrumenttype
+		return x.Open
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderBookItem) GetStrikeprice() float64 {
+func (x *Candle) GetHigh() float64 {
 	if x != nil {
-		return x.Strikeprice
+		return x.High
 	}
 	return 0
 }
 
-func (x *OrderBookItem) GetOptiontype() string {
+func (x *Candle) GetLow() float64 {
 	if x != nil {
-		return x.Optiontype
+		return x.Low
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderBookItem) GetExpirydate() string {
+func (x *Candle) GetClose() float64 {
 	if x != nil {
-		return x.Expirydate
+		return x.Close
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderBookItem) GetLotsize() string {
+func (x *Candle) GetVolume() int64 {
 	if x != nil {
-		return x.Lotsize
+		return x.Volume
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderBookItem) GetCancelsize() string {
+type GetCandleDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*Candle              `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Oldest first, without duplicates
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCandleDataResponse) Reset() {
+	*x = GetCandleDataResponse{}
+	mi := &file_broker_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCandleDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandleDataResponse) ProtoMessage() {}
+
+func (x *GetCandleDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[98]
 	if x != nil {
-		return x.Cancelsize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OrderBookItem) GetAverageprice() float64 {
+// Deprecated: Use GetCandleDataResponse.ProtoReflect.Descriptor instead.
+func (*GetCandleDataResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetCandleDataResponse) GetStatus() bool {
 	if x != nil {
-		return x.Averageprice
+		return x.Status
 	}
-	return 0
+	return false
 }
 
-func (x *OrderBookItem) GetFilledshares() string {
+func (x *GetCandleDataResponse) GetMessage() string {
 	if x != nil {
-		return x.Filledshares
+		return x.Message
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetUnfilledshares() string {
+func (x *GetCandleDataResponse) GetErrorcode() string {
 	if x != nil {
-		return x.Unfilledshares
+		return x.Errorcode
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetOrderid() string {
+func (x *GetCandleDataResponse) GetData() []*Candle {
 	if x != nil {
-		return x.Orderid
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderBookItem) GetText() string {
+// --- Candle Cache (admin) ---
+type CandleSeriesStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Symboltoken   string                 `protobuf:"bytes,2,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Interval      CandleInterval         `protobuf:"varint,3,opt,name=interval,proto3,enum=broker.CandleInterval" json:"interval,omitempty"`
+	Candles       int64                  `protobuf:"varint,4,opt,name=candles,proto3" json:"candles,omitempty"`
+	First         string                 `protobuf:"bytes,5,opt,name=first,proto3" json:"first,omitempty"`                                       // Oldest cached candle, RFC 3339
+	Last          string                 `protobuf:"bytes,6,opt,name=last,proto3" json:"last,omitempty"`                                         // Newest cached candle, RFC 3339
+	CoveredRanges int32                  `protobuf:"varint,7,opt,name=covered_ranges,json=coveredRanges,proto3" json:"covered_ranges,omitempty"` // Disjoint ranges fetched from Angel One
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CandleSeriesStats) Reset() {
+	*x = CandleSeriesStats{}
+	mi := &file_broker_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CandleSeriesStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandleSeriesStats) ProtoMessage() {}
+
+func (x *CandleSeriesStats) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[99]
 	if x != nil {
-		return x.Text
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandleSeriesStats.ProtoReflect.Descriptor instead.
+func (*CandleSeriesStats) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *OrderBookItem) GetStatus() string {
+func (x *CandleSeriesStats) GetExchange() string {
 	if x != nil {
-		return x.Status
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetOrderstatus() string {
+func (x *CandleSeriesStats) GetSymboltoken() string {
 	if x != nil {
-		return x.Orderstatus
+		return x.Symboltoken
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetUpdatetime() string {
+func (x *CandleSeriesStats) GetInterval() CandleInterval {
 	if x != nil {
-		return x.Updatetime
+		return x.Interval
 	}
-	return ""
+	return CandleInterval_CANDLE_INTERVAL_UNSPECIFIED
 }
 
-func (x *OrderBookItem) GetExchtime() string {
+func (x *CandleSeriesStats) GetCandles() int64 {
 	if x != nil {
-		return x.Exchtime
+		return x.Candles
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderBookItem) GetExchorderupdatetime() string {
+func (x *CandleSeriesStats) GetFirst() string {
 	if x != nil {
-		return x.Exchorderupdatetime
+		return x.First
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetFillid() string {
+func (x *CandleSeriesStats) GetLast() string {
 	if x != nil {
-		return x.Fillid
+		return x.Last
 	}
 	return ""
 }
 
-func (x *OrderBookItem) GetFilltime() string {
+func (x *CandleSeriesStats) GetCoveredRanges() int32 {
 	if x != nil {
-		return x.Filltime
+		return x.CoveredRanges
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderBookItem) GetParentorderid() string {
-	if x != nil {
-		return x.Parentorderid
-	}
-	return ""
+type GetCandleCacheStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderBookItem) GetUniqueorderid() string {
-	if x != nil {
-		return x.Uniqueorderid
-	}
-	return ""
+func (x *GetCandleCacheStatsRequest) Reset() {
+	*x = GetCandleCacheStatsRequest{}
+	mi := &file_broker_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderBookItem) GetExchangeorderid() string {
-	if x != nil {
-		return x.Exchangeorderid
-	}
-	return ""
+func (x *GetCandleCacheStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OrderBookItem) GetOrdertag() string {
+func (*GetCandleCacheStatsRequest) ProtoMessage() {}
+
+func (x *GetCandleCacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[100]
 	if x != nil {
-		return x.Ordertag
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type GetOrderBookRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
-	// Headers
-	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
-	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
-	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Deprecated: Use GetCandleCacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCandleCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetOrderBookRequest) Reset() {
-	*x = GetOrderBookRequest{}
-	mi := &file_broker_proto_msgTypes[10]
+type GetCandleCacheStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Series        int64                  `protobuf:"varint,2,opt,name=series,proto3" json:"series,omitempty"`
+	Candles       int64                  `protobuf:"varint,3,opt,name=candles,proto3" json:"candles,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Hits          int64                  `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`                                  // Requests served entirely from the cache
+	PartialHits   int64                  `protobuf:"varint,6,opt,name=partial_hits,json=partialHits,proto3" json:"partial_hits,omitempty"` // Requests that needed some ranges from Angel One
+	Misses        int64                  `protobuf:"varint,7,opt,name=misses,proto3" json:"misses,omitempty"`                              // Requests with nothing cached
+	SeriesStats   []*CandleSeriesStats   `protobuf:"bytes,8,rep,name=series_stats,json=seriesStats,proto3" json:"series_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCandleCacheStatsResponse) Reset() {
+	*x = GetCandleCacheStatsResponse{}
+	mi := &file_broker_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderBookRequest) String() string {
+func (x *GetCandleCacheStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderBookRequest) ProtoMessage() {}
+func (*GetCandleCacheStatsResponse) ProtoMessage() {}
 
-func (x *GetOrderBookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[10]
+func (x *GetCandleCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,64 +9731,90 @@ func (x *GetOrderBookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderBookRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderBookRequest) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetCandleCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCandleCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetOrderBookRequest) GetAngelOneJwt() string {
+func (x *GetCandleCacheStatsResponse) GetEnabled() bool {
 	if x != nil {
-		return x.AngelOneJwt
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *GetOrderBookRequest) GetClientLocalIp() string {
+func (x *GetCandleCacheStatsResponse) GetSeries() int64 {
 	if x != nil {
-		return x.ClientLocalIp
+		return x.Series
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderBookRequest) GetClientPublicIp() string {
+func (x *GetCandleCacheStatsResponse) GetCandles() int64 {
 	if x != nil {
-		return x.ClientPublicIp
+		return x.Candles
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderBookRequest) GetMacAddress() string {
+func (x *GetCandleCacheStatsResponse) GetSizeBytes() int64 {
 	if x != nil {
-		return x.MacAddress
+		return x.SizeBytes
 	}
-	return ""
+	return 0
 }
 
-type GetOrderBookResponse struct {
+func (x *GetCandleCacheStatsResponse) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *GetCandleCacheStatsResponse) GetPartialHits() int64 {
+	if x != nil {
+		return x.PartialHits
+	}
+	return 0
+}
+
+func (x *GetCandleCacheStatsResponse) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *GetCandleCacheStatsResponse) GetSeriesStats() []*CandleSeriesStats {
+	if x != nil {
+		return x.SeriesStats
+	}
+	return nil
+}
+
+type PurgeCandleCacheRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
-	Data          []*OrderBookItem       `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Data is an array of order items, or null/empty if no orders
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Symboltoken   string                 `protobuf:"bytes,2,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderBookResponse) Reset() {
-	*x = GetOrderBookResponse{}
-	mi := &file_broker_proto_msgTypes[11]
+func (x *PurgeCandleCacheRequest) Reset() {
+	*x = PurgeCandleCacheRequest{}
+	mi := &file_broker_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderBookResponse) String() string {
+func (x *PurgeCandleCacheRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderBookResponse) ProtoMessage() {}
+func (*PurgeCandleCacheRequest) ProtoMessage() {}
 
-func (x *GetOrderBookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[11]
+func (x *PurgeCandleCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,86 +9825,48 @@ func (x *GetOrderBookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderBookResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderBookResponse) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *GetOrderBookResponse) GetStatus() bool {
-	if x != nil {
-		return x.Status
-	}
-	return false
+// Deprecated: Use PurgeCandleCacheRequest.ProtoReflect.Descriptor instead.
+func (*PurgeCandleCacheRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetOrderBookResponse) GetMessage() string {
+func (x *PurgeCandleCacheRequest) GetExchange() string {
 	if x != nil {
-		return x.Message
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderBookResponse) GetErrorcode() string {
+func (x *PurgeCandleCacheRequest) GetSymboltoken() string {
 	if x != nil {
-		return x.Errorcode
+		return x.Symboltoken
 	}
 	return ""
 }
 
-func (x *GetOrderBookResponse) GetData() []*OrderBookItem {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// --- Portfolio Holdings ---
-type HoldingItemData struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Tradingsymbol      string                 `protobuf:"bytes,1,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`
-	Exchange           string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Isin               string                 `protobuf:"bytes,3,opt,name=isin,proto3" json:"isin,omitempty"`
-	T1Quantity         int32                  `protobuf:"varint,4,opt,name=t1quantity,proto3" json:"t1quantity,omitempty"`                 // from JSON t1quantity
-	Realisedquantity   int32                  `protobuf:"varint,5,opt,name=realisedquantity,proto3" json:"realisedquantity,omitempty"`     // from JSON realisedquantity
-	Quantity           int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`                     // from JSON quantity
-	Authorisedquantity int32                  `protobuf:"varint,7,opt,name=authorisedquantity,proto3" json:"authorisedquantity,omitempty"` // from JSON authorisedquantity
-	Product            string                 `protobuf:"bytes,8,opt,name=product,proto3" json:"product,omitempty"`
-	// collateralquantity and collateraltype can be null, handle as optional or string
-	// For simplicity, using string and checking for null during unmarshal if needed,
-	// or use google.protobuf.Int32Value for nullable integers if you add it.
-	// Protobuf basic types are not inherently nullable in proto3 unless wrapped.
-	// For now, let's try int32 for collateralquantity and see if nulls are treated as 0.
-	// If Angel sends 'null', and Go's json unmarshaller tries to put it into int32, it will error.
-	// A safer bet for fields that can be 'null' is to use string or wrapper types.
-	// Let's assume for now `collateralquantity` will be 0 if null.
-	Collateralquantity int32   `protobuf:"varint,9,opt,name=collateralquantity,proto3" json:"collateralquantity,omitempty"` // from JSON collateralquantity (handle null)
-	Collateraltype     string  `protobuf:"bytes,10,opt,name=collateraltype,proto3" json:"collateraltype,omitempty"`         // from JSON collateraltype (handle null)
-	Haircut            float64 `protobuf:"fixed64,11,opt,name=haircut,proto3" json:"haircut,omitempty"`                     // from JSON haircut
-	Averageprice       float64 `protobuf:"fixed64,12,opt,name=averageprice,proto3" json:"averageprice,omitempty"`
-	Ltp                float64 `protobuf:"fixed64,13,opt,name=ltp,proto3" json:"ltp,omitempty"`
-	Symboltoken        string  `protobuf:"bytes,14,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
-	Close              float64 `protobuf:"fixed64,15,opt,name=close,proto3" json:"close,omitempty"` // JSON close is a number
-	Profitandloss      float64 `protobuf:"fixed64,16,opt,name=profitandloss,proto3" json:"profitandloss,omitempty"`
-	Pnlpercentage      float64 `protobuf:"fixed64,17,opt,name=pnlpercentage,proto3" json:"pnlpercentage,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type PurgeCandleCacheResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SeriesRemoved  int32                  `protobuf:"varint,1,opt,name=series_removed,json=seriesRemoved,proto3" json:"series_removed,omitempty"`
+	CandlesRemoved int64                  `protobuf:"varint,2,opt,name=candles_removed,json=candlesRemoved,proto3" json:"candles_removed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *HoldingItemData) Reset() {
-	*x = HoldingItemData{}
-	mi := &file_broker_proto_msgTypes[12]
+func (x *PurgeCandleCacheResponse) Reset() {
+	*x = PurgeCandleCacheResponse{}
+	mi := &file_broker_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HoldingItemData) String() string {
+func (x *PurgeCandleCacheResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HoldingItemData) ProtoMessage() {}
+func (*PurgeCandleCacheResponse) ProtoMessage() {}
 
-func (x *HoldingItemData) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[12]
+func (x *PurgeCandleCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,155 +9877,160 @@ func (x *HoldingItemData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HoldingItemData.ProtoReflect.Descriptor instead.
-func (*HoldingItemData) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PurgeCandleCacheResponse.ProtoReflect.Descriptor instead.
+func (*PurgeCandleCacheResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *HoldingItemData) GetTradingsymbol() string {
+func (x *PurgeCandleCacheResponse) GetSeriesRemoved() int32 {
 	if x != nil {
-		return x.Tradingsymbol
+		return x.SeriesRemoved
 	}
-	return ""
+	return 0
 }
 
-func (x *HoldingItemData) GetExchange() string {
+func (x *PurgeCandleCacheResponse) GetCandlesRemoved() int64 {
 	if x != nil {
-		return x.Exchange
+		return x.CandlesRemoved
 	}
-	return ""
+	return 0
 }
 
-func (x *HoldingItemData) GetIsin() string {
-	if x != nil {
-		return x.Isin
-	}
-	return ""
+// --- Instruments ---
+// From Angel One's instrument master (OpenAPIScripMaster), refreshed daily.
+type Instrument struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Symboltoken    string                 `protobuf:"bytes,1,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Tradingsymbol  string                 `protobuf:"bytes,2,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"`   // e.g. SBIN-EQ
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                     // Underlying name, e.g. SBIN
+	Exchange       string                 `protobuf:"bytes,4,opt,name=exchange,proto3" json:"exchange,omitempty"`             // NSE, BSE, NFO, MCX, ...
+	Instrumenttype string                 `protobuf:"bytes,5,opt,name=instrumenttype,proto3" json:"instrumenttype,omitempty"` // Empty for equities; OPTIDX, FUTSTK, ...
+	Expiry         string                 `protobuf:"bytes,6,opt,name=expiry,proto3" json:"expiry,omitempty"`                 // e.g. 27JUN2024; empty for equities
+	Strike         float64                `protobuf:"fixed64,7,opt,name=strike,proto3" json:"strike,omitempty"`               // In rupees; 0 when not an option
+	Lotsize        int32                  `protobuf:"varint,8,opt,name=lotsize,proto3" json:"lotsize,omitempty"`
+	Ticksize       float64                `protobuf:"fixed64,9,opt,name=ticksize,proto3" json:"ticksize,omitempty"` // In rupees
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *HoldingItemData) GetT1Quantity() int32 {
-	if x != nil {
-		return x.T1Quantity
-	}
-	return 0
+func (x *Instrument) Reset() {
+	*x = Instrument{}
+	mi := &file_broker_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *HoldingItemData) GetRealisedquantity() int32 {
-	if x != nil {
-		return x.Realisedquantity
-	}
-	return 0
+func (x *Instrument) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *HoldingItemData) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
+func (*Instrument) ProtoMessage() {}
 
-func (x *HoldingItemData) GetAuthorisedquantity() int32 {
+func (x *Instrument) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[104]
 	if x != nil {
-		return x.Authorisedquantity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *HoldingItemData) GetProduct() string {
-	if x != nil {
-		return x.Product
-	}
-	return ""
+// Deprecated: Use Instrument.ProtoReflect.Descriptor instead.
+func (*Instrument) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *HoldingItemData) GetCollateralquantity() int32 {
+func (x *Instrument) GetSymboltoken() string {
 	if x != nil {
-		return x.Collateralquantity
+		return x.Symboltoken
 	}
-	return 0
+	return ""
 }
 
-func (x *HoldingItemData) GetCollateraltype() string {
+func (x *Instrument) GetTradingsymbol() string {
 	if x != nil {
-		return x.Collateraltype
+		return x.Tradingsymbol
 	}
 	return ""
 }
 
-func (x *HoldingItemData) GetHaircut() float64 {
+func (x *Instrument) GetName() string {
 	if x != nil {
-		return x.Haircut
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *HoldingItemData) GetAverageprice() float64 {
+func (x *Instrument) GetExchange() string {
 	if x != nil {
-		return x.Averageprice
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *HoldingItemData) GetLtp() float64 {
+func (x *Instrument) GetInstrumenttype() string {
 	if x != nil {
-		return x.Ltp
+		return x.Instrumenttype
 	}
-	return 0
+	return ""
 }
 
-func (x *HoldingItemData) GetSymboltoken() string {
+func (x *Instrument) GetExpiry() string {
 	if x != nil {
-		return x.Symboltoken
+		return x.Expiry
 	}
 	return ""
 }
 
-func (x *HoldingItemData) GetClose() float64 {
+func (x *Instrument) GetStrike() float64 {
 	if x != nil {
-		return x.Close
+		return x.Strike
 	}
 	return 0
 }
 
-func (x *HoldingItemData) GetProfitandloss() float64 {
+func (x *Instrument) GetLotsize() int32 {
 	if x != nil {
-		return x.Profitandloss
+		return x.Lotsize
 	}
 	return 0
 }
 
-func (x *HoldingItemData) GetPnlpercentage() float64 {
+func (x *Instrument) GetTicksize() float64 {
 	if x != nil {
-		return x.Pnlpercentage
+		return x.Ticksize
 	}
 	return 0
 }
 
-type TotalHoldingValue struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Totalholdingvalue  float64                `protobuf:"fixed64,1,opt,name=totalholdingvalue,proto3" json:"totalholdingvalue,omitempty"`
-	Totalinvvalue      float64                `protobuf:"fixed64,2,opt,name=totalinvvalue,proto3" json:"totalinvvalue,omitempty"`
-	Totalprofitandloss float64                `protobuf:"fixed64,3,opt,name=totalprofitandloss,proto3" json:"totalprofitandloss,omitempty"`
-	Totalpnlpercentage float64                `protobuf:"fixed64,4,opt,name=totalpnlpercentage,proto3" json:"totalpnlpercentage,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type SearchInstrumentsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                   // Symbol or name; prefix and fuzzy matches are included
+	Exchange       string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`             // Optional filter
+	Instrumenttype string                 `protobuf:"bytes,3,opt,name=instrumenttype,proto3" json:"instrumenttype,omitempty"` // Optional filter
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                  // Defaults to 20, at most 100
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *TotalHoldingValue) Reset() {
-	*x = TotalHoldingValue{}
-	mi := &file_broker_proto_msgTypes[13]
+func (x *SearchInstrumentsRequest) Reset() {
+	*x = SearchInstrumentsRequest{}
+	mi := &file_broker_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TotalHoldingValue) String() string {
+func (x *SearchInstrumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TotalHoldingValue) ProtoMessage() {}
+func (*SearchInstrumentsRequest) ProtoMessage() {}
 
-func (x *TotalHoldingValue) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[13]
+func (x *SearchInstrumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,62 +10041,64 @@ func (x *TotalHoldingValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TotalHoldingValue.ProtoReflect.Descriptor instead.
-func (*TotalHoldingValue) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SearchInstrumentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchInstrumentsRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *TotalHoldingValue) GetTotalholdingvalue() float64 {
+func (x *SearchInstrumentsRequest) GetQuery() string {
 	if x != nil {
-		return x.Totalholdingvalue
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *TotalHoldingValue) GetTotalinvvalue() float64 {
+func (x *SearchInstrumentsRequest) GetExchange() string {
 	if x != nil {
-		return x.Totalinvvalue
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *TotalHoldingValue) GetTotalprofitandloss() float64 {
+func (x *SearchInstrumentsRequest) GetInstrumenttype() string {
 	if x != nil {
-		return x.Totalprofitandloss
+		return x.Instrumenttype
 	}
-	return 0
+	return ""
 }
 
-func (x *TotalHoldingValue) GetTotalpnlpercentage() float64 {
+func (x *SearchInstrumentsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Totalpnlpercentage
+		return x.Limit
 	}
 	return 0
 }
 
-type PortfolioHoldingsData struct {
+type SearchInstrumentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Holdings      []*HoldingItemData     `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty"`
-	Totalholding  *TotalHoldingValue     `protobuf:"bytes,2,opt,name=totalholding,proto3" json:"totalholding,omitempty"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          []*Instrument          `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"` // Best matches first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PortfolioHoldingsData) Reset() {
-	*x = PortfolioHoldingsData{}
-	mi := &file_broker_proto_msgTypes[14]
+func (x *SearchInstrumentsResponse) Reset() {
+	*x = SearchInstrumentsResponse{}
+	mi := &file_broker_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PortfolioHoldingsData) String() string {
+func (x *SearchInstrumentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortfolioHoldingsData) ProtoMessage() {}
+func (*SearchInstrumentsResponse) ProtoMessage() {}
 
-func (x *PortfolioHoldingsData) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[14]
+func (x *SearchInstrumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,50 +10109,62 @@ func (x *PortfolioHoldingsData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortfolioHoldingsData.ProtoReflect.Descriptor instead.
-func (*PortfolioHoldingsData) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SearchInstrumentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchInstrumentsResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *PortfolioHoldingsData) GetHoldings() []*HoldingItemData {
+func (x *SearchInstrumentsResponse) GetStatus() bool {
 	if x != nil {
-		return x.Holdings
+		return x.Status
 	}
-	return nil
+	return false
 }
 
-func (x *PortfolioHoldingsData) GetTotalholding() *TotalHoldingValue {
+func (x *SearchInstrumentsResponse) GetMessage() string {
 	if x != nil {
-		return x.Totalholding
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SearchInstrumentsResponse) GetErrorcode() string {
+	if x != nil {
+		return x.Errorcode
+	}
+	return ""
+}
+
+func (x *SearchInstrumentsResponse) GetData() []*Instrument {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-type GetHoldingsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	AngelOneJwt    string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
-	ClientLocalIp  string                 `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
-	ClientPublicIp string                 `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
-	MacAddress     string                 `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetInstrumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"` // Optional, but tokens are only unique per exchange
+	Symboltoken   string                 `protobuf:"bytes,2,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHoldingsRequest) Reset() {
-	*x = GetHoldingsRequest{}
-	mi := &file_broker_proto_msgTypes[15]
+func (x *GetInstrumentRequest) Reset() {
+	*x = GetInstrumentRequest{}
+	mi := &file_broker_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHoldingsRequest) String() string {
+func (x *GetInstrumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHoldingsRequest) ProtoMessage() {}
+func (*GetInstrumentRequest) ProtoMessage() {}
 
-func (x *GetHoldingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[15]
+func (x *GetInstrumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,64 +10175,50 @@ func (x *GetHoldingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHoldingsRequest.ProtoReflect.Descriptor instead.
-func (*GetHoldingsRequest) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetHoldingsRequest) GetAngelOneJwt() string {
-	if x != nil {
-		return x.AngelOneJwt
-	}
-	return ""
-}
-
-func (x *GetHoldingsRequest) GetClientLocalIp() string {
-	if x != nil {
-		return x.ClientLocalIp
-	}
-	return ""
+// Deprecated: Use GetInstrumentRequest.ProtoReflect.Descriptor instead.
+func (*GetInstrumentRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetHoldingsRequest) GetClientPublicIp() string {
+func (x *GetInstrumentRequest) GetExchange() string {
 	if x != nil {
-		return x.ClientPublicIp
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetHoldingsRequest) GetMacAddress() string {
+func (x *GetInstrumentRequest) GetSymboltoken() string {
 	if x != nil {
-		return x.MacAddress
+		return x.Symboltoken
 	}
 	return ""
 }
 
-type GetHoldingsResponse struct {
+type GetInstrumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
-	Data          *PortfolioHoldingsData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"` // <<< CHANGED to use the new wrapper
+	Data          *Instrument            `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHoldingsResponse) Reset() {
-	*x = GetHoldingsResponse{}
-	mi := &file_broker_proto_msgTypes[16]
+func (x *GetInstrumentResponse) Reset() {
+	*x = GetInstrumentResponse{}
+	mi := &file_broker_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHoldingsResponse) String() string {
+func (x *GetInstrumentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHoldingsResponse) ProtoMessage() {}
+func (*GetInstrumentResponse) ProtoMessage() {}
 
-func (x *GetHoldingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[16]
+func (x *GetInstrumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,66 +10229,63 @@ func (x *GetHoldingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHoldingsResponse.ProtoReflect.Descriptor instead.
-func (*GetHoldingsResponse) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetInstrumentResponse.ProtoReflect.Descriptor instead.
+func (*GetInstrumentResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetHoldingsResponse) GetStatus() bool {
+func (x *GetInstrumentResponse) GetStatus() bool {
 	if x != nil {
 		return x.Status
 	}
 	return false
 }
 
-func (x *GetHoldingsResponse) GetMessage() string {
+func (x *GetInstrumentResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetHoldingsResponse) GetErrorcode() string {
+func (x *GetInstrumentResponse) GetErrorcode() string {
 	if x != nil {
 		return x.Errorcode
 	}
 	return ""
 }
 
-func (x *GetHoldingsResponse) GetData() *PortfolioHoldingsData {
+func (x *GetInstrumentResponse) GetData() *Instrument {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// --- Market Data ---
-// For LTP Mode
-type LTPData struct {
+type TickInstrument struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	TradingSymbol string                 `protobuf:"bytes,2,opt,name=trading_symbol,json=tradingSymbol,proto3" json:"trading_symbol,omitempty"`
-	SymbolToken   string                 `protobuf:"bytes,3,opt,name=symbol_token,json=symbolToken,proto3" json:"symbol_token,omitempty"`
-	Ltp           float64                `protobuf:"fixed64,4,opt,name=ltp,proto3" json:"ltp,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"` // NSE, NFO, BSE, BFO, MCX, NCDEX or CDS
+	Symboltoken   string                 `protobuf:"bytes,2,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Tradingsymbol string                 `protobuf:"bytes,3,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"` // Used to look up symboltoken when it is empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LTPData) Reset() {
-	*x = LTPData{}
-	mi := &file_broker_proto_msgTypes[17]
+func (x *TickInstrument) Reset() {
+	*x = TickInstrument{}
+	mi := &file_broker_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LTPData) String() string {
+func (x *TickInstrument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LTPData) ProtoMessage() {}
+func (*TickInstrument) ProtoMessage() {}
 
-func (x *LTPData) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[17]
+func (x *TickInstrument) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,64 +10296,156 @@ func (x *LTPData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LTPData.ProtoReflect.Descriptor instead.
-func (*LTPData) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TickInstrument.ProtoReflect.Descriptor instead.
+func (*TickInstrument) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *LTPData) GetExchange() string {
+func (x *TickInstrument) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *LTPData) GetTradingSymbol() string {
+func (x *TickInstrument) GetSymboltoken() string {
 	if x != nil {
-		return x.TradingSymbol
+		return x.Symboltoken
 	}
 	return ""
 }
 
-func (x *LTPData) GetSymbolToken() string {
+func (x *TickInstrument) GetTradingsymbol() string {
 	if x != nil {
-		return x.SymbolToken
+		return x.Tradingsymbol
 	}
 	return ""
 }
 
-func (x *LTPData) GetLtp() float64 {
+type SubscribeTicksRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt    string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	FeedToken      string                 `protobuf:"bytes,2,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"`
+	ClientCode     string                 `protobuf:"bytes,3,opt,name=client_code,json=clientCode,proto3" json:"client_code,omitempty"` // Looked up from the profile when empty
+	Mode           TickMode               `protobuf:"varint,4,opt,name=mode,proto3,enum=broker.TickMode" json:"mode,omitempty"`
+	Instruments    []*TickInstrument      `protobuf:"bytes,5,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	ClientLocalIp  string                 `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string                 `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string                 `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscribeTicksRequest) Reset() {
+	*x = SubscribeTicksRequest{}
+	mi := &file_broker_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeTicksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeTicksRequest) ProtoMessage() {}
+
+func (x *SubscribeTicksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[110]
 	if x != nil {
-		return x.Ltp
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// For Depth (Buy/Sell Orders)
-type MarketDepthItem struct {
+// Deprecated: Use SubscribeTicksRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeTicksRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SubscribeTicksRequest) GetAngelOneJwt() string {
+	if x != nil {
+		return x.AngelOneJwt
+	}
+	return ""
+}
+
+func (x *SubscribeTicksRequest) GetFeedToken() string {
+	if x != nil {
+		return x.FeedToken
+	}
+	return ""
+}
+
+func (x *SubscribeTicksRequest) GetClientCode() string {
+	if x != nil {
+		return x.ClientCode
+	}
+	return ""
+}
+
+func (x *SubscribeTicksRequest) GetMode() TickMode {
+	if x != nil {
+		return x.Mode
+	}
+	return TickMode_TICK_MODE_UNSPECIFIED
+}
+
+func (x *SubscribeTicksRequest) GetInstruments() []*TickInstrument {
+	if x != nil {
+		return x.Instruments
+	}
+	return nil
+}
+
+func (x *SubscribeTicksRequest) GetClientLocalIp() string {
+	if x != nil {
+		return x.ClientLocalIp
+	}
+	return ""
+}
+
+func (x *SubscribeTicksRequest) GetClientPublicIp() string {
+	if x != nil {
+		return x.ClientPublicIp
+	}
+	return ""
+}
+
+func (x *SubscribeTicksRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+type DepthLevel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	Orders        int32                  `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarketDepthItem) Reset() {
-	*x = MarketDepthItem{}
-	mi := &file_broker_proto_msgTypes[18]
+func (x *DepthLevel) Reset() {
+	*x = DepthLevel{}
+	mi := &file_broker_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarketDepthItem) String() string {
+func (x *DepthLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketDepthItem) ProtoMessage() {}
+func (*DepthLevel) ProtoMessage() {}
 
-func (x *MarketDepthItem) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[18]
+func (x *DepthLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,55 +10456,80 @@ func (x *MarketDepthItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketDepthItem.ProtoReflect.Descriptor instead.
-func (*MarketDepthItem) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DepthLevel.ProtoReflect.Descriptor instead.
+func (*DepthLevel) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *MarketDepthItem) GetPrice() float64 {
+func (x *DepthLevel) GetPrice() float64 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-func (x *MarketDepthItem) GetQuantity() int32 {
+func (x *DepthLevel) GetQuantity() int64 {
 	if x != nil {
 		return x.Quantity
 	}
 	return 0
 }
 
-func (x *MarketDepthItem) GetOrders() int32 {
+func (x *DepthLevel) GetOrders() int32 {
 	if x != nil {
 		return x.Orders
 	}
 	return 0
 }
 
-type MarketDepth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Buy           []*MarketDepthItem     `protobuf:"bytes,1,rep,name=buy,proto3" json:"buy,omitempty"`
-	Sell          []*MarketDepthItem     `protobuf:"bytes,2,rep,name=sell,proto3" json:"sell,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Prices are in rupees.
+type Tick struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Mode              TickMode               `protobuf:"varint,1,opt,name=mode,proto3,enum=broker.TickMode" json:"mode,omitempty"`
+	Exchange          string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Symboltoken       string                 `protobuf:"bytes,3,opt,name=symboltoken,proto3" json:"symboltoken,omitempty"`
+	Sequence          int64                  `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ExchangeTimestamp int64                  `protobuf:"varint,5,opt,name=exchange_timestamp,json=exchangeTimestamp,proto3" json:"exchange_timestamp,omitempty"` // Unix milliseconds
+	Ltp               float64                `protobuf:"fixed64,6,opt,name=ltp,proto3" json:"ltp,omitempty"`
+	// QUOTE and SNAP_QUOTE
+	LastTradedQuantity int64   `protobuf:"varint,7,opt,name=last_traded_quantity,json=lastTradedQuantity,proto3" json:"last_traded_quantity,omitempty"`
+	AveragePrice       float64 `protobuf:"fixed64,8,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	Volume             int64   `protobuf:"varint,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	TotalBuyQuantity   float64 `protobuf:"fixed64,10,opt,name=total_buy_quantity,json=totalBuyQuantity,proto3" json:"total_buy_quantity,omitempty"`
+	TotalSellQuantity  float64 `protobuf:"fixed64,11,opt,name=total_sell_quantity,json=totalSellQuantity,proto3" json:"total_sell_quantity,omitempty"`
+	Open               float64 `protobuf:"fixed64,12,opt,name=open,proto3" json:"open,omitempty"`
+	High               float64 `protobuf:"fixed64,13,opt,name=high,proto3" json:"high,omitempty"`
+	Low                float64 `protobuf:"fixed64,14,opt,name=low,proto3" json:"low,omitempty"`
+	Close              float64 `protobuf:"fixed64,15,opt,name=close,proto3" json:"close,omitempty"`
+	// SNAP_QUOTE
+	LastTradedTimestamp int64         `protobuf:"varint,16,opt,name=last_traded_timestamp,json=lastTradedTimestamp,proto3" json:"last_traded_timestamp,omitempty"` // Unix seconds
+	OpenInterest        int64         `protobuf:"varint,17,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	BestBids            []*DepthLevel `protobuf:"bytes,18,rep,name=best_bids,json=bestBids,proto3" json:"best_bids,omitempty"`
+	BestAsks            []*DepthLevel `protobuf:"bytes,19,rep,name=best_asks,json=bestAsks,proto3" json:"best_asks,omitempty"`
+	UpperCircuit        float64       `protobuf:"fixed64,20,opt,name=upper_circuit,json=upperCircuit,proto3" json:"upper_circuit,omitempty"`
+	LowerCircuit        float64       `protobuf:"fixed64,21,opt,name=lower_circuit,json=lowerCircuit,proto3" json:"lower_circuit,omitempty"`
+	Week52High          float64       `protobuf:"fixed64,22,opt,name=week52_high,json=week52High,proto3" json:"week52_high,omitempty"`
+	Week52Low           float64       `protobuf:"fixed64,23,opt,name=week52_low,json=week52Low,proto3" json:"week52_low,omitempty"`
+	Tradingsymbol       string        `protobuf:"bytes,24,opt,name=tradingsymbol,proto3" json:"tradingsymbol,omitempty"` // Filled in when the instrument master knows the token
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *MarketDepth) Reset() {
-	*x = MarketDepth{}
-	mi := &file_broker_proto_msgTypes[19]
+func (x *Tick) Reset() {
+	*x = Tick{}
+	mi := &file_broker_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarketDepth) String() string {
+func (x *Tick) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketDepth) ProtoMessage() {}
+func (*Tick) ProtoMessage() {}
 
-func (x *MarketDepth) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[19]
+func (x *Tick) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,272 +10540,289 @@ func (x *MarketDepth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketDepth.ProtoReflect.Descriptor instead.
-func (*MarketDepth) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Tick.ProtoReflect.Descriptor instead.
+func (*Tick) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *MarketDepth) GetBuy() []*MarketDepthItem {
+func (x *Tick) GetMode() TickMode {
 	if x != nil {
-		return x.Buy
+		return x.Mode
 	}
-	return nil
+	return TickMode_TICK_MODE_UNSPECIFIED
 }
 
-func (x *MarketDepth) GetSell() []*MarketDepthItem {
+func (x *Tick) GetExchange() string {
 	if x != nil {
-		return x.Sell
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-// For Full Quote Mode
-type FullQuoteData struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	TradingSymbol    string                 `protobuf:"bytes,2,opt,name=trading_symbol,json=tradingSymbol,proto3" json:"trading_symbol,omitempty"`
-	SymbolToken      string                 `protobuf:"bytes,3,opt,name=symbol_token,json=symbolToken,proto3" json:"symbol_token,omitempty"`
-	Ltp              float64                `protobuf:"fixed64,4,opt,name=ltp,proto3" json:"ltp,omitempty"`
-	Open             float64                `protobuf:"fixed64,5,opt,name=open,proto3" json:"open,omitempty"`
-	High             float64                `protobuf:"fixed64,6,opt,name=high,proto3" json:"high,omitempty"`
-	Low              float64                `protobuf:"fixed64,7,opt,name=low,proto3" json:"low,omitempty"`
-	Close            float64                `protobuf:"fixed64,8,opt,name=close,proto3" json:"close,omitempty"`
-	LastTradeQty     int64                  `protobuf:"varint,9,opt,name=last_trade_qty,json=lastTradeQty,proto3" json:"last_trade_qty,omitempty"`               // from lastTradeQty
-	ExchFeedTime     string                 `protobuf:"bytes,10,opt,name=exch_feed_time,json=exchFeedTime,proto3" json:"exch_feed_time,omitempty"`               // from exchFeedTime
-	ExchTradeTime    string                 `protobuf:"bytes,11,opt,name=exch_trade_time,json=exchTradeTime,proto3" json:"exch_trade_time,omitempty"`            // from exchTradeTime
-	NetChange        float64                `protobuf:"fixed64,12,opt,name=net_change,json=netChange,proto3" json:"net_change,omitempty"`                        // from netChange
-	PercentChange    float64                `protobuf:"fixed64,13,opt,name=percent_change,json=percentChange,proto3" json:"percent_change,omitempty"`            // from percentChange
-	AvgPrice         float64                `protobuf:"fixed64,14,opt,name=avg_price,json=avgPrice,proto3" json:"avg_price,omitempty"`                           // from avgPrice
-	TradeVolume      int64                  `protobuf:"varint,15,opt,name=trade_volume,json=tradeVolume,proto3" json:"trade_volume,omitempty"`                   // from tradeVolume
-	OpnInterest      int64                  `protobuf:"varint,16,opt,name=opn_interest,json=opnInterest,proto3" json:"opn_interest,omitempty"`                   // from opnInterest
-	LowerCircuit     float64                `protobuf:"fixed64,17,opt,name=lower_circuit,json=lowerCircuit,proto3" json:"lower_circuit,omitempty"`               // from lowerCircuit
-	UpperCircuit     float64                `protobuf:"fixed64,18,opt,name=upper_circuit,json=upperCircuit,proto3" json:"upper_circuit,omitempty"`               // from upperCircuit
-	TotBuyQuan       int64                  `protobuf:"varint,19,opt,name=tot_buy_quan,json=totBuyQuan,proto3" json:"tot_buy_quan,omitempty"`                    // from totBuyQuan
-	TotSellQuan      int64                  `protobuf:"varint,20,opt,name=tot_sell_quan,json=totSellQuan,proto3" json:"tot_sell_quan,omitempty"`                 // from totSellQuan
-	FiftyTwoWeekLow  string                 `protobuf:"bytes,21,opt,name=fifty_two_week_low,json=fiftyTwoWeekLow,proto3" json:"fifty_two_week_low,omitempty"`    // from 52WeekLow (Angel sends as string/number, safer as string)
-	FiftyTwoWeekHigh string                 `protobuf:"bytes,22,opt,name=fifty_two_week_high,json=fiftyTwoWeekHigh,proto3" json:"fifty_two_week_high,omitempty"` // from 52WeekHigh (Angel sends as string/number, safer as string)
-	Depth            *MarketDepth           `protobuf:"bytes,23,opt,name=depth,proto3" json:"depth,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Tick) GetSymboltoken() string {
+	if x != nil {
+		return x.Symboltoken
+	}
+	return ""
 }
 
-func (x *FullQuoteData) Reset() {
-	*x = FullQuoteData{}
-	mi := &file_broker_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Tick) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
 }
 
-func (x *FullQuoteData) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Tick) GetExchangeTimestamp() int64 {
+	if x != nil {
+		return x.ExchangeTimestamp
+	}
+	return 0
 }
 
-func (*FullQuoteData) ProtoMessage() {}
-
-func (x *FullQuoteData) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[20]
+func (x *Tick) GetLtp() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Ltp
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use FullQuoteData.ProtoReflect.Descriptor instead.
-func (*FullQuoteData) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{20}
+func (x *Tick) GetLastTradedQuantity() int64 {
+	if x != nil {
+		return x.LastTradedQuantity
+	}
+	return 0
 }
 
-func (x *FullQuoteData) GetExchange() string {
+func (x *Tick) GetAveragePrice() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.AveragePrice
 	}
-	return ""
+	return 0
 }
 
-func (x *FullQuoteData) GetTradingSymbol() string {
+func (x *Tick) GetVolume() int64 {
 	if x != nil {
-		return x.TradingSymbol
+		return x.Volume
 	}
-	return ""
+	return 0
 }
 
-func (x *FullQuoteData) GetSymbolToken() string {
+func (x *Tick) GetTotalBuyQuantity() float64 {
 	if x != nil {
-		return x.SymbolToken
+		return x.TotalBuyQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *FullQuoteData) GetLtp() float64 {
+func (x *Tick) GetTotalSellQuantity() float64 {
 	if x != nil {
-		return x.Ltp
+		return x.TotalSellQuantity
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetOpen() float64 {
+func (x *Tick) GetOpen() float64 {
 	if x != nil {
 		return x.Open
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetHigh() float64 {
+func (x *Tick) GetHigh() float64 {
 	if x != nil {
 		return x.High
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetLow() float64 {
+func (x *Tick) GetLow() float64 {
 	if x != nil {
 		return x.Low
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetClose() float64 {
+func (x *Tick) GetClose() float64 {
 	if x != nil {
 		return x.Close
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetLastTradeQty() int64 {
+func (x *Tick) GetLastTradedTimestamp() int64 {
 	if x != nil {
-		return x.LastTradeQty
+		return x.LastTradedTimestamp
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetExchFeedTime() string {
+func (x *Tick) GetOpenInterest() int64 {
 	if x != nil {
-		return x.ExchFeedTime
+		return x.OpenInterest
 	}
-	return ""
+	return 0
 }
 
-func (x *FullQuoteData) GetExchTradeTime() string {
+func (x *Tick) GetBestBids() []*DepthLevel {
 	if x != nil {
-		return x.ExchTradeTime
+		return x.BestBids
 	}
-	return ""
+	return nil
 }
 
-func (x *FullQuoteData) GetNetChange() float64 {
+func (x *Tick) GetBestAsks() []*DepthLevel {
 	if x != nil {
-		return x.NetChange
+		return x.BestAsks
 	}
-	return 0
+	return nil
 }
 
-func (x *FullQuoteData) GetPercentChange() float64 {
+func (x *Tick) GetUpperCircuit() float64 {
 	if x != nil {
-		return x.PercentChange
+		return x.UpperCircuit
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetAvgPrice() float64 {
+func (x *Tick) GetLowerCircuit() float64 {
 	if x != nil {
-		return x.AvgPrice
+		return x.LowerCircuit
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetTradeVolume() int64 {
+func (x *Tick) GetWeek52High() float64 {
 	if x != nil {
-		return x.TradeVolume
+		return x.Week52High
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetOpnInterest() int64 {
+func (x *Tick) GetWeek52Low() float64 {
 	if x != nil {
-		return x.OpnInterest
+		return x.Week52Low
 	}
 	return 0
 }
 
-func (x *FullQuoteData) GetLowerCircuit() float64 {
+func (x *Tick) GetTradingsymbol() string {
 	if x != nil {
-		return x.LowerCircuit
+		return x.Tradingsymbol
 	}
-	return 0
+	return ""
 }
 
-func (x *FullQuoteData) GetUpperCircuit() float64 {
+// --- GetFullQuote ---
+// Angel One's /quote endpoint in docs seems to be for a single symbol token per request in POST body.
+// The example you gave: "exchangeTokens": {"NSE": ["3045"]} suggests it *can* take a map,
+// but their /getTradeInfo often implies single symbol for a "full quote".
+// Let's assume it can take multiple tokens per exchange like LTP for consistency,
+// and the URL "quote/" (plural) might imply that. If not, we'll adjust.
+// The doc example for /getQuote (https://smartapi.angelbroking.com/docs/MarketData#getquote) shows payload for multiple.
+type GetFullQuoteRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	// Uses the same exchange_tokens structure as GetLTPRequest
+	ExchangeTokens []*ExchangeTokenPair `protobuf:"bytes,2,rep,name=exchange_tokens,json=exchangeTokens,proto3" json:"exchange_tokens,omitempty"`
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFullQuoteRequest) Reset() {
+	*x = GetFullQuoteRequest{}
+	mi := &file_broker_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFullQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFullQuoteRequest) ProtoMessage() {}
+
+func (x *GetFullQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[113]
 	if x != nil {
-		return x.UpperCircuit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FullQuoteData) GetTotBuyQuan() int64 {
+// Deprecated: Use GetFullQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetFullQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetFullQuoteRequest) GetAngelOneJwt() string {
 	if x != nil {
-		return x.TotBuyQuan
+		return x.AngelOneJwt
 	}
-	return 0
+	return ""
 }
 
-func (x *FullQuoteData) GetTotSellQuan() int64 {
+func (x *GetFullQuoteRequest) GetExchangeTokens() []*ExchangeTokenPair {
 	if x != nil {
-		return x.TotSellQuan
+		return x.ExchangeTokens
 	}
-	return 0
+	return nil
 }
 
-func (x *FullQuoteData) GetFiftyTwoWeekLow() string {
+func (x *GetFullQuoteRequest) GetClientLocalIp() string {
 	if x != nil {
-		return x.FiftyTwoWeekLow
+		return x.ClientLocalIp
 	}
 	return ""
 }
 
-func (x *FullQuoteData) GetFiftyTwoWeekHigh() string {
+func (x *GetFullQuoteRequest) GetClientPublicIp() string {
 	if x != nil {
-		return x.FiftyTwoWeekHigh
+		return x.ClientPublicIp
 	}
 	return ""
 }
 
-func (x *FullQuoteData) GetDepth() *MarketDepth {
+func (x *GetFullQuoteRequest) GetMacAddress() string {
 	if x != nil {
-		return x.Depth
+		return x.MacAddress
 	}
-	return nil
+	return ""
 }
 
-// Common structure for unfetched items
-type UnfetchedItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	SymbolToken   string                 `protobuf:"bytes,2,opt,name=symbol_token,json=symbolToken,proto3" json:"symbol_token,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorCode     string                 `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"` // from errorCode
+type GetFullQuoteResponse struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	Status        bool                                        `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                                      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                                      `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *GetFullQuoteResponse_FullQuoteResponseData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfetchedItem) Reset() {
-	*x = UnfetchedItem{}
-	mi := &file_broker_proto_msgTypes[21]
+func (x *GetFullQuoteResponse) Reset() {
+	*x = GetFullQuoteResponse{}
+	mi := &file_broker_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfetchedItem) String() string {
+func (x *GetFullQuoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfetchedItem) ProtoMessage() {}
+func (*GetFullQuoteResponse) ProtoMessage() {}
 
-func (x *UnfetchedItem) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[21]
+func (x *GetFullQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2092,69 +10833,67 @@ func (x *UnfetchedItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfetchedItem.ProtoReflect.Descriptor instead.
-func (*UnfetchedItem) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetFullQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetFullQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *UnfetchedItem) GetExchange() string {
+func (x *GetFullQuoteResponse) GetStatus() bool {
 	if x != nil {
-		return x.Exchange
+		return x.Status
 	}
-	return ""
+	return false
 }
 
-func (x *UnfetchedItem) GetSymbolToken() string {
+func (x *GetFullQuoteResponse) GetMessage() string {
 	if x != nil {
-		return x.SymbolToken
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UnfetchedItem) GetMessage() string {
+func (x *GetFullQuoteResponse) GetErrorcode() string {
 	if x != nil {
-		return x.Message
+		return x.Errorcode
 	}
 	return ""
 }
 
-func (x *UnfetchedItem) GetErrorCode() string {
+func (x *GetFullQuoteResponse) GetData() *GetFullQuoteResponse_FullQuoteResponseData {
 	if x != nil {
-		return x.ErrorCode
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// --- GetLTP ---
-type GetLTPRequest struct {
+// --- Logout ---
+type LogoutRequest struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
-	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
-	// Angel One uses "exchangeTokens": {"NSE": ["3045", "token2"], "BSE": ["token3"]}
-	// We'll keep using ExchangeTokenPair for this structure.
-	ExchangeTokens []*ExchangeTokenPair `protobuf:"bytes,2,rep,name=exchange_tokens,json=exchangeTokens,proto3" json:"exchange_tokens,omitempty"`
-	// Headers
-	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
-	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
-	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"` // For Authorization header
+	ClientCode  string                 `protobuf:"bytes,2,opt,name=client_code,json=clientCode,proto3" json:"client_code,omitempty"`      // For the request body to Angel One
+	// Optional headers similar to GetProfile if needed by Angel One logout
+	ClientLocalIp  string `protobuf:"bytes,3,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,4,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,5,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetLTPRequest) Reset() {
-	*x = GetLTPRequest{}
-	mi := &file_broker_proto_msgTypes[22]
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_broker_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLTPRequest) String() string {
+func (x *LogoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLTPRequest) ProtoMessage() {}
+func (*LogoutRequest) ProtoMessage() {}
 
-func (x *GetLTPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[22]
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2165,69 +10904,70 @@ func (x *GetLTPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLTPRequest.ProtoReflect.Descriptor instead.
-func (*GetLTPRequest) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GetLTPRequest) GetAngelOneJwt() string {
+func (x *LogoutRequest) GetAngelOneJwt() string {
 	if x != nil {
 		return x.AngelOneJwt
 	}
 	return ""
 }
 
-func (x *GetLTPRequest) GetExchangeTokens() []*ExchangeTokenPair {
+func (x *LogoutRequest) GetClientCode() string {
 	if x != nil {
-		return x.ExchangeTokens
+		return x.ClientCode
 	}
-	return nil
+	return ""
 }
 
-func (x *GetLTPRequest) GetClientLocalIp() string {
+func (x *LogoutRequest) GetClientLocalIp() string {
 	if x != nil {
 		return x.ClientLocalIp
 	}
 	return ""
 }
 
-func (x *GetLTPRequest) GetClientPublicIp() string {
+func (x *LogoutRequest) GetClientPublicIp() string {
 	if x != nil {
 		return x.ClientPublicIp
 	}
 	return ""
 }
 
-func (x *GetLTPRequest) GetMacAddress() string {
+func (x *LogoutRequest) GetMacAddress() string {
 	if x != nil {
 		return x.MacAddress
 	}
 	return ""
 }
 
-type ExchangeTokenPair struct {
+type LogoutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Tokens        []string               `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`      // From Angel One response
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`     // From Angel One response
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"` // From Angel One response
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExchangeTokenPair) Reset() {
-	*x = ExchangeTokenPair{}
-	mi := &file_broker_proto_msgTypes[23]
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_broker_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExchangeTokenPair) String() string {
+func (x *LogoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExchangeTokenPair) ProtoMessage() {}
+func (*LogoutResponse) ProtoMessage() {}
 
-func (x *ExchangeTokenPair) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[23]
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2238,50 +10978,60 @@ func (x *ExchangeTokenPair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExchangeTokenPair.ProtoReflect.Descriptor instead.
-func (*ExchangeTokenPair) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *ExchangeTokenPair) GetExchange() string {
+func (x *LogoutResponse) GetStatus() bool {
 	if x != nil {
-		return x.Exchange
+		return x.Status
+	}
+	return false
+}
+
+func (x *LogoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ExchangeTokenPair) GetTokens() []string {
+func (x *LogoutResponse) GetErrorcode() string {
 	if x != nil {
-		return x.Tokens
+		return x.Errorcode
 	}
-	return nil
+	return ""
 }
 
-type GetLTPResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Status        bool                            `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Errorcode     string                          `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
-	Data          *GetLTPResponse_LTPResponseData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// --- Generate Tokens (session refresh) ---
+type GenerateTokensRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AngelOneJwt  string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`  // The (possibly expired) JWT, sent as Authorization header
+	RefreshToken string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // Refresh token received at login
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetLTPResponse) Reset() {
-	*x = GetLTPResponse{}
-	mi := &file_broker_proto_msgTypes[24]
+func (x *GenerateTokensRequest) Reset() {
+	*x = GenerateTokensRequest{}
+	mi := &file_broker_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLTPResponse) String() string {
+func (x *GenerateTokensRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLTPResponse) ProtoMessage() {}
+func (*GenerateTokensRequest) ProtoMessage() {}
 
-func (x *GetLTPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[24]
+func (x *GenerateTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2292,74 +11042,70 @@ func (x *GetLTPResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLTPResponse.ProtoReflect.Descriptor instead.
-func (*GetLTPResponse) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GenerateTokensRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTokensRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetLTPResponse) GetStatus() bool {
+func (x *GenerateTokensRequest) GetAngelOneJwt() string {
 	if x != nil {
-		return x.Status
+		return x.AngelOneJwt
 	}
-	return false
+	return ""
 }
 
-func (x *GetLTPResponse) GetMessage() string {
+func (x *GenerateTokensRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Message
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *GetLTPResponse) GetErrorcode() string {
+func (x *GenerateTokensRequest) GetClientLocalIp() string {
 	if x != nil {
-		return x.Errorcode
+		return x.ClientLocalIp
 	}
 	return ""
 }
 
-func (x *GetLTPResponse) GetData() *GetLTPResponse_LTPResponseData {
+func (x *GenerateTokensRequest) GetClientPublicIp() string {
 	if x != nil {
-		return x.Data
+		return x.ClientPublicIp
 	}
-	return nil
+	return ""
 }
 
-// --- GetFullQuote ---
-// Angel One's /quote endpoint in docs seems to be for a single symbol token per request in POST body.
-// The example you gave: "exchangeTokens": {"NSE": ["3045"]} suggests it *can* take a map,
-// but their /getTradeInfo often implies single symbol for a "full quote".
-// Let's assume it can take multiple tokens per exchange like LTP for consistency,
-// and the URL "quote/" (plural) might imply that. If not, we'll adjust.
-// The doc example for /getQuote (https://smartapi.angelbroking.com/docs/MarketData#getquote) shows payload for multiple.
-type GetFullQuoteRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
-	// Uses the same exchange_tokens structure as GetLTPRequest
-	ExchangeTokens []*ExchangeTokenPair `protobuf:"bytes,2,rep,name=exchange_tokens,json=exchangeTokens,proto3" json:"exchange_tokens,omitempty"`
-	// Headers
-	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
-	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
-	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GenerateTokensRequest) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
 }
 
-func (x *GetFullQuoteRequest) Reset() {
-	*x = GetFullQuoteRequest{}
-	mi := &file_broker_proto_msgTypes[25]
+type GenerateTokensAngelData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JwtToken      string                 `protobuf:"bytes,1,opt,name=jwt_token,json=jwtToken,proto3" json:"jwt_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	FeedToken     string                 `protobuf:"bytes,3,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTokensAngelData) Reset() {
+	*x = GenerateTokensAngelData{}
+	mi := &file_broker_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFullQuoteRequest) String() string {
+func (x *GenerateTokensAngelData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFullQuoteRequest) ProtoMessage() {}
+func (*GenerateTokensAngelData) ProtoMessage() {}
 
-func (x *GetFullQuoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[25]
+func (x *GenerateTokensAngelData) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,71 +11116,57 @@ func (x *GetFullQuoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFullQuoteRequest.ProtoReflect.Descriptor instead.
-func (*GetFullQuoteRequest) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *GetFullQuoteRequest) GetAngelOneJwt() string {
-	if x != nil {
-		return x.AngelOneJwt
-	}
-	return ""
-}
-
-func (x *GetFullQuoteRequest) GetExchangeTokens() []*ExchangeTokenPair {
-	if x != nil {
-		return x.ExchangeTokens
-	}
-	return nil
+// Deprecated: Use GenerateTokensAngelData.ProtoReflect.Descriptor instead.
+func (*GenerateTokensAngelData) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GetFullQuoteRequest) GetClientLocalIp() string {
+func (x *GenerateTokensAngelData) GetJwtToken() string {
 	if x != nil {
-		return x.ClientLocalIp
+		return x.JwtToken
 	}
 	return ""
 }
 
-func (x *GetFullQuoteRequest) GetClientPublicIp() string {
+func (x *GenerateTokensAngelData) GetRefreshToken() string {
 	if x != nil {
-		return x.ClientPublicIp
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *GetFullQuoteRequest) GetMacAddress() string {
+func (x *GenerateTokensAngelData) GetFeedToken() string {
 	if x != nil {
-		return x.MacAddress
+		return x.FeedToken
 	}
 	return ""
 }
 
-type GetFullQuoteResponse struct {
-	state         protoimpl.MessageState                      `protogen:"open.v1"`
-	Status        bool                                        `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                                      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Errorcode     string                                      `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
-	Data          *GetFullQuoteResponse_FullQuoteResponseData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+type GenerateTokensResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        bool                     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                   `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"`
+	Data          *GenerateTokensAngelData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFullQuoteResponse) Reset() {
-	*x = GetFullQuoteResponse{}
-	mi := &file_broker_proto_msgTypes[26]
+func (x *GenerateTokensResponse) Reset() {
+	*x = GenerateTokensResponse{}
+	mi := &file_broker_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFullQuoteResponse) String() string {
+func (x *GenerateTokensResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFullQuoteResponse) ProtoMessage() {}
+func (*GenerateTokensResponse) ProtoMessage() {}
 
-func (x *GetFullQuoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[26]
+func (x *GenerateTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,67 +11177,69 @@ func (x *GetFullQuoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFullQuoteResponse.ProtoReflect.Descriptor instead.
-func (*GetFullQuoteResponse) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GenerateTokensResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTokensResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetFullQuoteResponse) GetStatus() bool {
+func (x *GenerateTokensResponse) GetStatus() bool {
 	if x != nil {
 		return x.Status
 	}
 	return false
 }
 
-func (x *GetFullQuoteResponse) GetMessage() string {
+func (x *GenerateTokensResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetFullQuoteResponse) GetErrorcode() string {
+func (x *GenerateTokensResponse) GetErrorcode() string {
 	if x != nil {
 		return x.Errorcode
 	}
 	return ""
 }
 
-func (x *GetFullQuoteResponse) GetData() *GetFullQuoteResponse_FullQuoteResponseData {
+func (x *GenerateTokensResponse) GetData() *GenerateTokensAngelData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// --- Logout ---
-type LogoutRequest struct {
+// --- Set Session (background work) ---
+// The gateway hands the broker the session of authenticated requests, so
+// rules, price alerts and bracket orders keep running in the background.
+type SetSessionRequest struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
-	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"` // For Authorization header
-	ClientCode  string                 `protobuf:"bytes,2,opt,name=client_code,json=clientCode,proto3" json:"client_code,omitempty"`      // For the request body to Angel One
-	// Optional headers similar to GetProfile if needed by Angel One logout
-	ClientLocalIp  string `protobuf:"bytes,3,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
-	ClientPublicIp string `protobuf:"bytes,4,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
-	MacAddress     string `protobuf:"bytes,5,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	AngelOneJwt string                 `protobuf:"bytes,1,opt,name=angel_one_jwt,json=angelOneJwt,proto3" json:"angel_one_jwt,omitempty"`
+	FeedToken   string                 `protobuf:"bytes,2,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"` // Optional; lets rules stream ticks
+	// Headers
+	ClientLocalIp  string `protobuf:"bytes,10,opt,name=client_local_ip,json=clientLocalIp,proto3" json:"client_local_ip,omitempty"`
+	ClientPublicIp string `protobuf:"bytes,11,opt,name=client_public_ip,json=clientPublicIp,proto3" json:"client_public_ip,omitempty"`
+	MacAddress     string `protobuf:"bytes,12,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *LogoutRequest) Reset() {
-	*x = LogoutRequest{}
-	mi := &file_broker_proto_msgTypes[27]
+func (x *SetSessionRequest) Reset() {
+	*x = SetSessionRequest{}
+	mi := &file_broker_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutRequest) String() string {
+func (x *SetSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutRequest) ProtoMessage() {}
+func (*SetSessionRequest) ProtoMessage() {}
 
-func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[27]
+func (x *SetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,70 +11250,71 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
-func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SetSessionRequest.ProtoReflect.Descriptor instead.
+func (*SetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *LogoutRequest) GetAngelOneJwt() string {
+func (x *SetSessionRequest) GetAngelOneJwt() string {
 	if x != nil {
 		return x.AngelOneJwt
 	}
 	return ""
 }
 
-func (x *LogoutRequest) GetClientCode() string {
+func (x *SetSessionRequest) GetFeedToken() string {
 	if x != nil {
-		return x.ClientCode
+		return x.FeedToken
 	}
 	return ""
 }
 
-func (x *LogoutRequest) GetClientLocalIp() string {
+func (x *SetSessionRequest) GetClientLocalIp() string {
 	if x != nil {
 		return x.ClientLocalIp
 	}
 	return ""
 }
 
-func (x *LogoutRequest) GetClientPublicIp() string {
+func (x *SetSessionRequest) GetClientPublicIp() string {
 	if x != nil {
 		return x.ClientPublicIp
 	}
 	return ""
 }
 
-func (x *LogoutRequest) GetMacAddress() string {
+func (x *SetSessionRequest) GetMacAddress() string {
 	if x != nil {
 		return x.MacAddress
 	}
 	return ""
 }
 
-type LogoutResponse struct {
+type SetSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`      // From Angel One response
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`     // From Angel One response
-	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"` // From Angel One response
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Errorcode     string                 `protobuf:"bytes,3,opt,name=errorcode,proto3" json:"errorcode,omitempty"` // Angel One's, when it rejected the JWT
+	Kept          bool                   `protobuf:"varint,4,opt,name=kept,proto3" json:"kept,omitempty"`          // Whether the client has rules, price alerts or bracket orders, so the session was kept
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
-	mi := &file_broker_proto_msgTypes[28]
+func (x *SetSessionResponse) Reset() {
+	*x = SetSessionResponse{}
+	mi := &file_broker_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutResponse) String() string {
+func (x *SetSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutResponse) ProtoMessage() {}
+func (*SetSessionResponse) ProtoMessage() {}
 
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[28]
+func (x *SetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_broker_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,33 +11324,40 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	}
 	return mi.MessageOf(x)
 }
-
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{28}
+
+// Deprecated: Use SetSessionResponse.ProtoReflect.Descriptor instead.
+func (*SetSessionResponse) Descriptor() ([]byte, []int) {
+	return file_broker_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *LogoutResponse) GetStatus() bool {
+func (x *SetSessionResponse) GetStatus() bool {
 	if x != nil {
 		return x.Status
 	}
 	return false
 }
 
-func (x *LogoutResponse) GetMessage() string {
+func (x *SetSessionResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *LogoutResponse) GetErrorcode() string {
+func (x *SetSessionResponse) GetErrorcode() string {
 	if x != nil {
 		return x.Errorcode
 	}
 	return ""
 }
 
+func (x *SetSessionResponse) GetKept() bool {
+	if x != nil {
+		return x.Kept
+	}
+	return false
+}
+
 type GetLTPResponse_LTPResponseData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Fetched       []*LTPData             `protobuf:"bytes,1,rep,name=fetched,proto3" json:"fetched,omitempty"`
@@ -2626,7 +11368,7 @@ type GetLTPResponse_LTPResponseData struct {
 
 func (x *GetLTPResponse_LTPResponseData) Reset() {
 	*x = GetLTPResponse_LTPResponseData{}
-	mi := &file_broker_proto_msgTypes[29]
+	mi := &file_broker_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2638,7 +11380,7 @@ func (x *GetLTPResponse_LTPResponseData) String() string {
 func (*GetLTPResponse_LTPResponseData) ProtoMessage() {}
 
 func (x *GetLTPResponse_LTPResponseData) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[29]
+	mi := &file_broker_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2651,7 +11393,7 @@ func (x *GetLTPResponse_LTPResponseData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLTPResponse_LTPResponseData.ProtoReflect.Descriptor instead.
 func (*GetLTPResponse_LTPResponseData) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{24, 0}
+	return file_broker_proto_rawDescGZIP(), []int{95, 0}
 }
 
 func (x *GetLTPResponse_LTPResponseData) GetFetched() []*LTPData {
@@ -2678,7 +11420,7 @@ type GetFullQuoteResponse_FullQuoteResponseData struct {
 
 func (x *GetFullQuoteResponse_FullQuoteResponseData) Reset() {
 	*x = GetFullQuoteResponse_FullQuoteResponseData{}
-	mi := &file_broker_proto_msgTypes[30]
+	mi := &file_broker_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2690,7 +11432,7 @@ func (x *GetFullQuoteResponse_FullQuoteResponseData) String() string {
 func (*GetFullQuoteResponse_FullQuoteResponseData) ProtoMessage() {}
 
 func (x *GetFullQuoteResponse_FullQuoteResponseData) ProtoReflect() protoreflect.Message {
-	mi := &file_broker_proto_msgTypes[30]
+	mi := &file_broker_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2703,7 +11445,7 @@ func (x *GetFullQuoteResponse_FullQuoteResponseData) ProtoReflect() protoreflect
 
 // Deprecated: Use GetFullQuoteResponse_FullQuoteResponseData.ProtoReflect.Descriptor instead.
 func (*GetFullQuoteResponse_FullQuoteResponseData) Descriptor() ([]byte, []int) {
-	return file_broker_proto_rawDescGZIP(), []int{26, 0}
+	return file_broker_proto_rawDescGZIP(), []int{114, 0}
 }
 
 func (x *GetFullQuoteResponse_FullQuoteResponseData) GetFetched() []*FullQuoteData {
@@ -2746,7 +11488,7 @@ const file_broker_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
 	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12/\n" +
-	"\x04data\x18\x04 \x01(\v2\x1b.broker.AngelOneProfileDataR\x04data\"\x9a\x04\n" +
+	"\x04data\x18\x04 \x01(\v2\x1b.broker.AngelOneProfileDataR\x04data\"\x83\x06\n" +
 	"\x11PlaceOrderRequest\x12\"\n" +
 	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x18\n" +
 	"\avariety\x18\x02 \x01(\tR\avariety\x12$\n" +
@@ -2761,19 +11503,55 @@ const file_broker_proto_rawDesc = "" +
 	" \x01(\x01R\x05price\x12\x1c\n" +
 	"\tsquareoff\x18\v \x01(\x01R\tsquareoff\x12\x1a\n" +
 	"\bstoploss\x18\f \x01(\x01R\bstoploss\x12\x1a\n" +
-	"\bquantity\x18\r \x01(\x05R\bquantity\x12&\n" +
+	"\bquantity\x18\r \x01(\x05R\bquantity\x12\"\n" +
+	"\ftriggerprice\x18\x0e \x01(\x01R\ftriggerprice\x12,\n" +
+	"\x11disclosedquantity\x18\x0f \x01(\x05R\x11disclosedquantity\x12*\n" +
+	"\x10trailingstoploss\x18\x10 \x01(\x01R\x10trailingstoploss\x12\x1a\n" +
+	"\bordertag\x18\x11 \x01(\tR\bordertag\x12*\n" +
+	"\x10marketprotection\x18\x12 \x01(\x01R\x10marketprotection\x12!\n" +
+	"\fcheck_margin\x18\x13 \x01(\bR\vcheckMargin\x12&\n" +
 	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
 	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
 	"\vmac_address\x18\x16 \x01(\tR\n" +
-	"macAddress\"G\n" +
+	"macAddress\"m\n" +
 	"\x13PlaceOrderAngelData\x12\x16\n" +
 	"\x06script\x18\x01 \x01(\tR\x06script\x12\x18\n" +
-	"\aorderid\x18\x02 \x01(\tR\aorderid\"\x95\x01\n" +
+	"\aorderid\x18\x02 \x01(\tR\aorderid\x12$\n" +
+	"\runiqueorderid\x18\x03 \x01(\tR\runiqueorderid\"\x95\x01\n" +
 	"\x12PlaceOrderResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
 	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12/\n" +
-	"\x04data\x18\x04 \x01(\v2\x1b.broker.PlaceOrderAngelDataR\x04data\"\xdf\x01\n" +
+	"\x04data\x18\x04 \x01(\v2\x1b.broker.PlaceOrderAngelDataR\x04data\"\xad\x02\n" +
+	"\x12PlaceBasketRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12-\n" +
+	"\x04legs\x18\x02 \x03(\v2\x19.broker.PlaceOrderRequestR\x04legs\x12.\n" +
+	"\x13rollback_on_failure\x18\x03 \x01(\bR\x11rollbackOnFailure\x12!\n" +
+	"\fmax_parallel\x18\x04 \x01(\x05R\vmaxParallel\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\xd0\x02\n" +
+	"\x0fBasketLegResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06placed\x18\x02 \x01(\bR\x06placed\x12\x18\n" +
+	"\aorderid\x18\x03 \x01(\tR\aorderid\x12$\n" +
+	"\runiqueorderid\x18\x04 \x01(\tR\runiqueorderid\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x06 \x01(\tR\terrorcode\x12\x18\n" +
+	"\askipped\x18\a \x01(\bR\askipped\x12\x1c\n" +
+	"\tcancelled\x18\b \x01(\bR\tcancelled\x12!\n" +
+	"\fcancel_error\x18\t \x01(\tR\vcancelError\x12\x1a\n" +
+	"\bordertag\x18\n" +
+	" \x01(\tR\bordertag\x12 \n" +
+	"\vunconfirmed\x18\v \x01(\bR\vunconfirmed\"\xb3\x01\n" +
+	"\x13PlaceBasketResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12+\n" +
+	"\x04legs\x18\x04 \x03(\v2\x17.broker.BasketLegResultR\x04legs\x12\x1f\n" +
+	"\vrolled_back\x18\x05 \x01(\bR\n" +
+	"rolledBack\"\xdf\x01\n" +
 	"\x12CancelOrderRequest\x12\"\n" +
 	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x18\n" +
 	"\avariety\x18\x02 \x01(\tR\avariety\x12\x18\n" +
@@ -2789,7 +11567,33 @@ const file_broker_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
 	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x120\n" +
-	"\x04data\x18\x04 \x01(\v2\x1c.broker.CancelOrderAngelDataR\x04data\"\xcd\t\n" +
+	"\x04data\x18\x04 \x01(\v2\x1c.broker.CancelOrderAngelDataR\x04data\"\xf5\x03\n" +
+	"\x12ModifyOrderRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x18\n" +
+	"\avariety\x18\x02 \x01(\tR\avariety\x12\x18\n" +
+	"\aorderid\x18\x03 \x01(\tR\aorderid\x12\x1c\n" +
+	"\tordertype\x18\x04 \x01(\tR\tordertype\x12 \n" +
+	"\vproducttype\x18\x05 \x01(\tR\vproducttype\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\tR\bduration\x12\x14\n" +
+	"\x05price\x18\a \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\b \x01(\x05R\bquantity\x12\"\n" +
+	"\ftriggerprice\x18\t \x01(\x01R\ftriggerprice\x12$\n" +
+	"\rtradingsymbol\x18\n" +
+	" \x01(\tR\rtradingsymbol\x12 \n" +
+	"\vsymboltoken\x18\v \x01(\tR\vsymboltoken\x12\x1a\n" +
+	"\bexchange\x18\f \x01(\tR\bexchange\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"V\n" +
+	"\x14ModifyOrderAngelData\x12\x18\n" +
+	"\aorderid\x18\x01 \x01(\tR\aorderid\x12$\n" +
+	"\runiqueorderid\x18\x02 \x01(\tR\runiqueorderid\"\x97\x01\n" +
+	"\x13ModifyOrderResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x120\n" +
+	"\x04data\x18\x04 \x01(\v2\x1c.broker.ModifyOrderAngelDataR\x04data\"\xfc\t\n" +
 	"\rOrderBookItem\x12\x18\n" +
 	"\avariety\x18\x01 \x01(\tR\avariety\x12\x1c\n" +
 	"\tordertype\x18\x02 \x01(\tR\tordertype\x12 \n" +
@@ -2836,9 +11640,11 @@ const file_broker_proto_rawDesc = "" +
 	"\rparentorderid\x18\" \x01(\tR\rparentorderid\x12$\n" +
 	"\runiqueorderid\x18# \x01(\tR\runiqueorderid\x12(\n" +
 	"\x0fexchangeorderid\x18$ \x01(\tR\x0fexchangeorderid\x12\x1a\n" +
-	"\bordertag\x18% \x01(\tR\bordertag\"\xac\x01\n" +
+	"\bordertag\x18% \x01(\tR\bordertag\x12-\n" +
+	"\x06trades\x18& \x03(\v2\x15.broker.TradeBookItemR\x06trades\"\xd3\x01\n" +
 	"\x13GetOrderBookRequest\x12\"\n" +
-	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12&\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12%\n" +
+	"\x0einclude_trades\x18\x02 \x01(\bR\rincludeTrades\x12&\n" +
 	"\x0fclient_local_ip\x18\n" +
 	" \x01(\tR\rclientLocalIp\x12(\n" +
 	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
@@ -2848,7 +11654,73 @@ const file_broker_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
 	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12)\n" +
-	"\x04data\x18\x04 \x03(\v2\x15.broker.OrderBookItemR\x04data\"\xc5\x04\n" +
+	"\x04data\x18\x04 \x03(\v2\x15.broker.OrderBookItemR\x04data\"\xd5\x01\n" +
+	"\x16GetOrderDetailsRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12$\n" +
+	"\runiqueorderid\x18\x02 \x01(\tR\runiqueorderid\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"\x94\x01\n" +
+	"\x17GetOrderDetailsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12)\n" +
+	"\x04data\x18\x04 \x01(\v2\x15.broker.OrderBookItemR\x04data\"\xcd\x04\n" +
+	"\rTradeBookItem\x12\x18\n" +
+	"\aorderid\x18\x01 \x01(\tR\aorderid\x12\x16\n" +
+	"\x06fillid\x18\x02 \x01(\tR\x06fillid\x12\x1a\n" +
+	"\bfilltime\x18\x03 \x01(\tR\bfilltime\x12\x1c\n" +
+	"\tfillprice\x18\x04 \x01(\x01R\tfillprice\x12\x1a\n" +
+	"\bfillsize\x18\x05 \x01(\x03R\bfillsize\x12\x1e\n" +
+	"\n" +
+	"tradevalue\x18\x06 \x01(\x01R\n" +
+	"tradevalue\x12(\n" +
+	"\x0ftransactiontype\x18\a \x01(\tR\x0ftransactiontype\x12\x1a\n" +
+	"\bexchange\x18\b \x01(\tR\bexchange\x12 \n" +
+	"\vproducttype\x18\t \x01(\tR\vproducttype\x12$\n" +
+	"\rtradingsymbol\x18\n" +
+	" \x01(\tR\rtradingsymbol\x12&\n" +
+	"\x0einstrumenttype\x18\v \x01(\tR\x0einstrumenttype\x12 \n" +
+	"\vsymbolgroup\x18\f \x01(\tR\vsymbolgroup\x12 \n" +
+	"\vstrikeprice\x18\r \x01(\x01R\vstrikeprice\x12\x1e\n" +
+	"\n" +
+	"optiontype\x18\x0e \x01(\tR\n" +
+	"optiontype\x12\x1e\n" +
+	"\n" +
+	"expirydate\x18\x0f \x01(\tR\n" +
+	"expirydate\x12\x1c\n" +
+	"\tmarketlot\x18\x10 \x01(\x03R\tmarketlot\x12\x1c\n" +
+	"\tprecision\x18\x11 \x01(\x05R\tprecision\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x12 \x01(\x05R\n" +
+	"multiplier\"\xac\x01\n" +
+	"\x13GetTradeBookRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"\x91\x01\n" +
+	"\x14GetTradeBookResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12)\n" +
+	"\x04data\x18\x04 \x03(\v2\x15.broker.TradeBookItemR\x04data\"\xb2\x01\n" +
+	"\x19StreamOrderUpdatesRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"\xb3\x01\n" +
+	"\vOrderUpdate\x12*\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x16.broker.OrderEventTypeR\x04type\x12*\n" +
+	"\x11order_status_code\x18\x02 \x01(\tR\x0forderStatusCode\x12\x1f\n" +
+	"\vreceived_at\x18\x03 \x01(\x03R\n" +
+	"receivedAt\x12+\n" +
+	"\x05order\x18\x04 \x01(\v2\x15.broker.OrderBookItemR\x05order\"\xc5\x04\n" +
 	"\x0fHoldingItemData\x12$\n" +
 	"\rtradingsymbol\x18\x01 \x01(\tR\rtradingsymbol\x12\x1a\n" +
 	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x12\n" +
@@ -2889,7 +11761,542 @@ const file_broker_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
 	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x121\n" +
-	"\x04data\x18\x04 \x01(\v2\x1d.broker.PortfolioHoldingsDataR\x04data\"\x81\x01\n" +
+	"\x04data\x18\x04 \x01(\v2\x1d.broker.PortfolioHoldingsDataR\x04data\"\xb4\x06\n" +
+	"\fPositionItem\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12 \n" +
+	"\vsymboltoken\x18\x02 \x01(\tR\vsymboltoken\x12$\n" +
+	"\rtradingsymbol\x18\x03 \x01(\tR\rtradingsymbol\x12\x1e\n" +
+	"\n" +
+	"symbolname\x18\x04 \x01(\tR\n" +
+	"symbolname\x12&\n" +
+	"\x0einstrumenttype\x18\x05 \x01(\tR\x0einstrumenttype\x12 \n" +
+	"\vproducttype\x18\x06 \x01(\tR\vproducttype\x12\x1e\n" +
+	"\n" +
+	"optiontype\x18\a \x01(\tR\n" +
+	"optiontype\x12 \n" +
+	"\vstrikeprice\x18\b \x01(\x01R\vstrikeprice\x12\x1e\n" +
+	"\n" +
+	"expirydate\x18\t \x01(\tR\n" +
+	"expirydate\x12\x18\n" +
+	"\alotsize\x18\n" +
+	" \x01(\x05R\alotsize\x12\x16\n" +
+	"\x06buyqty\x18\v \x01(\x03R\x06buyqty\x12\x18\n" +
+	"\asellqty\x18\f \x01(\x03R\asellqty\x12\x16\n" +
+	"\x06netqty\x18\r \x01(\x03R\x06netqty\x12 \n" +
+	"\vbuyavgprice\x18\x0e \x01(\x01R\vbuyavgprice\x12\"\n" +
+	"\fsellavgprice\x18\x0f \x01(\x01R\fsellavgprice\x12\x1a\n" +
+	"\bnetprice\x18\x10 \x01(\x01R\bnetprice\x12\x1c\n" +
+	"\tbuyamount\x18\x11 \x01(\x01R\tbuyamount\x12\x1e\n" +
+	"\n" +
+	"sellamount\x18\x12 \x01(\x01R\n" +
+	"sellamount\x12\x1a\n" +
+	"\bnetvalue\x18\x13 \x01(\x01R\bnetvalue\x12\x10\n" +
+	"\x03ltp\x18\x14 \x01(\x01R\x03ltp\x12\x1a\n" +
+	"\brealised\x18\x15 \x01(\x01R\brealised\x12\x1e\n" +
+	"\n" +
+	"unrealised\x18\x16 \x01(\x01R\n" +
+	"unrealised\x12\x1a\n" +
+	"\bcfbuyqty\x18\x17 \x01(\x03R\bcfbuyqty\x12\x1c\n" +
+	"\tcfsellqty\x18\x18 \x01(\x03R\tcfsellqty\x12$\n" +
+	"\rcfbuyavgprice\x18\x19 \x01(\x01R\rcfbuyavgprice\x12&\n" +
+	"\x0ecfsellavgprice\x18\x1a \x01(\x01R\x0ecfsellavgprice\"_\n" +
+	"\rPositionsData\x12&\n" +
+	"\x03net\x18\x01 \x03(\v2\x14.broker.PositionItemR\x03net\x12&\n" +
+	"\x03day\x18\x02 \x03(\v2\x14.broker.PositionItemR\x03day\"\xac\x01\n" +
+	"\x13GetPositionsRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"\x91\x01\n" +
+	"\x14GetPositionsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12)\n" +
+	"\x04data\x18\x04 \x01(\v2\x15.broker.PositionsDataR\x04data\"\xbd\x03\n" +
+	"\x16ConvertPositionRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12 \n" +
+	"\vsymboltoken\x18\x03 \x01(\tR\vsymboltoken\x12$\n" +
+	"\rtradingsymbol\x18\x04 \x01(\tR\rtradingsymbol\x12&\n" +
+	"\x0eoldproducttype\x18\x05 \x01(\tR\x0eoldproducttype\x12&\n" +
+	"\x0enewproducttype\x18\x06 \x01(\tR\x0enewproducttype\x12(\n" +
+	"\x0ftransactiontype\x18\a \x01(\tR\x0ftransactiontype\x12\x1a\n" +
+	"\bquantity\x18\b \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04type\x18\t \x01(\tR\x04type\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"i\n" +
+	"\x17ConvertPositionResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\"\xcd\x04\n" +
+	"\tRMSLimits\x12\x10\n" +
+	"\x03net\x18\x01 \x01(\x01R\x03net\x12$\n" +
+	"\ravailablecash\x18\x02 \x01(\x01R\ravailablecash\x126\n" +
+	"\x16availableintradaypayin\x18\x03 \x01(\x01R\x16availableintradaypayin\x122\n" +
+	"\x14availablelimitmargin\x18\x04 \x01(\x01R\x14availablelimitmargin\x12\x1e\n" +
+	"\n" +
+	"collateral\x18\x05 \x01(\x01R\n" +
+	"collateral\x12$\n" +
+	"\rm2munrealized\x18\x06 \x01(\x01R\rm2munrealized\x12 \n" +
+	"\vm2mrealized\x18\a \x01(\x01R\vm2mrealized\x12&\n" +
+	"\x0eutiliseddebits\x18\b \x01(\x01R\x0eutiliseddebits\x12\"\n" +
+	"\futilisedspan\x18\t \x01(\x01R\futilisedspan\x124\n" +
+	"\x15utilisedoptionpremium\x18\n" +
+	" \x01(\x01R\x15utilisedoptionpremium\x122\n" +
+	"\x14utilisedholdingsales\x18\v \x01(\x01R\x14utilisedholdingsales\x12*\n" +
+	"\x10utilisedexposure\x18\f \x01(\x01R\x10utilisedexposure\x12*\n" +
+	"\x10utilisedturnover\x18\r \x01(\x01R\x10utilisedturnover\x12&\n" +
+	"\x0eutilisedpayout\x18\x0e \x01(\x01R\x0eutilisedpayout\"\xac\x01\n" +
+	"\x13GetRMSLimitsRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"\x8d\x01\n" +
+	"\x14GetRMSLimitsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12%\n" +
+	"\x04data\x18\x04 \x01(\v2\x11.broker.RMSLimitsR\x04data\"\xde\x01\n" +
+	"\x16CalculateMarginRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12-\n" +
+	"\x04legs\x18\x02 \x03(\v2\x19.broker.PlaceOrderRequestR\x04legs\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"\xf2\x01\n" +
+	"\x10MarginComponents\x12\x1e\n" +
+	"\n" +
+	"netpremium\x18\x01 \x01(\x01R\n" +
+	"netpremium\x12\x1e\n" +
+	"\n" +
+	"spanmargin\x18\x02 \x01(\x01R\n" +
+	"spanmargin\x12$\n" +
+	"\rmarginbenefit\x18\x03 \x01(\x01R\rmarginbenefit\x12&\n" +
+	"\x0edeliverymargin\x18\x04 \x01(\x01R\x0edeliverymargin\x12\"\n" +
+	"\fnonnfomargin\x18\x05 \x01(\x01R\fnonnfomargin\x12,\n" +
+	"\x11totoptionspremium\x18\x06 \x01(\x01R\x11totoptionspremium\"\xad\x01\n" +
+	"\tLegMargin\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12 \n" +
+	"\vsymboltoken\x18\x03 \x01(\tR\vsymboltoken\x12$\n" +
+	"\rtradingsymbol\x18\x04 \x01(\tR\rtradingsymbol\x12&\n" +
+	"\x0emarginrequired\x18\x05 \x01(\x01R\x0emarginrequired\"\x9f\x01\n" +
+	"\n" +
+	"MarginData\x120\n" +
+	"\x13totalmarginrequired\x18\x01 \x01(\x01R\x13totalmarginrequired\x128\n" +
+	"\n" +
+	"components\x18\x02 \x01(\v2\x18.broker.MarginComponentsR\n" +
+	"components\x12%\n" +
+	"\x04legs\x18\x03 \x03(\v2\x11.broker.LegMarginR\x04legs\"\x91\x01\n" +
+	"\x17CalculateMarginResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12&\n" +
+	"\x04data\x18\x04 \x01(\v2\x12.broker.MarginDataR\x04data\"\xd1\x03\n" +
+	"\aGTTRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12$\n" +
+	"\rtradingsymbol\x18\x03 \x01(\tR\rtradingsymbol\x12 \n" +
+	"\vsymboltoken\x18\x04 \x01(\tR\vsymboltoken\x12\x1a\n" +
+	"\bexchange\x18\x05 \x01(\tR\bexchange\x12 \n" +
+	"\vproducttype\x18\x06 \x01(\tR\vproducttype\x12(\n" +
+	"\x0ftransactiontype\x18\a \x01(\tR\x0ftransactiontype\x12\x14\n" +
+	"\x05price\x18\b \x01(\x01R\x05price\x12\x10\n" +
+	"\x03qty\x18\t \x01(\x05R\x03qty\x12\"\n" +
+	"\ftriggerprice\x18\n" +
+	" \x01(\x01R\ftriggerprice\x12\"\n" +
+	"\fdisclosedqty\x18\v \x01(\x05R\fdisclosedqty\x12 \n" +
+	"\vcreateddate\x18\f \x01(\tR\vcreateddate\x12 \n" +
+	"\vupdateddate\x18\r \x01(\tR\vupdateddate\x12\x1e\n" +
+	"\n" +
+	"expirydate\x18\x0e \x01(\tR\n" +
+	"expirydate\x12\x1a\n" +
+	"\bclientid\x18\x0f \x01(\tR\bclientid\"\xe9\x03\n" +
+	"\x10CreateGTTRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12$\n" +
+	"\rtradingsymbol\x18\x02 \x01(\tR\rtradingsymbol\x12 \n" +
+	"\vsymboltoken\x18\x03 \x01(\tR\vsymboltoken\x12\x1a\n" +
+	"\bexchange\x18\x04 \x01(\tR\bexchange\x12(\n" +
+	"\x0ftransactiontype\x18\x05 \x01(\tR\x0ftransactiontype\x12 \n" +
+	"\vproducttype\x18\x06 \x01(\tR\vproducttype\x12\x14\n" +
+	"\x05price\x18\a \x01(\x01R\x05price\x12\x10\n" +
+	"\x03qty\x18\b \x01(\x05R\x03qty\x12\"\n" +
+	"\ftriggerprice\x18\t \x01(\x01R\ftriggerprice\x12\"\n" +
+	"\fdisclosedqty\x18\n" +
+	" \x01(\x05R\fdisclosedqty\x12\x1e\n" +
+	"\n" +
+	"timeperiod\x18\v \x01(\x05R\n" +
+	"timeperiod\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"s\n" +
+	"\x11CreateGTTResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\"\x87\x03\n" +
+	"\x10ModifyGTTRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12 \n" +
+	"\vsymboltoken\x18\x03 \x01(\tR\vsymboltoken\x12\x1a\n" +
+	"\bexchange\x18\x04 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x10\n" +
+	"\x03qty\x18\x06 \x01(\x05R\x03qty\x12\"\n" +
+	"\ftriggerprice\x18\a \x01(\x01R\ftriggerprice\x12\"\n" +
+	"\fdisclosedqty\x18\b \x01(\x05R\fdisclosedqty\x12\x1e\n" +
+	"\n" +
+	"timeperiod\x18\t \x01(\x05R\n" +
+	"timeperiod\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"s\n" +
+	"\x11ModifyGTTResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\"\xf7\x01\n" +
+	"\x10CancelGTTRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12 \n" +
+	"\vsymboltoken\x18\x03 \x01(\tR\vsymboltoken\x12\x1a\n" +
+	"\bexchange\x18\x04 \x01(\tR\bexchange\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"s\n" +
+	"\x11CancelGTTResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\"\xbd\x01\n" +
+	"\x14GetGTTDetailsRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x8c\x01\n" +
+	"\x15GetGTTDetailsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12#\n" +
+	"\x04data\x18\x04 \x01(\v2\x0f.broker.GTTRuleR\x04data\"\xe9\x01\n" +
+	"\x0eListGTTRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x16\n" +
+	"\x06status\x18\x02 \x03(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x86\x01\n" +
+	"\x0fListGTTResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12#\n" +
+	"\x04data\x18\x04 \x03(\v2\x0f.broker.GTTRuleR\x04data\"j\n" +
+	"\x0eAlertCondition\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.broker.AlertConditionTypeR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\tR\x04time\"\xa0\x05\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vclient_code\x18\x02 \x01(\tR\n" +
+	"clientCode\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bexchange\x18\x04 \x01(\tR\bexchange\x12$\n" +
+	"\rtradingsymbol\x18\x05 \x01(\tR\rtradingsymbol\x12 \n" +
+	"\vsymboltoken\x18\x06 \x01(\tR\vsymboltoken\x126\n" +
+	"\n" +
+	"conditions\x18\a \x03(\v2\x16.broker.AlertConditionR\n" +
+	"conditions\x12/\n" +
+	"\x05order\x18\b \x01(\v2\x19.broker.PlaceOrderRequestR\x05order\x12'\n" +
+	"\x0freference_price\x18\t \x01(\x01R\x0ereferencePrice\x12,\n" +
+	"\x05state\x18\n" +
+	" \x01(\x0e2\x16.broker.AlertRuleStateR\x05state\x12\x1a\n" +
+	"\bordertag\x18\v \x01(\tR\bordertag\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\x12!\n" +
+	"\ftriggered_at\x18\r \x01(\x03R\vtriggeredAt\x12#\n" +
+	"\rtrigger_price\x18\x0e \x01(\x01R\ftriggerPrice\x12\x18\n" +
+	"\aorderid\x18\x0f \x01(\tR\aorderid\x12$\n" +
+	"\runiqueorderid\x18\x10 \x01(\tR\runiqueorderid\x12\x18\n" +
+	"\amessage\x18\x11 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\x12 \x01(\x01R\tlastPrice\x12.\n" +
+	"\x13waiting_for_session\x18\x13 \x01(\bR\x11waitingForSession\"\xf5\x01\n" +
+	"\x16CreateAlertRuleRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\x02 \x01(\tR\tfeedToken\x12%\n" +
+	"\x04rule\x18\x03 \x01(\v2\x11.broker.AlertRuleR\x04rule\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x90\x01\n" +
+	"\x17CreateAlertRuleResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12%\n" +
+	"\x04data\x18\x04 \x01(\v2\x11.broker.AlertRuleR\x04data\"\xee\x01\n" +
+	"\x15ListAlertRulesRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\x02 \x01(\tR\tfeedToken\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnly\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x8f\x01\n" +
+	"\x16ListAlertRulesResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12%\n" +
+	"\x04data\x18\x04 \x03(\v2\x11.broker.AlertRuleR\x04data\"\xdb\x01\n" +
+	"\x13GetAlertRuleRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\x02 \x01(\tR\tfeedToken\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x8d\x01\n" +
+	"\x14GetAlertRuleResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12%\n" +
+	"\x04data\x18\x04 \x01(\v2\x11.broker.AlertRuleR\x04data\"\xde\x01\n" +
+	"\x16CancelAlertRuleRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\x02 \x01(\tR\tfeedToken\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x90\x01\n" +
+	"\x17CancelAlertRuleResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12%\n" +
+	"\x04data\x18\x04 \x01(\v2\x11.broker.AlertRuleR\x04data\"\x8d\x04\n" +
+	"\n" +
+	"PriceAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vclient_code\x18\x02 \x01(\tR\n" +
+	"clientCode\x12\x1a\n" +
+	"\bexchange\x18\x03 \x01(\tR\bexchange\x12$\n" +
+	"\rtradingsymbol\x18\x04 \x01(\tR\rtradingsymbol\x12 \n" +
+	"\vsymboltoken\x18\x05 \x01(\tR\vsymboltoken\x124\n" +
+	"\tcondition\x18\x06 \x01(\v2\x16.broker.AlertConditionR\tcondition\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x16\n" +
+	"\x06repeat\x18\b \x01(\bR\x06repeat\x12-\n" +
+	"\x05state\x18\t \x01(\x0e2\x17.broker.PriceAlertStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12!\n" +
+	"\ftriggered_at\x18\v \x01(\x03R\vtriggeredAt\x12#\n" +
+	"\rtrigger_price\x18\f \x01(\x01R\ftriggerPrice\x12#\n" +
+	"\rtrigger_count\x18\r \x01(\x05R\ftriggerCount\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\x0e \x01(\x01R\tlastPrice\x12.\n" +
+	"\x13waiting_for_session\x18\x0f \x01(\bR\x11waitingForSession\"\x88\x01\n" +
+	"\fAlertWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vclient_code\x18\x02 \x01(\tR\n" +
+	"clientCode\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"\x99\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vclient_code\x18\x02 \x01(\tR\n" +
+	"clientCode\x12\x19\n" +
+	"\balert_id\x18\x03 \x01(\tR\aalertId\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x04 \x01(\tR\twebhookId\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12.\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x16.broker.DeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12#\n" +
+	"\rresponse_code\x18\b \x01(\x05R\fresponseCode\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x18\n" +
+	"\apayload\x18\n" +
+	" \x01(\tR\apayload\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12&\n" +
+	"\x0fnext_attempt_at\x18\f \x01(\x03R\rnextAttemptAt\x12!\n" +
+	"\fdelivered_at\x18\r \x01(\x03R\vdeliveredAt\"\xda\x01\n" +
+	"\x17CreatePriceAlertRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12(\n" +
+	"\x05alert\x18\x02 \x01(\v2\x12.broker.PriceAlertR\x05alert\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\xba\x02\n" +
+	"\x17UpdatePriceAlertRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x124\n" +
+	"\tcondition\x18\x03 \x01(\v2\x16.broker.AlertConditionR\tcondition\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x16\n" +
+	"\x06repeat\x18\x05 \x01(\bR\x06repeat\x12\x16\n" +
+	"\x06paused\x18\x06 \x01(\bR\x06paused\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x8c\x01\n" +
+	"\x12PriceAlertResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12&\n" +
+	"\x04data\x18\x04 \x01(\v2\x12.broker.PriceAlertR\x04data\"\xaf\x01\n" +
+	"\x16ListPriceAlertsRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x91\x01\n" +
+	"\x17ListPriceAlertsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12&\n" +
+	"\x04data\x18\x04 \x03(\v2\x12.broker.PriceAlertR\x04data\"\xb7\x01\n" +
+	"\x0eAlertIDRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"e\n" +
+	"\x13DeleteAlertResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\"\xc4\x01\n" +
+	"\x19CreateAlertWebhookRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x90\x01\n" +
+	"\x14AlertWebhookResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12(\n" +
+	"\x04data\x18\x04 \x01(\v2\x14.broker.AlertWebhookR\x04data\"\xb1\x01\n" +
+	"\x18ListAlertWebhooksRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x95\x01\n" +
+	"\x19ListAlertWebhooksResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12(\n" +
+	"\x04data\x18\x04 \x03(\v2\x14.broker.AlertWebhookR\x04data\"\xe4\x01\n" +
+	"\x1aListAlertDeliveriesRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x19\n" +
+	"\balert_id\x18\x02 \x01(\tR\aalertId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x9a\x01\n" +
+	"\x1bListAlertDeliveriesResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12+\n" +
+	"\x04data\x18\x04 \x03(\v2\x17.broker.WebhookDeliveryR\x04data\"\x80\x03\n" +
+	"\n" +
+	"BracketLeg\x12\x1a\n" +
+	"\bordertag\x18\x01 \x01(\tR\bordertag\x12\x18\n" +
+	"\aorderid\x18\x02 \x01(\tR\aorderid\x12$\n" +
+	"\runiqueorderid\x18\x03 \x01(\tR\runiqueorderid\x12 \n" +
+	"\vorderstatus\x18\x04 \x01(\tR\vorderstatus\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\"\n" +
+	"\ffilledshares\x18\x06 \x01(\x05R\ffilledshares\x12\"\n" +
+	"\faverageprice\x18\a \x01(\x01R\faverageprice\x12\x12\n" +
+	"\x04sent\x18\b \x01(\bR\x04sent\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x12\x1b\n" +
+	"\tplaced_at\x18\n" +
+	" \x01(\x03R\bplacedAt\x12$\n" +
+	"\x0elast_action_at\x18\v \x01(\x03R\flastActionAt\x12\x1f\n" +
+	"\vcancel_sent\x18\f \x01(\bR\n" +
+	"cancelSent\"\x9c\x06\n" +
+	"\fBracketOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vclient_code\x18\x02 \x01(\tR\n" +
+	"clientCode\x12/\n" +
+	"\x05entry\x18\x03 \x01(\v2\x19.broker.PlaceOrderRequestR\x05entry\x12!\n" +
+	"\ftarget_price\x18\x04 \x01(\x01R\vtargetPrice\x123\n" +
+	"\x15stoploss_triggerprice\x18\x05 \x01(\x01R\x14stoplossTriggerprice\x12%\n" +
+	"\x0estoploss_price\x18\x06 \x01(\x01R\rstoplossPrice\x12*\n" +
+	"\x05state\x18\a \x01(\x0e2\x14.broker.BracketStateR\x05state\x12/\n" +
+	"\tentry_leg\x18\b \x01(\v2\x12.broker.BracketLegR\bentryLeg\x121\n" +
+	"\n" +
+	"target_leg\x18\t \x01(\v2\x12.broker.BracketLegR\ttargetLeg\x125\n" +
+	"\fstoploss_leg\x18\n" +
+	" \x01(\v2\x12.broker.BracketLegR\vstoplossLeg\x12)\n" +
+	"\x10cancel_requested\x18\v \x01(\bR\x0fcancelRequested\x12\x18\n" +
+	"\amessage\x18\f \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\x03R\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"exit_round\x18\x0f \x01(\x05R\texitRound\x12#\n" +
+	"\rexit_quantity\x18\x10 \x01(\x05R\fexitQuantity\x12#\n" +
+	"\rsold_quantity\x18\x11 \x01(\x05R\fsoldQuantity\x12.\n" +
+	"\x13waiting_for_session\x18\x12 \x01(\bR\x11waitingForSession\x12%\n" +
+	"\x0etarget_reached\x18\x13 \x01(\bR\rtargetReached\x12!\n" +
+	"\fexit_retries\x18\x14 \x01(\x05R\vexitRetries\"\xe1\x02\n" +
+	"\x18PlaceBracketOrderRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12/\n" +
+	"\x05entry\x18\x02 \x01(\v2\x19.broker.PlaceOrderRequestR\x05entry\x12!\n" +
+	"\ftarget_price\x18\x03 \x01(\x01R\vtargetPrice\x123\n" +
+	"\x15stoploss_triggerprice\x18\x04 \x01(\x01R\x14stoplossTriggerprice\x12%\n" +
+	"\x0estoploss_price\x18\x05 \x01(\x01R\rstoplossPrice\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x90\x01\n" +
+	"\x14BracketOrderResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12(\n" +
+	"\x04data\x18\x04 \x01(\v2\x14.broker.BracketOrderR\x04data\"\xd2\x01\n" +
+	"\x18ListBracketOrdersRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x95\x01\n" +
+	"\x19ListBracketOrdersResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12(\n" +
+	"\x04data\x18\x04 \x03(\v2\x14.broker.BracketOrderR\x04data\"\xbe\x01\n" +
+	"\x15BracketOrderIDRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12&\n" +
+	"\x0fclient_local_ip\x18\x14 \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\x15 \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\x16 \x01(\tR\n" +
+	"macAddress\"\x81\x01\n" +
 	"\aLTPData\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12%\n" +
 	"\x0etrading_symbol\x18\x02 \x01(\tR\rtradingSymbol\x12!\n" +
@@ -2942,10 +12349,11 @@ const file_broker_proto_rawDesc = "" +
 	" \x01(\tR\rclientLocalIp\x12(\n" +
 	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
 	"\vmac_address\x18\f \x01(\tR\n" +
-	"macAddress\"G\n" +
+	"macAddress\"o\n" +
 	"\x11ExchangeTokenPair\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
-	"\x06tokens\x18\x02 \x03(\tR\x06tokens\"\x8f\x02\n" +
+	"\x06tokens\x18\x02 \x03(\tR\x06tokens\x12&\n" +
+	"\x0etradingsymbols\x18\x03 \x03(\tR\x0etradingsymbols\"\x8f\x02\n" +
 	"\x0eGetLTPResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
@@ -2953,7 +12361,135 @@ const file_broker_proto_rawDesc = "" +
 	"\x04data\x18\x04 \x01(\v2&.broker.GetLTPResponse.LTPResponseDataR\x04data\x1aq\n" +
 	"\x0fLTPResponseData\x12)\n" +
 	"\afetched\x18\x01 \x03(\v2\x0f.broker.LTPDataR\afetched\x123\n" +
-	"\tunfetched\x18\x02 \x03(\v2\x15.broker.UnfetchedItemR\tunfetched\"\xf0\x01\n" +
+	"\tunfetched\x18\x02 \x03(\v2\x15.broker.UnfetchedItemR\tunfetched\"\xd3\x02\n" +
+	"\x14GetCandleDataRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12 \n" +
+	"\vsymboltoken\x18\x03 \x01(\tR\vsymboltoken\x122\n" +
+	"\binterval\x18\x04 \x01(\x0e2\x16.broker.CandleIntervalR\binterval\x12\x1a\n" +
+	"\bfromdate\x18\x05 \x01(\tR\bfromdate\x12\x16\n" +
+	"\x06todate\x18\x06 \x01(\tR\x06todate\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"\x8e\x01\n" +
+	"\x06Candle\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x03 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x04 \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\x05 \x01(\x01R\x05close\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x03R\x06volume\"\x8b\x01\n" +
+	"\x15GetCandleDataResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12\"\n" +
+	"\x04data\x18\x04 \x03(\v2\x0e.broker.CandleR\x04data\"\xf0\x01\n" +
+	"\x11CandleSeriesStats\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12 \n" +
+	"\vsymboltoken\x18\x02 \x01(\tR\vsymboltoken\x122\n" +
+	"\binterval\x18\x03 \x01(\x0e2\x16.broker.CandleIntervalR\binterval\x12\x18\n" +
+	"\acandles\x18\x04 \x01(\x03R\acandles\x12\x14\n" +
+	"\x05first\x18\x05 \x01(\tR\x05first\x12\x12\n" +
+	"\x04last\x18\x06 \x01(\tR\x04last\x12%\n" +
+	"\x0ecovered_ranges\x18\a \x01(\x05R\rcoveredRanges\"\x1c\n" +
+	"\x1aGetCandleCacheStatsRequest\"\x95\x02\n" +
+	"\x1bGetCandleCacheStatsResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06series\x18\x02 \x01(\x03R\x06series\x12\x18\n" +
+	"\acandles\x18\x03 \x01(\x03R\acandles\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x12\n" +
+	"\x04hits\x18\x05 \x01(\x03R\x04hits\x12!\n" +
+	"\fpartial_hits\x18\x06 \x01(\x03R\vpartialHits\x12\x16\n" +
+	"\x06misses\x18\a \x01(\x03R\x06misses\x12<\n" +
+	"\fseries_stats\x18\b \x03(\v2\x19.broker.CandleSeriesStatsR\vseriesStats\"W\n" +
+	"\x17PurgeCandleCacheRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12 \n" +
+	"\vsymboltoken\x18\x02 \x01(\tR\vsymboltoken\"j\n" +
+	"\x18PurgeCandleCacheResponse\x12%\n" +
+	"\x0eseries_removed\x18\x01 \x01(\x05R\rseriesRemoved\x12'\n" +
+	"\x0fcandles_removed\x18\x02 \x01(\x03R\x0ecandlesRemoved\"\x92\x02\n" +
+	"\n" +
+	"Instrument\x12 \n" +
+	"\vsymboltoken\x18\x01 \x01(\tR\vsymboltoken\x12$\n" +
+	"\rtradingsymbol\x18\x02 \x01(\tR\rtradingsymbol\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bexchange\x18\x04 \x01(\tR\bexchange\x12&\n" +
+	"\x0einstrumenttype\x18\x05 \x01(\tR\x0einstrumenttype\x12\x16\n" +
+	"\x06expiry\x18\x06 \x01(\tR\x06expiry\x12\x16\n" +
+	"\x06strike\x18\a \x01(\x01R\x06strike\x12\x18\n" +
+	"\alotsize\x18\b \x01(\x05R\alotsize\x12\x1a\n" +
+	"\bticksize\x18\t \x01(\x01R\bticksize\"\x8a\x01\n" +
+	"\x18SearchInstrumentsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12&\n" +
+	"\x0einstrumenttype\x18\x03 \x01(\tR\x0einstrumenttype\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x93\x01\n" +
+	"\x19SearchInstrumentsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12&\n" +
+	"\x04data\x18\x04 \x03(\v2\x12.broker.InstrumentR\x04data\"T\n" +
+	"\x14GetInstrumentRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12 \n" +
+	"\vsymboltoken\x18\x02 \x01(\tR\vsymboltoken\"\x8f\x01\n" +
+	"\x15GetInstrumentResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12&\n" +
+	"\x04data\x18\x04 \x01(\v2\x12.broker.InstrumentR\x04data\"t\n" +
+	"\x0eTickInstrument\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12 \n" +
+	"\vsymboltoken\x18\x02 \x01(\tR\vsymboltoken\x12$\n" +
+	"\rtradingsymbol\x18\x03 \x01(\tR\rtradingsymbol\"\xce\x02\n" +
+	"\x15SubscribeTicksRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\x02 \x01(\tR\tfeedToken\x12\x1f\n" +
+	"\vclient_code\x18\x03 \x01(\tR\n" +
+	"clientCode\x12$\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x10.broker.TickModeR\x04mode\x128\n" +
+	"\vinstruments\x18\x05 \x03(\v2\x16.broker.TickInstrumentR\vinstruments\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"V\n" +
+	"\n" +
+	"DepthLevel\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06orders\x18\x03 \x01(\x05R\x06orders\"\xcf\x06\n" +
+	"\x04Tick\x12$\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x10.broker.TickModeR\x04mode\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12 \n" +
+	"\vsymboltoken\x18\x03 \x01(\tR\vsymboltoken\x12\x1a\n" +
+	"\bsequence\x18\x04 \x01(\x03R\bsequence\x12-\n" +
+	"\x12exchange_timestamp\x18\x05 \x01(\x03R\x11exchangeTimestamp\x12\x10\n" +
+	"\x03ltp\x18\x06 \x01(\x01R\x03ltp\x120\n" +
+	"\x14last_traded_quantity\x18\a \x01(\x03R\x12lastTradedQuantity\x12#\n" +
+	"\raverage_price\x18\b \x01(\x01R\faveragePrice\x12\x16\n" +
+	"\x06volume\x18\t \x01(\x03R\x06volume\x12,\n" +
+	"\x12total_buy_quantity\x18\n" +
+	" \x01(\x01R\x10totalBuyQuantity\x12.\n" +
+	"\x13total_sell_quantity\x18\v \x01(\x01R\x11totalSellQuantity\x12\x12\n" +
+	"\x04open\x18\f \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\r \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x0e \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\x0f \x01(\x01R\x05close\x122\n" +
+	"\x15last_traded_timestamp\x18\x10 \x01(\x03R\x13lastTradedTimestamp\x12#\n" +
+	"\ropen_interest\x18\x11 \x01(\x03R\fopenInterest\x12/\n" +
+	"\tbest_bids\x18\x12 \x03(\v2\x12.broker.DepthLevelR\bbestBids\x12/\n" +
+	"\tbest_asks\x18\x13 \x03(\v2\x12.broker.DepthLevelR\bbestAsks\x12#\n" +
+	"\rupper_circuit\x18\x14 \x01(\x01R\fupperCircuit\x12#\n" +
+	"\rlower_circuit\x18\x15 \x01(\x01R\flowerCircuit\x12\x1f\n" +
+	"\vweek52_high\x18\x16 \x01(\x01R\n" +
+	"week52High\x12\x1d\n" +
+	"\n" +
+	"week52_low\x18\x17 \x01(\x01R\tweek52Low\x12$\n" +
+	"\rtradingsymbol\x18\x18 \x01(\tR\rtradingsymbol\"\xf0\x01\n" +
 	"\x13GetFullQuoteRequest\x12\"\n" +
 	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12B\n" +
 	"\x0fexchange_tokens\x18\x02 \x03(\v2\x19.broker.ExchangeTokenPairR\x0eexchangeTokens\x12&\n" +
@@ -2981,18 +12517,153 @@ const file_broker_proto_rawDesc = "" +
 	"\x0eLogoutResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
-	"\terrorcode\x18\x03 \x01(\tR\terrorcode2\xb1\x04\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\"\xd3\x01\n" +
+	"\x15GenerateTokensRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"z\n" +
+	"\x17GenerateTokensAngelData\x12\x1b\n" +
+	"\tjwt_token\x18\x01 \x01(\tR\bjwtToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\x03 \x01(\tR\tfeedToken\"\x9d\x01\n" +
+	"\x16GenerateTokensResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x123\n" +
+	"\x04data\x18\x04 \x01(\v2\x1f.broker.GenerateTokensAngelDataR\x04data\"\xc9\x01\n" +
+	"\x11SetSessionRequest\x12\"\n" +
+	"\rangel_one_jwt\x18\x01 \x01(\tR\vangelOneJwt\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\x02 \x01(\tR\tfeedToken\x12&\n" +
+	"\x0fclient_local_ip\x18\n" +
+	" \x01(\tR\rclientLocalIp\x12(\n" +
+	"\x10client_public_ip\x18\v \x01(\tR\x0eclientPublicIp\x12\x1f\n" +
+	"\vmac_address\x18\f \x01(\tR\n" +
+	"macAddress\"x\n" +
+	"\x12SetSessionResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\terrorcode\x18\x03 \x01(\tR\terrorcode\x12\x12\n" +
+	"\x04kept\x18\x04 \x01(\bR\x04kept*\x89\x01\n" +
+	"\x0eOrderEventType\x12 \n" +
+	"\x1cORDER_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06PLACED\x10\x01\x12\b\n" +
+	"\x04OPEN\x10\x02\x12\x14\n" +
+	"\x10PARTIALLY_FILLED\x10\x03\x12\f\n" +
+	"\bCOMPLETE\x10\x04\x12\f\n" +
+	"\bREJECTED\x10\x05\x12\r\n" +
+	"\tCANCELLED\x10\x06*\xb1\x01\n" +
+	"\x12AlertConditionType\x12$\n" +
+	" ALERT_CONDITION_TYPE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vPRICE_ABOVE\x10\x01\x12\x0f\n" +
+	"\vPRICE_BELOW\x10\x02\x12\x18\n" +
+	"\x14PERCENT_CHANGE_ABOVE\x10\x03\x12\x18\n" +
+	"\x14PERCENT_CHANGE_BELOW\x10\x04\x12\x0e\n" +
+	"\n" +
+	"TIME_AFTER\x10\x05\x12\x0f\n" +
+	"\vTIME_BEFORE\x10\x06*\x89\x01\n" +
+	"\x0eAlertRuleState\x12 \n" +
+	"\x1cALERT_RULE_STATE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vRULE_ACTIVE\x10\x01\x12\x0f\n" +
+	"\vRULE_FIRING\x10\x02\x12\x0e\n" +
+	"\n" +
+	"RULE_FIRED\x10\x03\x12\x0f\n" +
+	"\vRULE_FAILED\x10\x04\x12\x12\n" +
+	"\x0eRULE_CANCELLED\x10\x05*\x7f\n" +
+	"\x0fPriceAlertState\x12!\n" +
+	"\x1dPRICE_ALERT_STATE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PRICE_ALERT_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15PRICE_ALERT_TRIGGERED\x10\x02\x12\x16\n" +
+	"\x12PRICE_ALERT_PAUSED\x10\x03*t\n" +
+	"\x0eDeliveryStatus\x12\x1f\n" +
+	"\x1bDELIVERY_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10DELIVERY_PENDING\x10\x01\x12\x16\n" +
+	"\x12DELIVERY_DELIVERED\x10\x02\x12\x13\n" +
+	"\x0fDELIVERY_FAILED\x10\x03*\xce\x01\n" +
+	"\fBracketState\x12\x1d\n" +
+	"\x19BRACKET_STATE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15BRACKET_ENTRY_PENDING\x10\x01\x12\x19\n" +
+	"\x15BRACKET_PLACING_EXITS\x10\x02\x12\x12\n" +
+	"\x0eBRACKET_ACTIVE\x10\x03\x12\x13\n" +
+	"\x0fBRACKET_CLOSING\x10\x04\x12\x15\n" +
+	"\x11BRACKET_COMPLETED\x10\x05\x12\x15\n" +
+	"\x11BRACKET_CANCELLED\x10\x06\x12\x12\n" +
+	"\x0eBRACKET_FAILED\x10\a*\xb6\x01\n" +
+	"\x0eCandleInterval\x12\x1f\n" +
+	"\x1bCANDLE_INTERVAL_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ONE_MINUTE\x10\x01\x12\x10\n" +
+	"\fTHREE_MINUTE\x10\x02\x12\x0f\n" +
+	"\vFIVE_MINUTE\x10\x03\x12\x0e\n" +
+	"\n" +
+	"TEN_MINUTE\x10\x04\x12\x12\n" +
+	"\x0eFIFTEEN_MINUTE\x10\x05\x12\x11\n" +
+	"\rTHIRTY_MINUTE\x10\x06\x12\f\n" +
+	"\bONE_HOUR\x10\a\x12\v\n" +
+	"\aONE_DAY\x10\b*I\n" +
+	"\bTickMode\x12\x19\n" +
+	"\x15TICK_MODE_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03LTP\x10\x01\x12\t\n" +
+	"\x05QUOTE\x10\x02\x12\x0e\n" +
+	"\n" +
+	"SNAP_QUOTE\x10\x032\xb8\x1c\n" +
 	"\rBrokerService\x12C\n" +
 	"\n" +
 	"GetProfile\x12\x19.broker.GetProfileRequest\x1a\x1a.broker.GetProfileResponse\x127\n" +
 	"\x06Logout\x12\x15.broker.LogoutRequest\x1a\x16.broker.LogoutResponse\x12C\n" +
 	"\n" +
 	"PlaceOrder\x12\x19.broker.PlaceOrderRequest\x1a\x1a.broker.PlaceOrderResponse\x12F\n" +
-	"\vCancelOrder\x12\x1a.broker.CancelOrderRequest\x1a\x1b.broker.CancelOrderResponse\x12I\n" +
-	"\fGetOrderBook\x12\x1b.broker.GetOrderBookRequest\x1a\x1c.broker.GetOrderBookResponse\x12F\n" +
-	"\vGetHoldings\x12\x1a.broker.GetHoldingsRequest\x1a\x1b.broker.GetHoldingsResponse\x127\n" +
+	"\vPlaceBasket\x12\x1a.broker.PlaceBasketRequest\x1a\x1b.broker.PlaceBasketResponse\x12F\n" +
+	"\vCancelOrder\x12\x1a.broker.CancelOrderRequest\x1a\x1b.broker.CancelOrderResponse\x12F\n" +
+	"\vModifyOrder\x12\x1a.broker.ModifyOrderRequest\x1a\x1b.broker.ModifyOrderResponse\x12I\n" +
+	"\fGetOrderBook\x12\x1b.broker.GetOrderBookRequest\x1a\x1c.broker.GetOrderBookResponse\x12R\n" +
+	"\x0fGetOrderDetails\x12\x1e.broker.GetOrderDetailsRequest\x1a\x1f.broker.GetOrderDetailsResponse\x12I\n" +
+	"\fGetTradeBook\x12\x1b.broker.GetTradeBookRequest\x1a\x1c.broker.GetTradeBookResponse\x12N\n" +
+	"\x12StreamOrderUpdates\x12!.broker.StreamOrderUpdatesRequest\x1a\x13.broker.OrderUpdate0\x01\x12F\n" +
+	"\vGetHoldings\x12\x1a.broker.GetHoldingsRequest\x1a\x1b.broker.GetHoldingsResponse\x12I\n" +
+	"\fGetPositions\x12\x1b.broker.GetPositionsRequest\x1a\x1c.broker.GetPositionsResponse\x12R\n" +
+	"\x0fConvertPosition\x12\x1e.broker.ConvertPositionRequest\x1a\x1f.broker.ConvertPositionResponse\x12I\n" +
+	"\fGetRMSLimits\x12\x1b.broker.GetRMSLimitsRequest\x1a\x1c.broker.GetRMSLimitsResponse\x12R\n" +
+	"\x0fCalculateMargin\x12\x1e.broker.CalculateMarginRequest\x1a\x1f.broker.CalculateMarginResponse\x12@\n" +
+	"\tCreateGTT\x12\x18.broker.CreateGTTRequest\x1a\x19.broker.CreateGTTResponse\x12@\n" +
+	"\tModifyGTT\x12\x18.broker.ModifyGTTRequest\x1a\x19.broker.ModifyGTTResponse\x12@\n" +
+	"\tCancelGTT\x12\x18.broker.CancelGTTRequest\x1a\x19.broker.CancelGTTResponse\x12L\n" +
+	"\rGetGTTDetails\x12\x1c.broker.GetGTTDetailsRequest\x1a\x1d.broker.GetGTTDetailsResponse\x12:\n" +
+	"\aListGTT\x12\x16.broker.ListGTTRequest\x1a\x17.broker.ListGTTResponse\x12R\n" +
+	"\x0fCreateAlertRule\x12\x1e.broker.CreateAlertRuleRequest\x1a\x1f.broker.CreateAlertRuleResponse\x12O\n" +
+	"\x0eListAlertRules\x12\x1d.broker.ListAlertRulesRequest\x1a\x1e.broker.ListAlertRulesResponse\x12I\n" +
+	"\fGetAlertRule\x12\x1b.broker.GetAlertRuleRequest\x1a\x1c.broker.GetAlertRuleResponse\x12R\n" +
+	"\x0fCancelAlertRule\x12\x1e.broker.CancelAlertRuleRequest\x1a\x1f.broker.CancelAlertRuleResponse\x12O\n" +
+	"\x10CreatePriceAlert\x12\x1f.broker.CreatePriceAlertRequest\x1a\x1a.broker.PriceAlertResponse\x12R\n" +
+	"\x0fListPriceAlerts\x12\x1e.broker.ListPriceAlertsRequest\x1a\x1f.broker.ListPriceAlertsResponse\x12C\n" +
+	"\rGetPriceAlert\x12\x16.broker.AlertIDRequest\x1a\x1a.broker.PriceAlertResponse\x12O\n" +
+	"\x10UpdatePriceAlert\x12\x1f.broker.UpdatePriceAlertRequest\x1a\x1a.broker.PriceAlertResponse\x12G\n" +
+	"\x10DeletePriceAlert\x12\x16.broker.AlertIDRequest\x1a\x1b.broker.DeleteAlertResponse\x12U\n" +
+	"\x12CreateAlertWebhook\x12!.broker.CreateAlertWebhookRequest\x1a\x1c.broker.AlertWebhookResponse\x12X\n" +
+	"\x11ListAlertWebhooks\x12 .broker.ListAlertWebhooksRequest\x1a!.broker.ListAlertWebhooksResponse\x12I\n" +
+	"\x12DeleteAlertWebhook\x12\x16.broker.AlertIDRequest\x1a\x1b.broker.DeleteAlertResponse\x12^\n" +
+	"\x13ListAlertDeliveries\x12\".broker.ListAlertDeliveriesRequest\x1a#.broker.ListAlertDeliveriesResponse\x12S\n" +
+	"\x11PlaceBracketOrder\x12 .broker.PlaceBracketOrderRequest\x1a\x1c.broker.BracketOrderResponse\x12X\n" +
+	"\x11ListBracketOrders\x12 .broker.ListBracketOrdersRequest\x1a!.broker.ListBracketOrdersResponse\x12N\n" +
+	"\x0fGetBracketOrder\x12\x1d.broker.BracketOrderIDRequest\x1a\x1c.broker.BracketOrderResponse\x12Q\n" +
+	"\x12CancelBracketOrder\x12\x1d.broker.BracketOrderIDRequest\x1a\x1c.broker.BracketOrderResponse\x12L\n" +
+	"\rGetCandleData\x12\x1c.broker.GetCandleDataRequest\x1a\x1d.broker.GetCandleDataResponse\x12^\n" +
+	"\x13GetCandleCacheStats\x12\".broker.GetCandleCacheStatsRequest\x1a#.broker.GetCandleCacheStatsResponse\x12U\n" +
+	"\x10PurgeCandleCache\x12\x1f.broker.PurgeCandleCacheRequest\x1a .broker.PurgeCandleCacheResponse\x12X\n" +
+	"\x11SearchInstruments\x12 .broker.SearchInstrumentsRequest\x1a!.broker.SearchInstrumentsResponse\x12L\n" +
+	"\rGetInstrument\x12\x1c.broker.GetInstrumentRequest\x1a\x1d.broker.GetInstrumentResponse\x12?\n" +
+	"\x0eSubscribeTicks\x12\x1d.broker.SubscribeTicksRequest\x1a\f.broker.Tick0\x01\x127\n" +
 	"\x06GetLTP\x12\x15.broker.GetLTPRequest\x1a\x16.broker.GetLTPResponse\x12I\n" +
-	"\fGetFullQuote\x12\x1b.broker.GetFullQuoteRequest\x1a\x1c.broker.GetFullQuoteResponseB3Z1github.com/Sagar-v4/Angel-Two/protobuf/gen/brokerb\x06proto3"
+	"\fGetFullQuote\x12\x1b.broker.GetFullQuoteRequest\x1a\x1c.broker.GetFullQuoteResponse\x12O\n" +
+	"\x0eGenerateTokens\x12\x1d.broker.GenerateTokensRequest\x1a\x1e.broker.GenerateTokensResponse\x12C\n" +
+	"\n" +
+	"SetSession\x12\x19.broker.SetSessionRequest\x1a\x1a.broker.SetSessionResponseB3Z1github.com/Sagar-v4/Angel-Two/protobuf/gen/brokerb\x06proto3"
 
 var (
 	file_broker_proto_rawDescOnce sync.Once
@@ -3006,80 +12677,317 @@ func file_broker_proto_rawDescGZIP() []byte {
 	return file_broker_proto_rawDescData
 }
 
-var file_broker_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_broker_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_broker_proto_msgTypes = make([]protoimpl.MessageInfo, 124)
 var file_broker_proto_goTypes = []any{
-	(*AngelOneProfileData)(nil),                        // 0: broker.AngelOneProfileData
-	(*GetProfileRequest)(nil),                          // 1: broker.GetProfileRequest
-	(*GetProfileResponse)(nil),                         // 2: broker.GetProfileResponse
-	(*PlaceOrderRequest)(nil),                          // 3: broker.PlaceOrderRequest
-	(*PlaceOrderAngelData)(nil),                        // 4: broker.PlaceOrderAngelData
-	(*PlaceOrderResponse)(nil),                         // 5: broker.PlaceOrderResponse
-	(*CancelOrderRequest)(nil),                         // 6: broker.CancelOrderRequest
-	(*CancelOrderAngelData)(nil),                       // 7: broker.CancelOrderAngelData
-	(*CancelOrderResponse)(nil),                        // 8: broker.CancelOrderResponse
-	(*OrderBookItem)(nil),                              // 9: broker.OrderBookItem
-	(*GetOrderBookRequest)(nil),                        // 10: broker.GetOrderBookRequest
-	(*GetOrderBookResponse)(nil),                       // 11: broker.GetOrderBookResponse
-	(*HoldingItemData)(nil),                            // 12: broker.HoldingItemData
-	(*TotalHoldingValue)(nil),                          // 13: broker.TotalHoldingValue
-	(*PortfolioHoldingsData)(nil),                      // 14: broker.PortfolioHoldingsData
-	(*GetHoldingsRequest)(nil),                         // 15: broker.GetHoldingsRequest
-	(*GetHoldingsResponse)(nil),                        // 16: broker.GetHoldingsResponse
-	(*LTPData)(nil),                                    // 17: broker.LTPData
-	(*MarketDepthItem)(nil),                            // 18: broker.MarketDepthItem
-	(*MarketDepth)(nil),                                // 19: broker.MarketDepth
-	(*FullQuoteData)(nil),                              // 20: broker.FullQuoteData
-	(*UnfetchedItem)(nil),                              // 21: broker.UnfetchedItem
-	(*GetLTPRequest)(nil),                              // 22: broker.GetLTPRequest
-	(*ExchangeTokenPair)(nil),                          // 23: broker.ExchangeTokenPair
-	(*GetLTPResponse)(nil),                             // 24: broker.GetLTPResponse
-	(*GetFullQuoteRequest)(nil),                        // 25: broker.GetFullQuoteRequest
-	(*GetFullQuoteResponse)(nil),                       // 26: broker.GetFullQuoteResponse
-	(*LogoutRequest)(nil),                              // 27: broker.LogoutRequest
-	(*LogoutResponse)(nil),                             // 28: broker.LogoutResponse
-	(*GetLTPResponse_LTPResponseData)(nil),             // 29: broker.GetLTPResponse.LTPResponseData
-	(*GetFullQuoteResponse_FullQuoteResponseData)(nil), // 30: broker.GetFullQuoteResponse.FullQuoteResponseData
+	(OrderEventType)(0),                                // 0: broker.OrderEventType
+	(AlertConditionType)(0),                            // 1: broker.AlertConditionType
+	(AlertRuleState)(0),                                // 2: broker.AlertRuleState
+	(PriceAlertState)(0),                               // 3: broker.PriceAlertState
+	(DeliveryStatus)(0),                                // 4: broker.DeliveryStatus
+	(BracketState)(0),                                  // 5: broker.BracketState
+	(CandleInterval)(0),                                // 6: broker.CandleInterval
+	(TickMode)(0),                                      // 7: broker.TickMode
+	(*AngelOneProfileData)(nil),                        // 8: broker.AngelOneProfileData
+	(*GetProfileRequest)(nil),                          // 9: broker.GetProfileRequest
+	(*GetProfileResponse)(nil),                         // 10: broker.GetProfileResponse
+	(*PlaceOrderRequest)(nil),                          // 11: broker.PlaceOrderRequest
+	(*PlaceOrderAngelData)(nil),                        // 12: broker.PlaceOrderAngelData
+	(*PlaceOrderResponse)(nil),                         // 13: broker.PlaceOrderResponse
+	(*PlaceBasketRequest)(nil),                         // 14: broker.PlaceBasketRequest
+	(*BasketLegResult)(nil),                            // 15: broker.BasketLegResult
+	(*PlaceBasketResponse)(nil),                        // 16: broker.PlaceBasketResponse
+	(*CancelOrderRequest)(nil),                         // 17: broker.CancelOrderRequest
+	(*CancelOrderAngelData)(nil),                       // 18: broker.CancelOrderAngelData
+	(*CancelOrderResponse)(nil),                        // 19: broker.CancelOrderResponse
+	(*ModifyOrderRequest)(nil),                         // 20: broker.ModifyOrderRequest
+	(*ModifyOrderAngelData)(nil),                       // 21: broker.ModifyOrderAngelData
+	(*ModifyOrderResponse)(nil),                        // 22: broker.ModifyOrderResponse
+	(*OrderBookItem)(nil),                              // 23: broker.OrderBookItem
+	(*GetOrderBookRequest)(nil),                        // 24: broker.GetOrderBookRequest
+	(*GetOrderBookResponse)(nil),                       // 25: broker.GetOrderBookResponse
+	(*GetOrderDetailsRequest)(nil),                     // 26: broker.GetOrderDetailsRequest
+	(*GetOrderDetailsResponse)(nil),                    // 27: broker.GetOrderDetailsResponse
+	(*TradeBookItem)(nil),                              // 28: broker.TradeBookItem
+	(*GetTradeBookRequest)(nil),                        // 29: broker.GetTradeBookRequest
+	(*GetTradeBookResponse)(nil),                       // 30: broker.GetTradeBookResponse
+	(*StreamOrderUpdatesRequest)(nil),                  // 31: broker.StreamOrderUpdatesRequest
+	(*OrderUpdate)(nil),                                // 32: broker.OrderUpdate
+	(*HoldingItemData)(nil),                            // 33: broker.HoldingItemData
+	(*TotalHoldingValue)(nil),                          // 34: broker.TotalHoldingValue
+	(*PortfolioHoldingsData)(nil),                      // 35: broker.PortfolioHoldingsData
+	(*GetHoldingsRequest)(nil),                         // 36: broker.GetHoldingsRequest
+	(*GetHoldingsResponse)(nil),                        // 37: broker.GetHoldingsResponse
+	(*PositionItem)(nil),                               // 38: broker.PositionItem
+	(*PositionsData)(nil),                              // 39: broker.PositionsData
+	(*GetPositionsRequest)(nil),                        // 40: broker.GetPositionsRequest
+	(*GetPositionsResponse)(nil),                       // 41: broker.GetPositionsResponse
+	(*ConvertPositionRequest)(nil),                     // 42: broker.ConvertPositionRequest
+	(*ConvertPositionResponse)(nil),                    // 43: broker.ConvertPositionResponse
+	(*RMSLimits)(nil),                                  // 44: broker.RMSLimits
+	(*GetRMSLimitsRequest)(nil),                        // 45: broker.GetRMSLimitsRequest
+	(*GetRMSLimitsResponse)(nil),                       // 46: broker.GetRMSLimitsResponse
+	(*CalculateMarginRequest)(nil),                     // 47: broker.CalculateMarginRequest
+	(*MarginComponents)(nil),                           // 48: broker.MarginComponents
+	(*LegMargin)(nil),                                  // 49: broker.LegMargin
+	(*MarginData)(nil),                                 // 50: broker.MarginData
+	(*CalculateMarginResponse)(nil),                    // 51: broker.CalculateMarginResponse
+	(*GTTRule)(nil),                                    // 52: broker.GTTRule
+	(*CreateGTTRequest)(nil),                           // 53: broker.CreateGTTRequest
+	(*CreateGTTResponse)(nil),                          // 54: broker.CreateGTTResponse
+	(*ModifyGTTRequest)(nil),                           // 55: broker.ModifyGTTRequest
+	(*ModifyGTTResponse)(nil),                          // 56: broker.ModifyGTTResponse
+	(*CancelGTTRequest)(nil),                           // 57: broker.CancelGTTRequest
+	(*CancelGTTResponse)(nil),                          // 58: broker.CancelGTTResponse
+	(*GetGTTDetailsRequest)(nil),                       // 59: broker.GetGTTDetailsRequest
+	(*GetGTTDetailsResponse)(nil),                      // 60: broker.GetGTTDetailsResponse
+	(*ListGTTRequest)(nil),                             // 61: broker.ListGTTRequest
+	(*ListGTTResponse)(nil),                            // 62: broker.ListGTTResponse
+	(*AlertCondition)(nil),                             // 63: broker.AlertCondition
+	(*AlertRule)(nil),                                  // 64: broker.AlertRule
+	(*CreateAlertRuleRequest)(nil),                     // 65: broker.CreateAlertRuleRequest
+	(*CreateAlertRuleResponse)(nil),                    // 66: broker.CreateAlertRuleResponse
+	(*ListAlertRulesRequest)(nil),                      // 67: broker.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),                     // 68: broker.ListAlertRulesResponse
+	(*GetAlertRuleRequest)(nil),                        // 69: broker.GetAlertRuleRequest
+	(*GetAlertRuleResponse)(nil),                       // 70: broker.GetAlertRuleResponse
+	(*CancelAlertRuleRequest)(nil),                     // 71: broker.CancelAlertRuleRequest
+	(*CancelAlertRuleResponse)(nil),                    // 72: broker.CancelAlertRuleResponse
+	(*PriceAlert)(nil),                                 // 73: broker.PriceAlert
+	(*AlertWebhook)(nil),                               // 74: broker.AlertWebhook
+	(*WebhookDelivery)(nil),                            // 75: broker.WebhookDelivery
+	(*CreatePriceAlertRequest)(nil),                    // 76: broker.CreatePriceAlertRequest
+	(*UpdatePriceAlertRequest)(nil),                    // 77: broker.UpdatePriceAlertRequest
+	(*PriceAlertResponse)(nil),                         // 78: broker.PriceAlertResponse
+	(*ListPriceAlertsRequest)(nil),                     // 79: broker.ListPriceAlertsRequest
+	(*ListPriceAlertsResponse)(nil),                    // 80: broker.ListPriceAlertsResponse
+	(*AlertIDRequest)(nil),                             // 81: broker.AlertIDRequest
+	(*DeleteAlertResponse)(nil),                        // 82: broker.DeleteAlertResponse
+	(*CreateAlertWebhookRequest)(nil),                  // 83: broker.CreateAlertWebhookRequest
+	(*AlertWebhookResponse)(nil),                       // 84: broker.AlertWebhookResponse
+	(*ListAlertWebhooksRequest)(nil),                   // 85: broker.ListAlertWebhooksRequest
+	(*ListAlertWebhooksResponse)(nil),                  // 86: broker.ListAlertWebhooksResponse
+	(*ListAlertDeliveriesRequest)(nil),                 // 87: broker.ListAlertDeliveriesRequest
+	(*ListAlertDeliveriesResponse)(nil),                // 88: broker.ListAlertDeliveriesResponse
+	(*BracketLeg)(nil),                                 // 89: broker.BracketLeg
+	(*BracketOrder)(nil),                               // 90: broker.BracketOrder
+	(*PlaceBracketOrderRequest)(nil),                   // 91: broker.PlaceBracketOrderRequest
+	(*BracketOrderResponse)(nil),                       // 92: broker.BracketOrderResponse
+	(*ListBracketOrdersRequest)(nil),                   // 93: broker.ListBracketOrdersRequest
+	(*ListBracketOrdersResponse)(nil),                  // 94: broker.ListBracketOrdersResponse
+	(*BracketOrderIDRequest)(nil),                      // 95: broker.BracketOrderIDRequest
+	(*LTPData)(nil),                                    // 96: broker.LTPData
+	(*MarketDepthItem)(nil),                            // 97: broker.MarketDepthItem
+	(*MarketDepth)(nil),                                // 98: broker.MarketDepth
+	(*FullQuoteData)(nil),                              // 99: broker.FullQuoteData
+	(*UnfetchedItem)(nil),                              // 100: broker.UnfetchedItem
+	(*GetLTPRequest)(nil),                              // 101: broker.GetLTPRequest
+	(*ExchangeTokenPair)(nil),                          // 102: broker.ExchangeTokenPair
+	(*GetLTPResponse)(nil),                             // 103: broker.GetLTPResponse
+	(*GetCandleDataRequest)(nil),                       // 104: broker.GetCandleDataRequest
+	(*Candle)(nil),                                     // 105: broker.Candle
+	(*GetCandleDataResponse)(nil),                      // 106: broker.GetCandleDataResponse
+	(*CandleSeriesStats)(nil),                          // 107: broker.CandleSeriesStats
+	(*GetCandleCacheStatsRequest)(nil),                 // 108: broker.GetCandleCacheStatsRequest
+	(*GetCandleCacheStatsResponse)(nil),                // 109: broker.GetCandleCacheStatsResponse
+	(*PurgeCandleCacheRequest)(nil),                    // 110: broker.PurgeCandleCacheRequest
+	(*PurgeCandleCacheResponse)(nil),                   // 111: broker.PurgeCandleCacheResponse
+	(*Instrument)(nil),                                 // 112: broker.Instrument
+	(*SearchInstrumentsRequest)(nil),                   // 113: broker.SearchInstrumentsRequest
+	(*SearchInstrumentsResponse)(nil),                  // 114: broker.SearchInstrumentsResponse
+	(*GetInstrumentRequest)(nil),                       // 115: broker.GetInstrumentRequest
+	(*GetInstrumentResponse)(nil),                      // 116: broker.GetInstrumentResponse
+	(*TickInstrument)(nil),                             // 117: broker.TickInstrument
+	(*SubscribeTicksRequest)(nil),                      // 118: broker.SubscribeTicksRequest
+	(*DepthLevel)(nil),                                 // 119: broker.DepthLevel
+	(*Tick)(nil),                                       // 120: broker.Tick
+	(*GetFullQuoteRequest)(nil),                        // 121: broker.GetFullQuoteRequest
+	(*GetFullQuoteResponse)(nil),                       // 122: broker.GetFullQuoteResponse
+	(*LogoutRequest)(nil),                              // 123: broker.LogoutRequest
+	(*LogoutResponse)(nil),                             // 124: broker.LogoutResponse
+	(*GenerateTokensRequest)(nil),                      // 125: broker.GenerateTokensRequest
+	(*GenerateTokensAngelData)(nil),                    // 126: broker.GenerateTokensAngelData
+	(*GenerateTokensResponse)(nil),                     // 127: broker.GenerateTokensResponse
+	(*SetSessionRequest)(nil),                          // 128: broker.SetSessionRequest
+	(*SetSessionResponse)(nil),                         // 129: broker.SetSessionResponse
+	(*GetLTPResponse_LTPResponseData)(nil),             // 130: broker.GetLTPResponse.LTPResponseData
+	(*GetFullQuoteResponse_FullQuoteResponseData)(nil), // 131: broker.GetFullQuoteResponse.FullQuoteResponseData
 }
 var file_broker_proto_depIdxs = []int32{
-	0,  // 0: broker.GetProfileResponse.data:type_name -> broker.AngelOneProfileData
-	4,  // 1: broker.PlaceOrderResponse.data:type_name -> broker.PlaceOrderAngelData
-	7,  // 2: broker.CancelOrderResponse.data:type_name -> broker.CancelOrderAngelData
-	9,  // 3: broker.GetOrderBookResponse.data:type_name -> broker.OrderBookItem
-	12, // 4: broker.PortfolioHoldingsData.holdings:type_name -> broker.HoldingItemData
-	13, // 5: broker.PortfolioHoldingsData.totalholding:type_name -> broker.TotalHoldingValue
-	14, // 6: broker.GetHoldingsResponse.data:type_name -> broker.PortfolioHoldingsData
-	18, // 7: broker.MarketDepth.buy:type_name -> broker.MarketDepthItem
-	18, // 8: broker.MarketDepth.sell:type_name -> broker.MarketDepthItem
-	19, // 9: broker.FullQuoteData.depth:type_name -> broker.MarketDepth
-	23, // 10: broker.GetLTPRequest.exchange_tokens:type_name -> broker.ExchangeTokenPair
-	29, // 11: broker.GetLTPResponse.data:type_name -> broker.GetLTPResponse.LTPResponseData
-	23, // 12: broker.GetFullQuoteRequest.exchange_tokens:type_name -> broker.ExchangeTokenPair
-	30, // 13: broker.GetFullQuoteResponse.data:type_name -> broker.GetFullQuoteResponse.FullQuoteResponseData
-	17, // 14: broker.GetLTPResponse.LTPResponseData.fetched:type_name -> broker.LTPData
-	21, // 15: broker.GetLTPResponse.LTPResponseData.unfetched:type_name -> broker.UnfetchedItem
-	20, // 16: broker.GetFullQuoteResponse.FullQuoteResponseData.fetched:type_name -> broker.FullQuoteData
-	21, // 17: broker.GetFullQuoteResponse.FullQuoteResponseData.unfetched:type_name -> broker.UnfetchedItem
-	1,  // 18: broker.BrokerService.GetProfile:input_type -> broker.GetProfileRequest
-	27, // 19: broker.BrokerService.Logout:input_type -> broker.LogoutRequest
-	3,  // 20: broker.BrokerService.PlaceOrder:input_type -> broker.PlaceOrderRequest
-	6,  // 21: broker.BrokerService.CancelOrder:input_type -> broker.CancelOrderRequest
-	10, // 22: broker.BrokerService.GetOrderBook:input_type -> broker.GetOrderBookRequest
-	15, // 23: broker.BrokerService.GetHoldings:input_type -> broker.GetHoldingsRequest
-	22, // 24: broker.BrokerService.GetLTP:input_type -> broker.GetLTPRequest
-	25, // 25: broker.BrokerService.GetFullQuote:input_type -> broker.GetFullQuoteRequest
-	2,  // 26: broker.BrokerService.GetProfile:output_type -> broker.GetProfileResponse
-	28, // 27: broker.BrokerService.Logout:output_type -> broker.LogoutResponse
-	5,  // 28: broker.BrokerService.PlaceOrder:output_type -> broker.PlaceOrderResponse
-	8,  // 29: broker.BrokerService.CancelOrder:output_type -> broker.CancelOrderResponse
-	11, // 30: broker.BrokerService.GetOrderBook:output_type -> broker.GetOrderBookResponse
-	16, // 31: broker.BrokerService.GetHoldings:output_type -> broker.GetHoldingsResponse
-	24, // 32: broker.BrokerService.GetLTP:output_type -> broker.GetLTPResponse
-	26, // 33: broker.BrokerService.GetFullQuote:output_type -> broker.GetFullQuoteResponse
-	26, // [26:34] is the sub-list for method output_type
-	18, // [18:26] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	8,   // 0: broker.GetProfileResponse.data:type_name -> broker.AngelOneProfileData
+	12,  // 1: broker.PlaceOrderResponse.data:type_name -> broker.PlaceOrderAngelData
+	11,  // 2: broker.PlaceBasketRequest.legs:type_name -> broker.PlaceOrderRequest
+	15,  // 3: broker.PlaceBasketResponse.legs:type_name -> broker.BasketLegResult
+	18,  // 4: broker.CancelOrderResponse.data:type_name -> broker.CancelOrderAngelData
+	21,  // 5: broker.ModifyOrderResponse.data:type_name -> broker.ModifyOrderAngelData
+	28,  // 6: broker.OrderBookItem.trades:type_name -> broker.TradeBookItem
+	23,  // 7: broker.GetOrderBookResponse.data:type_name -> broker.OrderBookItem
+	23,  // 8: broker.GetOrderDetailsResponse.data:type_name -> broker.OrderBookItem
+	28,  // 9: broker.GetTradeBookResponse.data:type_name -> broker.TradeBookItem
+	0,   // 10: broker.OrderUpdate.type:type_name -> broker.OrderEventType
+	23,  // 11: broker.OrderUpdate.order:type_name -> broker.OrderBookItem
+	33,  // 12: broker.PortfolioHoldingsData.holdings:type_name -> broker.HoldingItemData
+	34,  // 13: broker.PortfolioHoldingsData.totalholding:type_name -> broker.TotalHoldingValue
+	35,  // 14: broker.GetHoldingsResponse.data:type_name -> broker.PortfolioHoldingsData
+	38,  // 15: broker.PositionsData.net:type_name -> broker.PositionItem
+	38,  // 16: broker.PositionsData.day:type_name -> broker.PositionItem
+	39,  // 17: broker.GetPositionsResponse.data:type_name -> broker.PositionsData
+	44,  // 18: broker.GetRMSLimitsResponse.data:type_name -> broker.RMSLimits
+	11,  // 19: broker.CalculateMarginRequest.legs:type_name -> broker.PlaceOrderRequest
+	48,  // 20: broker.MarginData.components:type_name -> broker.MarginComponents
+	49,  // 21: broker.MarginData.legs:type_name -> broker.LegMargin
+	50,  // 22: broker.CalculateMarginResponse.data:type_name -> broker.MarginData
+	52,  // 23: broker.GetGTTDetailsResponse.data:type_name -> broker.GTTRule
+	52,  // 24: broker.ListGTTResponse.data:type_name -> broker.GTTRule
+	1,   // 25: broker.AlertCondition.type:type_name -> broker.AlertConditionType
+	63,  // 26: broker.AlertRule.conditions:type_name -> broker.AlertCondition
+	11,  // 27: broker.AlertRule.order:type_name -> broker.PlaceOrderRequest
+	2,   // 28: broker.AlertRule.state:type_name -> broker.AlertRuleState
+	64,  // 29: broker.CreateAlertRuleRequest.rule:type_name -> broker.AlertRule
+	64,  // 30: broker.CreateAlertRuleResponse.data:type_name -> broker.AlertRule
+	64,  // 31: broker.ListAlertRulesResponse.data:type_name -> broker.AlertRule
+	64,  // 32: broker.GetAlertRuleResponse.data:type_name -> broker.AlertRule
+	64,  // 33: broker.CancelAlertRuleResponse.data:type_name -> broker.AlertRule
+	63,  // 34: broker.PriceAlert.condition:type_name -> broker.AlertCondition
+	3,   // 35: broker.PriceAlert.state:type_name -> broker.PriceAlertState
+	4,   // 36: broker.WebhookDelivery.status:type_name -> broker.DeliveryStatus
+	73,  // 37: broker.CreatePriceAlertRequest.alert:type_name -> broker.PriceAlert
+	63,  // 38: broker.UpdatePriceAlertRequest.condition:type_name -> broker.AlertCondition
+	73,  // 39: broker.PriceAlertResponse.data:type_name -> broker.PriceAlert
+	73,  // 40: broker.ListPriceAlertsResponse.data:type_name -> broker.PriceAlert
+	74,  // 41: broker.AlertWebhookResponse.data:type_name -> broker.AlertWebhook
+	74,  // 42: broker.ListAlertWebhooksResponse.data:type_name -> broker.AlertWebhook
+	75,  // 43: broker.ListAlertDeliveriesResponse.data:type_name -> broker.WebhookDelivery
+	11,  // 44: broker.BracketOrder.entry:type_name -> broker.PlaceOrderRequest
+	5,   // 45: broker.BracketOrder.state:type_name -> broker.BracketState
+	89,  // 46: broker.BracketOrder.entry_leg:type_name -> broker.BracketLeg
+	89,  // 47: broker.BracketOrder.target_leg:type_name -> broker.BracketLeg
+	89,  // 48: broker.BracketOrder.stoploss_leg:type_name -> broker.BracketLeg
+	11,  // 49: broker.PlaceBracketOrderRequest.entry:type_name -> broker.PlaceOrderRequest
+	90,  // 50: broker.BracketOrderResponse.data:type_name -> broker.BracketOrder
+	90,  // 51: broker.ListBracketOrdersResponse.data:type_name -> broker.BracketOrder
+	97,  // 52: broker.MarketDepth.buy:type_name -> broker.MarketDepthItem
+	97,  // 53: broker.MarketDepth.sell:type_name -> broker.MarketDepthItem
+	98,  // 54: broker.FullQuoteData.depth:type_name -> broker.MarketDepth
+	102, // 55: broker.GetLTPRequest.exchange_tokens:type_name -> broker.ExchangeTokenPair
+	130, // 56: broker.GetLTPResponse.data:type_name -> broker.GetLTPResponse.LTPResponseData
+	6,   // 57: broker.GetCandleDataRequest.interval:type_name -> broker.CandleInterval
+	105, // 58: broker.GetCandleDataResponse.data:type_name -> broker.Candle
+	6,   // 59: broker.CandleSeriesStats.interval:type_name -> broker.CandleInterval
+	107, // 60: broker.GetCandleCacheStatsResponse.series_stats:type_name -> broker.CandleSeriesStats
+	112, // 61: broker.SearchInstrumentsResponse.data:type_name -> broker.Instrument
+	112, // 62: broker.GetInstrumentResponse.data:type_name -> broker.Instrument
+	7,   // 63: broker.SubscribeTicksRequest.mode:type_name -> broker.TickMode
+	117, // 64: broker.SubscribeTicksRequest.instruments:type_name -> broker.TickInstrument
+	7,   // 65: broker.Tick.mode:type_name -> broker.TickMode
+	119, // 66: broker.Tick.best_bids:type_name -> broker.DepthLevel
+	119, // 67: broker.Tick.best_asks:type_name -> broker.DepthLevel
+	102, // 68: broker.GetFullQuoteRequest.exchange_tokens:type_name -> broker.ExchangeTokenPair
+	131, // 69: broker.GetFullQuoteResponse.data:type_name -> broker.GetFullQuoteResponse.FullQuoteResponseData
+	126, // 70: broker.GenerateTokensResponse.data:type_name -> broker.GenerateTokensAngelData
+	96,  // 71: broker.GetLTPResponse.LTPResponseData.fetched:type_name -> broker.LTPData
+	100, // 72: broker.GetLTPResponse.LTPResponseData.unfetched:type_name -> broker.UnfetchedItem
+	99,  // 73: broker.GetFullQuoteResponse.FullQuoteResponseData.fetched:type_name -> broker.FullQuoteData
+	100, // 74: broker.GetFullQuoteResponse.FullQuoteResponseData.unfetched:type_name -> broker.UnfetchedItem
+	9,   // 75: broker.BrokerService.GetProfile:input_type -> broker.GetProfileRequest
+	123, // 76: broker.BrokerService.Logout:input_type -> broker.LogoutRequest
+	11,  // 77: broker.BrokerService.PlaceOrder:input_type -> broker.PlaceOrderRequest
+	14,  // 78: broker.BrokerService.PlaceBasket:input_type -> broker.PlaceBasketRequest
+	17,  // 79: broker.BrokerService.CancelOrder:input_type -> broker.CancelOrderRequest
+	20,  // 80: broker.BrokerService.ModifyOrder:input_type -> broker.ModifyOrderRequest
+	24,  // 81: broker.BrokerService.GetOrderBook:input_type -> broker.GetOrderBookRequest
+	26,  // 82: broker.BrokerService.GetOrderDetails:input_type -> broker.GetOrderDetailsRequest
+	29,  // 83: broker.BrokerService.GetTradeBook:input_type -> broker.GetTradeBookRequest
+	31,  // 84: broker.BrokerService.StreamOrderUpdates:input_type -> broker.StreamOrderUpdatesRequest
+	36,  // 85: broker.BrokerService.GetHoldings:input_type -> broker.GetHoldingsRequest
+	40,  // 86: broker.BrokerService.GetPositions:input_type -> broker.GetPositionsRequest
+	42,  // 87: broker.BrokerService.ConvertPosition:input_type -> broker.ConvertPositionRequest
+	45,  // 88: broker.BrokerService.GetRMSLimits:input_type -> broker.GetRMSLimitsRequest
+	47,  // 89: broker.BrokerService.CalculateMargin:input_type -> broker.CalculateMarginRequest
+	53,  // 90: broker.BrokerService.CreateGTT:input_type -> broker.CreateGTTRequest
+	55,  // 91: broker.BrokerService.ModifyGTT:input_type -> broker.ModifyGTTRequest
+	57,  // 92: broker.BrokerService.CancelGTT:input_type -> broker.CancelGTTRequest
+	59,  // 93: broker.BrokerService.GetGTTDetails:input_type -> broker.GetGTTDetailsRequest
+	61,  // 94: broker.BrokerService.ListGTT:input_type -> broker.ListGTTRequest
+	65,  // 95: broker.BrokerService.CreateAlertRule:input_type -> broker.CreateAlertRuleRequest
+	67,  // 96: broker.BrokerService.ListAlertRules:input_type -> broker.ListAlertRulesRequest
+	69,  // 97: broker.BrokerService.GetAlertRule:input_type -> broker.GetAlertRuleRequest
+	71,  // 98: broker.BrokerService.CancelAlertRule:input_type -> broker.CancelAlertRuleRequest
+	76,  // 99: broker.BrokerService.CreatePriceAlert:input_type -> broker.CreatePriceAlertRequest
+	79,  // 100: broker.BrokerService.ListPriceAlerts:input_type -> broker.ListPriceAlertsRequest
+	81,  // 101: broker.BrokerService.GetPriceAlert:input_type -> broker.AlertIDRequest
+	77,  // 102: broker.BrokerService.UpdatePriceAlert:input_type -> broker.UpdatePriceAlertRequest
+	81,  // 103: broker.BrokerService.DeletePriceAlert:input_type -> broker.AlertIDRequest
+	83,  // 104: broker.BrokerService.CreateAlertWebhook:input_type -> broker.CreateAlertWebhookRequest
+	85,  // 105: broker.BrokerService.ListAlertWebhooks:input_type -> broker.ListAlertWebhooksRequest
+	81,  // 106: broker.BrokerService.DeleteAlertWebhook:input_type -> broker.AlertIDRequest
+	87,  // 107: broker.BrokerService.ListAlertDeliveries:input_type -> broker.ListAlertDeliveriesRequest
+	91,  // 108: broker.BrokerService.PlaceBracketOrder:input_type -> broker.PlaceBracketOrderRequest
+	93,  // 109: broker.BrokerService.ListBracketOrders:input_type -> broker.ListBracketOrdersRequest
+	95,  // 110: broker.BrokerService.GetBracketOrder:input_type -> broker.BracketOrderIDRequest
+	95,  // 111: broker.BrokerService.CancelBracketOrder:input_type -> broker.BracketOrderIDRequest
+	104, // 112: broker.BrokerService.GetCandleData:input_type -> broker.GetCandleDataRequest
+	108, // 113: broker.BrokerService.GetCandleCacheStats:input_type -> broker.GetCandleCacheStatsRequest
+	110, // 114: broker.BrokerService.PurgeCandleCache:input_type -> broker.PurgeCandleCacheRequest
+	113, // 115: broker.BrokerService.SearchInstruments:input_type -> broker.SearchInstrumentsRequest
+	115, // 116: broker.BrokerService.GetInstrument:input_type -> broker.GetInstrumentRequest
+	118, // 117: broker.BrokerService.SubscribeTicks:input_type -> broker.SubscribeTicksRequest
+	101, // 118: broker.BrokerService.GetLTP:input_type -> broker.GetLTPRequest
+	121, // 119: broker.BrokerService.GetFullQuote:input_type -> broker.GetFullQuoteRequest
+	125, // 120: broker.BrokerService.GenerateTokens:input_type -> broker.GenerateTokensRequest
+	128, // 121: broker.BrokerService.SetSession:input_type -> broker.SetSessionRequest
+	10,  // 122: broker.BrokerService.GetProfile:output_type -> broker.GetProfileResponse
+	124, // 123: broker.BrokerService.Logout:output_type -> broker.LogoutResponse
+	13,  // 124: broker.BrokerService.PlaceOrder:output_type -> broker.PlaceOrderResponse
+	16,  // 125: broker.BrokerService.PlaceBasket:output_type -> broker.PlaceBasketResponse
+	19,  // 126: broker.BrokerService.CancelOrder:output_type -> broker.CancelOrderResponse
+	22,  // 127: broker.BrokerService.ModifyOrder:output_type -> broker.ModifyOrderResponse
+	25,  // 128: broker.BrokerService.GetOrderBook:output_type -> broker.GetOrderBookResponse
+	27,  // 129: broker.BrokerService.GetOrderDetails:output_type -> broker.GetOrderDetailsResponse
+	30,  // 130: broker.BrokerService.GetTradeBook:output_type -> broker.GetTradeBookResponse
+	32,  // 131: broker.BrokerService.StreamOrderUpdates:output_type -> broker.OrderUpdate
+	37,  // 132: broker.BrokerService.GetHoldings:output_type -> broker.GetHoldingsResponse
+	41,  // 133: broker.BrokerService.GetPositions:output_type -> broker.GetPositionsResponse
+	43,  // 134: broker.BrokerService.ConvertPosition:output_type -> broker.ConvertPositionResponse
+	46,  // 135: broker.BrokerService.GetRMSLimits:output_type -> broker.GetRMSLimitsResponse
+	51,  // 136: broker.BrokerService.CalculateMargin:output_type -> broker.CalculateMarginResponse
+	54,  // 137: broker.BrokerService.CreateGTT:output_type -> broker.CreateGTTResponse
+	56,  // 138: broker.BrokerService.ModifyGTT:output_type -> broker.ModifyGTTResponse
+	58,  // 139: broker.BrokerService.CancelGTT:output_type -> broker.CancelGTTResponse
+	60,  // 140: broker.BrokerService.GetGTTDetails:output_type -> broker.GetGTTDetailsResponse
+	62,  // 141: broker.BrokerService.ListGTT:output_type -> broker.ListGTTResponse
+	66,  // 142: broker.BrokerService.CreateAlertRule:output_type -> broker.CreateAlertRuleResponse
+	68,  // 143: broker.BrokerService.ListAlertRules:output_type -> broker.ListAlertRulesResponse
+	70,  // 144: broker.BrokerService.GetAlertRule:output_type -> broker.GetAlertRuleResponse
+	72,  // 145: broker.BrokerService.CancelAlertRule:output_type -> broker.CancelAlertRuleResponse
+	78,  // 146: broker.BrokerService.CreatePriceAlert:output_type -> broker.PriceAlertResponse
+	80,  // 147: broker.BrokerService.ListPriceAlerts:output_type -> broker.ListPriceAlertsResponse
+	78,  // 148: broker.BrokerService.GetPriceAlert:output_type -> broker.PriceAlertResponse
+	78,  // 149: broker.BrokerService.UpdatePriceAlert:output_type -> broker.PriceAlertResponse
+	82,  // 150: broker.BrokerService.DeletePriceAlert:output_type -> broker.DeleteAlertResponse
+	84,  // 151: broker.BrokerService.CreateAlertWebhook:output_type -> broker.AlertWebhookResponse
+	86,  // 152: broker.BrokerService.ListAlertWebhooks:output_type -> broker.ListAlertWebhooksResponse
+	82,  // 153: broker.BrokerService.DeleteAlertWebhook:output_type -> broker.DeleteAlertResponse
+	88,  // 154: broker.BrokerService.ListAlertDeliveries:output_type -> broker.ListAlertDeliveriesResponse
+	92,  // 155: broker.BrokerService.PlaceBracketOrder:output_type -> broker.BracketOrderResponse
+	94,  // 156: broker.BrokerService.ListBracketOrders:output_type -> broker.ListBracketOrdersResponse
+	92,  // 157: broker.BrokerService.GetBracketOrder:output_type -> broker.BracketOrderResponse
+	92,  // 158: broker.BrokerService.CancelBracketOrder:output_type -> broker.BracketOrderResponse
+	106, // 159: broker.BrokerService.GetCandleData:output_type -> broker.GetCandleDataResponse
+	109, // 160: broker.BrokerService.GetCandleCacheStats:output_type -> broker.GetCandleCacheStatsResponse
+	111, // 161: broker.BrokerService.PurgeCandleCache:output_type -> broker.PurgeCandleCacheResponse
+	114, // 162: broker.BrokerService.SearchInstruments:output_type -> broker.SearchInstrumentsResponse
+	116, // 163: broker.BrokerService.GetInstrument:output_type -> broker.GetInstrumentResponse
+	120, // 164: broker.BrokerService.SubscribeTicks:output_type -> broker.Tick
+	103, // 165: broker.BrokerService.GetLTP:output_type -> broker.GetLTPResponse
+	122, // 166: broker.BrokerService.GetFullQuote:output_type -> broker.GetFullQuoteResponse
+	127, // 167: broker.BrokerService.GenerateTokens:output_type -> broker.GenerateTokensResponse
+	129, // 168: broker.BrokerService.SetSession:output_type -> broker.SetSessionResponse
+	122, // [122:169] is the sub-list for method output_type
+	75,  // [75:122] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_broker_proto_init() }
@@ -3092,13 +13000,14 @@ func file_broker_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_broker_proto_rawDesc), len(file_broker_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   31,
+			NumEnums:      8,
+			NumMessages:   124,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_broker_proto_goTypes,
 		DependencyIndexes: file_broker_proto_depIdxs,
+		EnumInfos:         file_broker_proto_enumTypes,
 		MessageInfos:      file_broker_proto_msgTypes,
 	}.Build()
 	File_broker_proto = out.File
@@ -19,14 +19,53 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BrokerService_GetProfile_FullMethodName   = "/broker.BrokerService/GetProfile"
-	BrokerService_Logout_FullMethodName       = "/broker.BrokerService/Logout"
-	BrokerService_PlaceOrder_FullMethodName   = "/broker.BrokerService/PlaceOrder"
-	BrokerService_CancelOrder_FullMethodName  = "/broker.BrokerService/CancelOrder"
-	BrokerService_GetOrderBook_FullMethodName = "/broker.BrokerService/GetOrderBook"
-	BrokerService_GetHoldings_FullMethodName  = "/broker.BrokerService/GetHoldings"
-	BrokerService_GetLTP_FullMethodName       = "/broker.BrokerService/GetLTP"
-	BrokerService_GetFullQuote_FullMethodName = "/broker.BrokerService/GetFullQuote"
+	BrokerService_GetProfile_FullMethodName          = "/broker.BrokerService/GetProfile"
+	BrokerService_Logout_FullMethodName              = "/broker.BrokerService/Logout"
+	BrokerService_PlaceOrder_FullMethodName          = "/broker.BrokerService/PlaceOrder"
+	BrokerService_PlaceBasket_FullMethodName         = "/broker.BrokerService/PlaceBasket"
+	BrokerService_CancelOrder_FullMethodName         = "/broker.BrokerService/CancelOrder"
+	BrokerService_ModifyOrder_FullMethodName         = "/broker.BrokerService/ModifyOrder"
+	BrokerService_GetOrderBook_FullMethodName        = "/broker.BrokerService/GetOrderBook"
+	BrokerService_GetOrderDetails_FullMethodName     = "/broker.BrokerService/GetOrderDetails"
+	BrokerService_GetTradeBook_FullMethodName        = "/broker.BrokerService/GetTradeBook"
+	BrokerService_StreamOrderUpdates_FullMethodName  = "/broker.BrokerService/StreamOrderUpdates"
+	BrokerService_GetHoldings_FullMethodName         = "/broker.BrokerService/GetHoldings"
+	BrokerService_GetPositions_FullMethodName        = "/broker.BrokerService/GetPositions"
+	BrokerService_ConvertPosition_FullMethodName     = "/broker.BrokerService/ConvertPosition"
+	BrokerService_GetRMSLimits_FullMethodName        = "/broker.BrokerService/GetRMSLimits"
+	BrokerService_CalculateMargin_FullMethodName     = "/broker.BrokerService/CalculateMargin"
+	BrokerService_CreateGTT_FullMethodName           = "/broker.BrokerService/CreateGTT"
+	BrokerService_ModifyGTT_FullMethodName           = "/broker.BrokerService/ModifyGTT"
+	BrokerService_CancelGTT_FullMethodName           = "/broker.BrokerService/CancelGTT"
+	BrokerService_GetGTTDetails_FullMethodName       = "/broker.BrokerService/GetGTTDetails"
+	BrokerService_ListGTT_FullMethodName             = "/broker.BrokerService/ListGTT"
+	BrokerService_CreateAlertRule_FullMethodName     = "/broker.BrokerService/CreateAlertRule"
+	BrokerService_ListAlertRules_FullMethodName      = "/broker.BrokerService/ListAlertRules"
+	BrokerService_GetAlertRule_FullMethodName        = "/broker.BrokerService/GetAlertRule"
+	BrokerService_CancelAlertRule_FullMethodName     = "/broker.BrokerService/CancelAlertRule"
+	BrokerService_CreatePriceAlert_FullMethodName    = "/broker.BrokerService/CreatePriceAlert"
+	BrokerService_ListPriceAlerts_FullMethodName     = "/broker.BrokerService/ListPriceAlerts"
+	BrokerService_GetPriceAlert_FullMethodName       = "/broker.BrokerService/GetPriceAlert"
+	BrokerService_UpdatePriceAlert_FullMethodName    = "/broker.BrokerService/UpdatePriceAlert"
+	BrokerService_DeletePriceAlert_FullMethodName    = "/broker.BrokerService/DeletePriceAlert"
+	BrokerService_CreateAlertWebhook_FullMethodName  = "/broker.BrokerService/CreateAlertWebhook"
+	BrokerService_ListAlertWebhooks_FullMethodName   = "/broker.BrokerService/ListAlertWebhooks"
+	BrokerService_DeleteAlertWebhook_FullMethodName  = "/broker.BrokerService/DeleteAlertWebhook"
+	BrokerService_ListAlertDeliveries_FullMethodName = "/broker.BrokerService/ListAlertDeliveries"
+	BrokerService_PlaceBracketOrder_FullMethodName   = "/broker.BrokerService/PlaceBracketOrder"
+	BrokerService_ListBracketOrders_FullMethodName   = "/broker.BrokerService/ListBracketOrders"
+	BrokerService_GetBracketOrder_FullMethodName     = "/broker.BrokerService/GetBracketOrder"
+	BrokerService_CancelBracketOrder_FullMethodName  = "/broker.BrokerService/CancelBracketOrder"
+	BrokerService_GetCandleData_FullMethodName       = "/broker.BrokerService/GetCandleData"
+	BrokerService_GetCandleCacheStats_FullMethodName = "/broker.BrokerService/GetCandleCacheStats"
+	BrokerService_PurgeCandleCache_FullMethodName    = "/broker.BrokerService/PurgeCandleCache"
+	BrokerService_SearchInstruments_FullMethodName   = "/broker.BrokerService/SearchInstruments"
+	BrokerService_GetInstrument_FullMethodName       = "/broker.BrokerService/GetInstrument"
+	BrokerService_SubscribeTicks_FullMethodName      = "/broker.BrokerService/SubscribeTicks"
+	BrokerService_GetLTP_FullMethodName              = "/broker.BrokerService/GetLTP"
+	BrokerService_GetFullQuote_FullMethodName        = "/broker.BrokerService/GetFullQuote"
+	BrokerService_GenerateTokens_FullMethodName      = "/broker.BrokerService/GenerateTokens"
+	BrokerService_SetSession_FullMethodName          = "/broker.BrokerService/SetSession"
 )
 
 // BrokerServiceClient is the client API for BrokerService service.
@@ -36,11 +75,51 @@ type BrokerServiceClient interface {
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error)
+	PlaceBasket(ctx context.Context, in *PlaceBasketRequest, opts ...grpc.CallOption) (*PlaceBasketResponse, error)
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	ModifyOrder(ctx context.Context, in *ModifyOrderRequest, opts ...grpc.CallOption) (*ModifyOrderResponse, error)
 	GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*GetOrderBookResponse, error)
+	GetOrderDetails(ctx context.Context, in *GetOrderDetailsRequest, opts ...grpc.CallOption) (*GetOrderDetailsResponse, error)
+	GetTradeBook(ctx context.Context, in *GetTradeBookRequest, opts ...grpc.CallOption) (*GetTradeBookResponse, error)
+	StreamOrderUpdates(ctx context.Context, in *StreamOrderUpdatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderUpdate], error)
 	GetHoldings(ctx context.Context, in *GetHoldingsRequest, opts ...grpc.CallOption) (*GetHoldingsResponse, error)
+	GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*GetPositionsResponse, error)
+	ConvertPosition(ctx context.Context, in *ConvertPositionRequest, opts ...grpc.CallOption) (*ConvertPositionResponse, error)
+	GetRMSLimits(ctx context.Context, in *GetRMSLimitsRequest, opts ...grpc.CallOption) (*GetRMSLimitsResponse, error)
+	CalculateMargin(ctx context.Context, in *CalculateMarginRequest, opts ...grpc.CallOption) (*CalculateMarginResponse, error)
+	CreateGTT(ctx context.Context, in *CreateGTTRequest, opts ...grpc.CallOption) (*CreateGTTResponse, error)
+	ModifyGTT(ctx context.Context, in *ModifyGTTRequest, opts ...grpc.CallOption) (*ModifyGTTResponse, error)
+	CancelGTT(ctx context.Context, in *CancelGTTRequest, opts ...grpc.CallOption) (*CancelGTTResponse, error)
+	GetGTTDetails(ctx context.Context, in *GetGTTDetailsRequest, opts ...grpc.CallOption) (*GetGTTDetailsResponse, error)
+	ListGTT(ctx context.Context, in *ListGTTRequest, opts ...grpc.CallOption) (*ListGTTResponse, error)
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	GetAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*GetAlertRuleResponse, error)
+	CancelAlertRule(ctx context.Context, in *CancelAlertRuleRequest, opts ...grpc.CallOption) (*CancelAlertRuleResponse, error)
+	CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertResponse, error)
+	ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error)
+	GetPriceAlert(ctx context.Context, in *AlertIDRequest, opts ...grpc.CallOption) (*PriceAlertResponse, error)
+	UpdatePriceAlert(ctx context.Context, in *UpdatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertResponse, error)
+	DeletePriceAlert(ctx context.Context, in *AlertIDRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error)
+	CreateAlertWebhook(ctx context.Context, in *CreateAlertWebhookRequest, opts ...grpc.CallOption) (*AlertWebhookResponse, error)
+	ListAlertWebhooks(ctx context.Context, in *ListAlertWebhooksRequest, opts ...grpc.CallOption) (*ListAlertWebhooksResponse, error)
+	DeleteAlertWebhook(ctx context.Context, in *AlertIDRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error)
+	ListAlertDeliveries(ctx context.Context, in *ListAlertDeliveriesRequest, opts ...grpc.CallOption) (*ListAlertDeliveriesResponse, error)
+	PlaceBracketOrder(ctx context.Context, in *PlaceBracketOrderRequest, opts ...grpc.CallOption) (*BracketOrderResponse, error)
+	ListBracketOrders(ctx context.Context, in *ListBracketOrdersRequest, opts ...grpc.CallOption) (*ListBracketOrdersResponse, error)
+	GetBracketOrder(ctx context.Context, in *BracketOrderIDRequest, opts ...grpc.CallOption) (*BracketOrderResponse, error)
+	CancelBracketOrder(ctx context.Context, in *BracketOrderIDRequest, opts ...grpc.CallOption) (*BracketOrderResponse, error)
+	GetCandleData(ctx context.Context, in *GetCandleDataRequest, opts ...grpc.CallOption) (*GetCandleDataResponse, error)
+	// Candle cache administration; not exposed through the API gateway.
+	GetCandleCacheStats(ctx context.Context, in *GetCandleCacheStatsRequest, opts ...grpc.CallOption) (*GetCandleCacheStatsResponse, error)
+	PurgeCandleCache(ctx context.Context, in *PurgeCandleCacheRequest, opts ...grpc.CallOption) (*PurgeCandleCacheResponse, error)
+	SearchInstruments(ctx context.Context, in *SearchInstrumentsRequest, opts ...grpc.CallOption) (*SearchInstrumentsResponse, error)
+	GetInstrument(ctx context.Context, in *GetInstrumentRequest, opts ...grpc.CallOption) (*GetInstrumentResponse, error)
+	SubscribeTicks(ctx context.Context, in *SubscribeTicksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Tick], error)
 	GetLTP(ctx context.Context, in *GetLTPRequest, opts ...grpc.CallOption) (*GetLTPResponse, error)
 	GetFullQuote(ctx context.Context, in *GetFullQuoteRequest, opts ...grpc.CallOption) (*GetFullQuoteResponse, error)
+	GenerateTokens(ctx context.Context, in *GenerateTokensRequest, opts ...grpc.CallOption) (*GenerateTokensResponse, error)
+	SetSession(ctx context.Context, in *SetSessionRequest, opts ...grpc.CallOption) (*SetSessionResponse, error)
 }
 
 type brokerServiceClient struct {
@@ -81,6 +160,16 @@ func (c *brokerServiceClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequ
 	return out, nil
 }
 
+func (c *brokerServiceClient) PlaceBasket(ctx context.Context, in *PlaceBasketRequest, opts ...grpc.CallOption) (*PlaceBasketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceBasketResponse)
+	err := c.cc.Invoke(ctx, BrokerService_PlaceBasket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *brokerServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CancelOrderResponse)
@@ -91,6 +180,16 @@ func (c *brokerServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRe
 	return out, nil
 }
 
+func (c *brokerServiceClient) ModifyOrder(ctx context.Context, in *ModifyOrderRequest, opts ...grpc.CallOption) (*ModifyOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModifyOrderResponse)
+	err := c.cc.Invoke(ctx, BrokerService_ModifyOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *brokerServiceClient) GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*GetOrderBookResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOrderBookResponse)
@@ -101,6 +200,45 @@ func (c *brokerServiceClient) GetOrderBook(ctx context.Context, in *GetOrderBook
 	return out, nil
 }
 
+func (c *brokerServiceClient) GetOrderDetails(ctx context.Context, in *GetOrderDetailsRequest, opts ...grpc.CallOption) (*GetOrderDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderDetailsResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetOrderDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetTradeBook(ctx context.Context, in *GetTradeBookRequest, opts ...grpc.CallOption) (*GetTradeBookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTradeBookResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetTradeBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) StreamOrderUpdates(ctx context.Context, in *StreamOrderUpdatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BrokerService_ServiceDesc.Streams[0], BrokerService_StreamOrderUpdates_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamOrderUpdatesRequest, OrderUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrokerService_StreamOrderUpdatesClient = grpc.ServerStreamingClient[OrderUpdate]
+
 func (c *brokerServiceClient) GetHoldings(ctx context.Context, in *GetHoldingsRequest, opts ...grpc.CallOption) (*GetHoldingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetHoldingsResponse)
@@ -108,204 +246,1362 @@ func (c *brokerServiceClient) GetHoldings(ctx context.Context, in *GetHoldingsRe
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*GetPositionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPositionsResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetPositions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) ConvertPosition(ctx context.Context, in *ConvertPositionRequest, opts ...grpc.CallOption) (*ConvertPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertPositionResponse)
+	err := c.cc.Invoke(ctx, BrokerService_ConvertPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetRMSLimits(ctx context.Context, in *GetRMSLimitsRequest, opts ...grpc.CallOption) (*GetRMSLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRMSLimitsResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetRMSLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) CalculateMargin(ctx context.Context, in *CalculateMarginRequest, opts ...grpc.CallOption) (*CalculateMarginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateMarginResponse)
+	err := c.cc.Invoke(ctx, BrokerService_CalculateMargin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) CreateGTT(ctx context.Context, in *CreateGTTRequest, opts ...grpc.CallOption) (*CreateGTTResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGTTResponse)
+	err := c.cc.Invoke(ctx, BrokerService_CreateGTT_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) ModifyGTT(ctx context.Context, in *ModifyGTTRequest, opts ...grpc.CallOption) (*ModifyGTTResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModifyGTTResponse)
+	err := c.cc.Invoke(ctx, BrokerService_ModifyGTT_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) CancelGTT(ctx context.Context, in *CancelGTTRequest, opts ...grpc.CallOption) (*CancelGTTResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelGTTResponse)
+	err := c.cc.Invoke(ctx, BrokerService_CancelGTT_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetGTTDetails(ctx context.Context, in *GetGTTDetailsRequest, opts ...grpc.CallOption) (*GetGTTDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGTTDetailsResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetGTTDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) ListGTT(ctx context.Context, in *ListGTTRequest, opts ...grpc.CallOption) (*ListGTTResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGTTResponse)
+	err := c.cc.Invoke(ctx, BrokerService_ListGTT_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertRuleResponse)
+	err := c.cc.Invoke(ctx, BrokerService_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, BrokerService_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*GetAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAlertRuleResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) CancelAlertRule(ctx context.Context, in *CancelAlertRuleRequest, opts ...grpc.CallOption) (*CancelAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAlertRuleResponse)
+	err := c.cc.Invoke(ctx, BrokerService_CancelAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceAlertResponse)
+	err := c.cc.Invoke(ctx, BrokerService_CreatePriceAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceAlertsResponse)
+	err := c.cc.Invoke(ctx, BrokerService_ListPriceAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetPriceAlert(ctx context.Context, in *AlertIDRequest, opts ...grpc.CallOption) (*PriceAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceAlertResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetPriceAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) UpdatePriceAlert(ctx context.Context, in *UpdatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceAlertResponse)
+	err := c.cc.Invoke(ctx, BrokerService_UpdatePriceAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) DeletePriceAlert(ctx context.Context, in *AlertIDRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertResponse)
+	err := c.cc.Invoke(ctx, BrokerService_DeletePriceAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) CreateAlertWebhook(ctx context.Context, in *CreateAlertWebhookRequest, opts ...grpc.CallOption) (*AlertWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertWebhookResponse)
+	err := c.cc.Invoke(ctx, BrokerService_CreateAlertWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) ListAlertWebhooks(ctx context.Context, in *ListAlertWebhooksRequest, opts ...grpc.CallOption) (*ListAlertWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertWebhooksResponse)
+	err := c.cc.Invoke(ctx, BrokerService_ListAlertWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) DeleteAlertWebhook(ctx context.Context, in *AlertIDRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertResponse)
+	err := c.cc.Invoke(ctx, BrokerService_DeleteAlertWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) ListAlertDeliveries(ctx context.Context, in *ListAlertDeliveriesRequest, opts ...grpc.CallOption) (*ListAlertDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertDeliveriesResponse)
+	err := c.cc.Invoke(ctx, BrokerService_ListAlertDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) PlaceBracketOrder(ctx context.Context, in *PlaceBracketOrderRequest, opts ...grpc.CallOption) (*BracketOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BracketOrderResponse)
+	err := c.cc.Invoke(ctx, BrokerService_PlaceBracketOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) ListBracketOrders(ctx context.Context, in *ListBracketOrdersRequest, opts ...grpc.CallOption) (*ListBracketOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBracketOrdersResponse)
+	err := c.cc.Invoke(ctx, BrokerService_ListBracketOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetBracketOrder(ctx context.Context, in *BracketOrderIDRequest, opts ...grpc.CallOption) (*BracketOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BracketOrderResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetBracketOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) CancelBracketOrder(ctx context.Context, in *BracketOrderIDRequest, opts ...grpc.CallOption) (*BracketOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BracketOrderResponse)
+	err := c.cc.Invoke(ctx, BrokerService_CancelBracketOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetCandleData(ctx context.Context, in *GetCandleDataRequest, opts ...grpc.CallOption) (*GetCandleDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCandleDataResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetCandleData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetCandleCacheStats(ctx context.Context, in *GetCandleCacheStatsRequest, opts ...grpc.CallOption) (*GetCandleCacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCandleCacheStatsResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetCandleCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) PurgeCandleCache(ctx context.Context, in *PurgeCandleCacheRequest, opts ...grpc.CallOption) (*PurgeCandleCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeCandleCacheResponse)
+	err := c.cc.Invoke(ctx, BrokerService_PurgeCandleCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) SearchInstruments(ctx context.Context, in *SearchInstrumentsRequest, opts ...grpc.CallOption) (*SearchInstrumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchInstrumentsResponse)
+	err := c.cc.Invoke(ctx, BrokerService_SearchInstruments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetInstrument(ctx context.Context, in *GetInstrumentRequest, opts ...grpc.CallOption) (*GetInstrumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstrumentResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetInstrument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) SubscribeTicks(ctx context.Context, in *SubscribeTicksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Tick], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BrokerService_ServiceDesc.Streams[1], BrokerService_SubscribeTicks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeTicksRequest, Tick]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrokerService_SubscribeTicksClient = grpc.ServerStreamingClient[Tick]
+
+func (c *brokerServiceClient) GetLTP(ctx context.Context, in *GetLTPRequest, opts ...grpc.CallOption) (*GetLTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLTPResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetLTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GetFullQuote(ctx context.Context, in *GetFullQuoteRequest, opts ...grpc.CallOption) (*GetFullQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFullQuoteResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GetFullQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) GenerateTokens(ctx context.Context, in *GenerateTokensRequest, opts ...grpc.CallOption) (*GenerateTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateTokensResponse)
+	err := c.cc.Invoke(ctx, BrokerService_GenerateTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brokerServiceClient) SetSession(ctx context.Context, in *SetSessionRequest, opts ...grpc.CallOption) (*SetSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSessionResponse)
+	err := c.cc.Invoke(ctx, BrokerService_SetSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BrokerServiceServer is the server API for BrokerService service.
+// All implementations must embed UnimplementedBrokerServiceServer
+// for forward compatibility.
+type BrokerServiceServer interface {
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
+	PlaceBasket(context.Context, *PlaceBasketRequest) (*PlaceBasketResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error)
+	GetOrderBook(context.Context, *GetOrderBookRequest) (*GetOrderBookResponse, error)
+	GetOrderDetails(context.Context, *GetOrderDetailsRequest) (*GetOrderDetailsResponse, error)
+	GetTradeBook(context.Context, *GetTradeBookRequest) (*GetTradeBookResponse, error)
+	StreamOrderUpdates(*StreamOrderUpdatesRequest, grpc.ServerStreamingServer[OrderUpdate]) error
+	GetHoldings(context.Context, *GetHoldingsRequest) (*GetHoldingsResponse, error)
+	GetPositions(context.Context, *GetPositionsRequest) (*GetPositionsResponse, error)
+	ConvertPosition(context.Context, *ConvertPositionRequest) (*ConvertPositionResponse, error)
+	GetRMSLimits(context.Context, *GetRMSLimitsRequest) (*GetRMSLimitsResponse, error)
+	CalculateMargin(context.Context, *CalculateMarginRequest) (*CalculateMarginResponse, error)
+	CreateGTT(context.Context, *CreateGTTRequest) (*CreateGTTResponse, error)
+	ModifyGTT(context.Context, *ModifyGTTRequest) (*ModifyGTTResponse, error)
+	CancelGTT(context.Context, *CancelGTTRequest) (*CancelGTTResponse, error)
+	GetGTTDetails(context.Context, *GetGTTDetailsRequest) (*GetGTTDetailsResponse, error)
+	ListGTT(context.Context, *ListGTTRequest) (*ListGTTResponse, error)
+	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	GetAlertRule(context.Context, *GetAlertRuleRequest) (*GetAlertRuleResponse, error)
+	CancelAlertRule(context.Context, *CancelAlertRuleRequest) (*CancelAlertRuleResponse, error)
+	CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*PriceAlertResponse, error)
+	ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error)
+	GetPriceAlert(context.Context, *AlertIDRequest) (*PriceAlertResponse, error)
+	UpdatePriceAlert(context.Context, *UpdatePriceAlertRequest) (*PriceAlertResponse, error)
+	DeletePriceAlert(context.Context, *AlertIDRequest) (*DeleteAlertResponse, error)
+	CreateAlertWebhook(context.Context, *CreateAlertWebhookRequest) (*AlertWebhookResponse, error)
+	ListAlertWebhooks(context.Context, *ListAlertWebhooksRequest) (*ListAlertWebhooksResponse, error)
+	DeleteAlertWebhook(context.Context, *AlertIDRequest) (*DeleteAlertResponse, error)
+	ListAlertDeliveries(context.Context, *ListAlertDeliveriesRequest) (*ListAlertDeliveriesResponse, error)
+	PlaceBracketOrder(context.Context, *PlaceBracketOrderRequest) (*BracketOrderResponse, error)
+	ListBracketOrders(context.Context, *ListBracketOrdersRequest) (*ListBracketOrdersResponse, error)
+	GetBracketOrder(context.Context, *BracketOrderIDRequest) (*BracketOrderResponse, error)
+	CancelBracketOrder(context.Context, *BracketOrderIDRequest) (*BracketOrderResponse, error)
+	GetCandleData(context.Context, *GetCandleDataRequest) (*GetCandleDataResponse, error)
+	// Candle cache administration; not exposed through the API gateway.
+	GetCandleCacheStats(context.Context, *GetCandleCacheStatsRequest) (*GetCandleCacheStatsResponse, error)
+	PurgeCandleCache(context.Context, *PurgeCandleCacheRequest) (*PurgeCandleCacheResponse, error)
+	SearchInstruments(context.Context, *SearchInstrumentsRequest) (*SearchInstrumentsResponse, error)
+	GetInstrument(context.Context, *GetInstrumentRequest) (*GetInstrumentResponse, error)
+	SubscribeTicks(*SubscribeTicksRequest, grpc.ServerStreamingServer[Tick]) error
+	GetLTP(context.Context, *GetLTPRequest) (*GetLTPResponse, error)
+	GetFullQuote(context.Context, *GetFullQuoteRequest) (*GetFullQuoteResponse, error)
+	GenerateTokens(context.Context, *GenerateTokensRequest) (*GenerateTokensResponse, error)
+	SetSession(context.Context, *SetSessionRequest) (*SetSessionResponse, error)
+	mustEmbedUnimplementedBrokerServiceServer()
+}
+
+// UnimplementedBrokerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBrokerServiceServer struct{}
+
+func (UnimplementedBrokerServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedBrokerServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedBrokerServiceServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedBrokerServiceServer) PlaceBasket(context.Context, *PlaceBasketRequest) (*PlaceBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBasket not implemented")
+}
+func (UnimplementedBrokerServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedBrokerServiceServer) ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOrder not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetOrderBook(context.Context, *GetOrderBookRequest) (*GetOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBook not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetOrderDetails(context.Context, *GetOrderDetailsRequest) (*GetOrderDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderDetails not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetTradeBook(context.Context, *GetTradeBookRequest) (*GetTradeBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradeBook not implemented")
+}
+func (UnimplementedBrokerServiceServer) StreamOrderUpdates(*StreamOrderUpdatesRequest, grpc.ServerStreamingServer[OrderUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrderUpdates not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetHoldings(context.Context, *GetHoldingsRequest) (*GetHoldingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHoldings not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetPositions(context.Context, *GetPositionsRequest) (*GetPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPositions not implemented")
+}
+func (UnimplementedBrokerServiceServer) ConvertPosition(context.Context, *ConvertPositionRequest) (*ConvertPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertPosition not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetRMSLimits(context.Context, *GetRMSLimitsRequest) (*GetRMSLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRMSLimits not implemented")
+}
+func (UnimplementedBrokerServiceServer) CalculateMargin(context.Context, *CalculateMarginRequest) (*CalculateMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateMargin not implemented")
+}
+func (UnimplementedBrokerServiceServer) CreateGTT(context.Context, *CreateGTTRequest) (*CreateGTTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGTT not implemented")
+}
+func (UnimplementedBrokerServiceServer) ModifyGTT(context.Context, *ModifyGTTRequest) (*ModifyGTTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyGTT not implemented")
+}
+func (UnimplementedBrokerServiceServer) CancelGTT(context.Context, *CancelGTTRequest) (*CancelGTTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGTT not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetGTTDetails(context.Context, *GetGTTDetailsRequest) (*GetGTTDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGTTDetails not implemented")
+}
+func (UnimplementedBrokerServiceServer) ListGTT(context.Context, *ListGTTRequest) (*ListGTTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGTT not implemented")
+}
+func (UnimplementedBrokerServiceServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedBrokerServiceServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetAlertRule(context.Context, *GetAlertRuleRequest) (*GetAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlertRule not implemented")
+}
+func (UnimplementedBrokerServiceServer) CancelAlertRule(context.Context, *CancelAlertRuleRequest) (*CancelAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAlertRule not implemented")
+}
+func (UnimplementedBrokerServiceServer) CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*PriceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePriceAlert not implemented")
+}
+func (UnimplementedBrokerServiceServer) ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceAlerts not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetPriceAlert(context.Context, *AlertIDRequest) (*PriceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceAlert not implemented")
+}
+func (UnimplementedBrokerServiceServer) UpdatePriceAlert(context.Context, *UpdatePriceAlertRequest) (*PriceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceAlert not implemented")
+}
+func (UnimplementedBrokerServiceServer) DeletePriceAlert(context.Context, *AlertIDRequest) (*DeleteAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceAlert not implemented")
+}
+func (UnimplementedBrokerServiceServer) CreateAlertWebhook(context.Context, *CreateAlertWebhookRequest) (*AlertWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertWebhook not implemented")
+}
+func (UnimplementedBrokerServiceServer) ListAlertWebhooks(context.Context, *ListAlertWebhooksRequest) (*ListAlertWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertWebhooks not implemented")
+}
+func (UnimplementedBrokerServiceServer) DeleteAlertWebhook(context.Context, *AlertIDRequest) (*DeleteAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertWebhook not implemented")
+}
+func (UnimplementedBrokerServiceServer) ListAlertDeliveries(context.Context, *ListAlertDeliveriesRequest) (*ListAlertDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertDeliveries not implemented")
+}
+func (UnimplementedBrokerServiceServer) PlaceBracketOrder(context.Context, *PlaceBracketOrderRequest) (*BracketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBracketOrder not implemented")
+}
+func (UnimplementedBrokerServiceServer) ListBracketOrders(context.Context, *ListBracketOrdersRequest) (*ListBracketOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBracketOrders not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetBracketOrder(context.Context, *BracketOrderIDRequest) (*BracketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBracketOrder not implemented")
+}
+func (UnimplementedBrokerServiceServer) CancelBracketOrder(context.Context, *BracketOrderIDRequest) (*BracketOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBracketOrder not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetCandleData(context.Context, *GetCandleDataRequest) (*GetCandleDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandleData not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetCandleCacheStats(context.Context, *GetCandleCacheStatsRequest) (*GetCandleCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandleCacheStats not implemented")
+}
+func (UnimplementedBrokerServiceServer) PurgeCandleCache(context.Context, *PurgeCandleCacheRequest) (*PurgeCandleCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCandleCache not implemented")
+}
+func (UnimplementedBrokerServiceServer) SearchInstruments(context.Context, *SearchInstrumentsRequest) (*SearchInstrumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchInstruments not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetInstrument(context.Context, *GetInstrumentRequest) (*GetInstrumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstrument not implemented")
+}
+func (UnimplementedBrokerServiceServer) SubscribeTicks(*SubscribeTicksRequest, grpc.ServerStreamingServer[Tick]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTicks not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetLTP(context.Context, *GetLTPRequest) (*GetLTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLTP not implemented")
+}
+func (UnimplementedBrokerServiceServer) GetFullQuote(context.Context, *GetFullQuoteRequest) (*GetFullQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFullQuote not implemented")
+}
+func (UnimplementedBrokerServiceServer) GenerateTokens(context.Context, *GenerateTokensRequest) (*GenerateTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTokens not implemented")
+}
+func (UnimplementedBrokerServiceServer) SetSession(context.Context, *SetSessionRequest) (*SetSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSession not implemented")
+}
+func (UnimplementedBrokerServiceServer) mustEmbedUnimplementedBrokerServiceServer() {}
+func (UnimplementedBrokerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeBrokerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BrokerServiceServer will
+// result in compilation errors.
+type UnsafeBrokerServiceServer interface {
+	mustEmbedUnimplementedBrokerServiceServer()
+}
+
+func RegisterBrokerServiceServer(s grpc.ServiceRegistrar, srv BrokerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBrokerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BrokerService_ServiceDesc, srv)
+}
+
+func _BrokerService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_PlaceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_PlaceBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).PlaceBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_PlaceBasket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).PlaceBasket(ctx, req.(*PlaceBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_ModifyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).ModifyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_ModifyOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).ModifyOrder(ctx, req.(*ModifyOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_GetOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetOrderBook(ctx, req.(*GetOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_GetOrderDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetOrderDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetOrderDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetOrderDetails(ctx, req.(*GetOrderDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_GetTradeBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetTradeBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetTradeBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetTradeBook(ctx, req.(*GetTradeBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_StreamOrderUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrderUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BrokerServiceServer).StreamOrderUpdates(m, &grpc.GenericServerStream[StreamOrderUpdatesRequest, OrderUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrokerService_StreamOrderUpdatesServer = grpc.ServerStreamingServer[OrderUpdate]
+
+func _BrokerService_GetHoldings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHoldingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetHoldings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetHoldings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetHoldings(ctx, req.(*GetHoldingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_GetPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetPositions(ctx, req.(*GetPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_ConvertPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).ConvertPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_ConvertPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).ConvertPosition(ctx, req.(*ConvertPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_GetRMSLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRMSLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetRMSLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetRMSLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetRMSLimits(ctx, req.(*GetRMSLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_CalculateMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateMarginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).CalculateMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_CalculateMargin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).CalculateMargin(ctx, req.(*CalculateMarginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_CreateGTT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGTTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).CreateGTT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_CreateGTT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).CreateGTT(ctx, req.(*CreateGTTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_ModifyGTT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyGTTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).ModifyGTT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_ModifyGTT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).ModifyGTT(ctx, req.(*ModifyGTTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_CancelGTT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelGTTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).CancelGTT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_CancelGTT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).CancelGTT(ctx, req.(*CancelGTTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_GetGTTDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGTTDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetGTTDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetGTTDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetGTTDetails(ctx, req.(*GetGTTDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_ListGTT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGTTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).ListGTT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_ListGTT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).ListGTT(ctx, req.(*ListGTTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).CreateAlertRule(ctx, req.(*CreateAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_GetAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetAlertRule(ctx, req.(*GetAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_CancelAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).CancelAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_CancelAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).CancelAlertRule(ctx, req.(*CancelAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_CreatePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).CreatePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_CreatePriceAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).CreatePriceAlert(ctx, req.(*CreatePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_ListPriceAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).ListPriceAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_ListPriceAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).ListPriceAlerts(ctx, req.(*ListPriceAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_GetPriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetPriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetPriceAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetPriceAlert(ctx, req.(*AlertIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_UpdatePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).UpdatePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_UpdatePriceAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).UpdatePriceAlert(ctx, req.(*UpdatePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_DeletePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).DeletePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_DeletePriceAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).DeletePriceAlert(ctx, req.(*AlertIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_CreateAlertWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).CreateAlertWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_CreateAlertWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).CreateAlertWebhook(ctx, req.(*CreateAlertWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_ListAlertWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).ListAlertWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_ListAlertWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).ListAlertWebhooks(ctx, req.(*ListAlertWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_DeleteAlertWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).DeleteAlertWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_DeleteAlertWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).DeleteAlertWebhook(ctx, req.(*AlertIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *brokerServiceClient) GetLTP(ctx context.Context, in *GetLTPRequest, opts ...grpc.CallOption) (*GetLTPResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetLTPResponse)
-	err := c.cc.Invoke(ctx, BrokerService_GetLTP_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _BrokerService_ListAlertDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertDeliveriesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).ListAlertDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_ListAlertDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).ListAlertDeliveries(ctx, req.(*ListAlertDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *brokerServiceClient) GetFullQuote(ctx context.Context, in *GetFullQuoteRequest, opts ...grpc.CallOption) (*GetFullQuoteResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetFullQuoteResponse)
-	err := c.cc.Invoke(ctx, BrokerService_GetFullQuote_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _BrokerService_PlaceBracketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceBracketOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// BrokerServiceServer is the server API for BrokerService service.
-// All implementations must embed UnimplementedBrokerServiceServer
-// for forward compatibility.
-type BrokerServiceServer interface {
-	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
-	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
-	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
-	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
-	GetOrderBook(context.Context, *GetOrderBookRequest) (*GetOrderBookResponse, error)
-	GetHoldings(context.Context, *GetHoldingsRequest) (*GetHoldingsResponse, error)
-	GetLTP(context.Context, *GetLTPRequest) (*GetLTPResponse, error)
-	GetFullQuote(context.Context, *GetFullQuoteRequest) (*GetFullQuoteResponse, error)
-	mustEmbedUnimplementedBrokerServiceServer()
-}
-
-// UnimplementedBrokerServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedBrokerServiceServer struct{}
-
-func (UnimplementedBrokerServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
-}
-func (UnimplementedBrokerServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
-}
-func (UnimplementedBrokerServiceServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
-}
-func (UnimplementedBrokerServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
-}
-func (UnimplementedBrokerServiceServer) GetOrderBook(context.Context, *GetOrderBookRequest) (*GetOrderBookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBook not implemented")
-}
-func (UnimplementedBrokerServiceServer) GetHoldings(context.Context, *GetHoldingsRequest) (*GetHoldingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHoldings not implemented")
-}
-func (UnimplementedBrokerServiceServer) GetLTP(context.Context, *GetLTPRequest) (*GetLTPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLTP not implemented")
-}
-func (UnimplementedBrokerServiceServer) GetFullQuote(context.Context, *GetFullQuoteRequest) (*GetFullQuoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFullQuote not implemented")
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).PlaceBracketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_PlaceBracketOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).PlaceBracketOrder(ctx, req.(*PlaceBracketOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBrokerServiceServer) mustEmbedUnimplementedBrokerServiceServer() {}
-func (UnimplementedBrokerServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeBrokerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BrokerServiceServer will
-// result in compilation errors.
-type UnsafeBrokerServiceServer interface {
-	mustEmbedUnimplementedBrokerServiceServer()
+func _BrokerService_ListBracketOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBracketOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).ListBracketOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_ListBracketOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).ListBracketOrders(ctx, req.(*ListBracketOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBrokerServiceServer(s grpc.ServiceRegistrar, srv BrokerServiceServer) {
-	// If the following call pancis, it indicates UnimplementedBrokerServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _BrokerService_GetBracketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BracketOrderIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&BrokerService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GetBracketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GetBracketOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GetBracketOrder(ctx, req.(*BracketOrderIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BrokerService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileRequest)
+func _BrokerService_CancelBracketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BracketOrderIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServiceServer).GetProfile(ctx, in)
+		return srv.(BrokerServiceServer).CancelBracketOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BrokerService_GetProfile_FullMethodName,
+		FullMethod: BrokerService_CancelBracketOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+		return srv.(BrokerServiceServer).CancelBracketOrder(ctx, req.(*BracketOrderIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BrokerService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogoutRequest)
+func _BrokerService_GetCandleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandleDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServiceServer).Logout(ctx, in)
+		return srv.(BrokerServiceServer).GetCandleData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BrokerService_Logout_FullMethodName,
+		FullMethod: BrokerService_GetCandleData_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServiceServer).Logout(ctx, req.(*LogoutRequest))
+		return srv.(BrokerServiceServer).GetCandleData(ctx, req.(*GetCandleDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BrokerService_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PlaceOrderRequest)
+func _BrokerService_GetCandleCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandleCacheStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServiceServer).PlaceOrder(ctx, in)
+		return srv.(BrokerServiceServer).GetCandleCacheStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BrokerService_PlaceOrder_FullMethodName,
+		FullMethod: BrokerService_GetCandleCacheStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServiceServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+		return srv.(BrokerServiceServer).GetCandleCacheStats(ctx, req.(*GetCandleCacheStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BrokerService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _BrokerService_PurgeCandleCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCandleCacheRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServiceServer).CancelOrder(ctx, in)
+		return srv.(BrokerServiceServer).PurgeCandleCache(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BrokerService_CancelOrder_FullMethodName,
+		FullMethod: BrokerService_PurgeCandleCache_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(BrokerServiceServer).PurgeCandleCache(ctx, req.(*PurgeCandleCacheRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BrokerService_GetOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderBookRequest)
+func _BrokerService_SearchInstruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchInstrumentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServiceServer).GetOrderBook(ctx, in)
+		return srv.(BrokerServiceServer).SearchInstruments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BrokerService_GetOrderBook_FullMethodName,
+		FullMethod: BrokerService_SearchInstruments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServiceServer).GetOrderBook(ctx, req.(*GetOrderBookRequest))
+		return srv.(BrokerServiceServer).SearchInstruments(ctx, req.(*SearchInstrumentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BrokerService_GetHoldings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHoldingsRequest)
+func _BrokerService_GetInstrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstrumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BrokerServiceServer).GetHoldings(ctx, in)
+		return srv.(BrokerServiceServer).GetInstrument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BrokerService_GetHoldings_FullMethodName,
+		FullMethod: BrokerService_GetInstrument_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BrokerServiceServer).GetHoldings(ctx, req.(*GetHoldingsRequest))
+		return srv.(BrokerServiceServer).GetInstrument(ctx, req.(*GetInstrumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BrokerService_SubscribeTicks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTicksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BrokerServiceServer).SubscribeTicks(m, &grpc.GenericServerStream[SubscribeTicksRequest, Tick]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrokerService_SubscribeTicksServer = grpc.ServerStreamingServer[Tick]
+
 func _BrokerService_GetLTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLTPRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +1638,42 @@ func _BrokerService_GetFullQuote_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BrokerService_GenerateTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).GenerateTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_GenerateTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).GenerateTokens(ctx, req.(*GenerateTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrokerService_SetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrokerServiceServer).SetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrokerService_SetSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrokerServiceServer).SetSession(ctx, req.(*SetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BrokerService_ServiceDesc is the grpc.ServiceDesc for BrokerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -361,18 +1693,158 @@ var BrokerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlaceOrder",
 			Handler:    _BrokerService_PlaceOrder_Handler,
 		},
+		{
+			MethodName: "PlaceBasket",
+			Handler:    _BrokerService_PlaceBasket_Handler,
+		},
 		{
 			MethodName: "CancelOrder",
 			Handler:    _BrokerService_CancelOrder_Handler,
 		},
+		{
+			MethodName: "ModifyOrder",
+			Handler:    _BrokerService_ModifyOrder_Handler,
+		},
 		{
 			MethodName: "GetOrderBook",
 			Handler:    _BrokerService_GetOrderBook_Handler,
 		},
+		{
+			MethodName: "GetOrderDetails",
+			Handler:    _BrokerService_GetOrderDetails_Handler,
+		},
+		{
+			MethodName: "GetTradeBook",
+			Handler:    _BrokerService_GetTradeBook_Handler,
+		},
 		{
 			MethodName: "GetHoldings",
 			Handler:    _BrokerService_GetHoldings_Handler,
 		},
+		{
+			MethodName: "GetPositions",
+			Handler:    _BrokerService_GetPositions_Handler,
+		},
+		{
+			MethodName: "ConvertPosition",
+			Handler:    _BrokerService_ConvertPosition_Handler,
+		},
+		{
+			MethodName: "GetRMSLimits",
+			Handler:    _BrokerService_GetRMSLimits_Handler,
+		},
+		{
+			MethodName: "CalculateMargin",
+			Handler:    _BrokerService_CalculateMargin_Handler,
+		},
+		{
+			MethodName: "CreateGTT",
+			Handler:    _BrokerService_CreateGTT_Handler,
+		},
+		{
+			MethodName: "ModifyGTT",
+			Handler:    _BrokerService_ModifyGTT_Handler,
+		},
+		{
+			MethodName: "CancelGTT",
+			Handler:    _BrokerService_CancelGTT_Handler,
+		},
+		{
+			MethodName: "GetGTTDetails",
+			Handler:    _BrokerService_GetGTTDetails_Handler,
+		},
+		{
+			MethodName: "ListGTT",
+			Handler:    _BrokerService_ListGTT_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _BrokerService_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _BrokerService_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "GetAlertRule",
+			Handler:    _BrokerService_GetAlertRule_Handler,
+		},
+		{
+			MethodName: "CancelAlertRule",
+			Handler:    _BrokerService_CancelAlertRule_Handler,
+		},
+		{
+			MethodName: "CreatePriceAlert",
+			Handler:    _BrokerService_CreatePriceAlert_Handler,
+		},
+		{
+			MethodName: "ListPriceAlerts",
+			Handler:    _BrokerService_ListPriceAlerts_Handler,
+		},
+		{
+			MethodName: "GetPriceAlert",
+			Handler:    _BrokerService_GetPriceAlert_Handler,
+		},
+		{
+			MethodName: "UpdatePriceAlert",
+			Handler:    _BrokerService_UpdatePriceAlert_Handler,
+		},
+		{
+			MethodName: "DeletePriceAlert",
+			Handler:    _BrokerService_DeletePriceAlert_Handler,
+		},
+		{
+			MethodName: "CreateAlertWebhook",
+			Handler:    _BrokerService_CreateAlertWebhook_Handler,
+		},
+		{
+			MethodName: "ListAlertWebhooks",
+			Handler:    _BrokerService_ListAlertWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteAlertWebhook",
+			Handler:    _BrokerService_DeleteAlertWebhook_Handler,
+		},
+		{
+			MethodName: "ListAlertDeliveries",
+			Handler:    _BrokerService_ListAlertDeliveries_Handler,
+		},
+		{
+			MethodName: "PlaceBracketOrder",
+			Handler:    _BrokerService_PlaceBracketOrder_Handler,
+		},
+		{
+			MethodName: "ListBracketOrders",
+			Handler:    _BrokerService_ListBracketOrders_Handler,
+		},
+		{
+			MethodName: "GetBracketOrder",
+			Handler:    _BrokerService_GetBracketOrder_Handler,
+		},
+		{
+			MethodName: "CancelBracketOrder",
+			Handler:    _BrokerService_CancelBracketOrder_Handler,
+		},
+		{
+			MethodName: "GetCandleData",
+			Handler:    _BrokerService_GetCandleData_Handler,
+		},
+		{
+			MethodName: "GetCandleCacheStats",
+			Handler:    _BrokerService_GetCandleCacheStats_Handler,
+		},
+		{
+			MethodName: "PurgeCandleCache",
+			Handler:    _BrokerService_PurgeCandleCache_Handler,
+		},
+		{
+			MethodName: "SearchInstruments",
+			Handler:    _BrokerService_SearchInstruments_Handler,
+		},
+		{
+			MethodName: "GetInstrument",
+			Handler:    _BrokerService_GetInstrument_Handler,
+		},
 		{
 			MethodName: "GetLTP",
 			Handler:    _BrokerService_GetLTP_Handler,
@@ -381,7 +1853,26 @@ var BrokerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFullQuote",
 			Handler:    _BrokerService_GetFullQuote_Handler,
 		},
+		{
+			MethodName: "GenerateTokens",
+			Handler:    _BrokerService_GenerateTokens_Handler,
+		},
+		{
+			MethodName: "SetSession",
+			Handler:    _BrokerService_SetSession_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrderUpdates",
+			Handler:       _BrokerService_StreamOrderUpdates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTicks",
+			Handler:       _BrokerService_SubscribeTicks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "broker.proto",
 }
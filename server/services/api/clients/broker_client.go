@@ -17,7 +17,11 @@ type BrokerServiceClientWrapper struct {
 }
 
 func NewBrokerServiceClient(addr string) (*BrokerServiceClientWrapper, error) {
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(refreshOnInvalidToken), // Transparently renews expired Angel One sessions
+	)
 	if err != nil {
 		log.Printf("Failed to connect to Broker Service at %s: %v", addr, err)
 		return nil, status.Errorf(codes.Unavailable, "failed to connect to broker service: %v", err)
@@ -0,0 +1,68 @@
+package clients
+
+import (
+	"context"
+	"log"
+
+	"github.com/Sagar-v4/Angel-Two/errorcodes"
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TokenRefresher renews the Angel One session of the current request and returns the new JWT.
+type TokenRefresher func(ctx context.Context) (string, error)
+
+type tokenRefresherKey struct{}
+
+// WithTokenRefresher returns a context that lets broker calls refresh the Angel One session.
+func WithTokenRefresher(ctx context.Context, refresher TokenRefresher) context.Context {
+	return context.WithValue(ctx, tokenRefresherKey{}, refresher)
+}
+
+func tokenRefresherFromContext(ctx context.Context) TokenRefresher {
+	refresher, _ := ctx.Value(tokenRefresherKey{}).(TokenRefresher)
+	return refresher
+}
+
+// errorCodeResponse is implemented by every broker response carrying Angel One's errorcode.
+type errorCodeResponse interface {
+	GetErrorcode() string
+}
+
+// refreshOnInvalidToken retries a broker call once with a refreshed JWT
+// when Angel One rejects the current one as invalid or expired.
+func refreshOnInvalidToken(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+
+	refresher := tokenRefresherFromContext(ctx)
+	if refresher == nil || method == brokerpb.BrokerService_GenerateTokens_FullMethodName {
+		return nil
+	}
+	resp, ok := reply.(errorCodeResponse)
+	if !ok || !errorcodes.IsSessionError(resp.GetErrorcode()) {
+		return nil
+	}
+	reqMsg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	jwtField := reqMsg.ProtoReflect().Descriptor().Fields().ByName("angel_one_jwt")
+	if jwtField == nil {
+		return nil
+	}
+
+	log.Printf("Broker call %s rejected with %s, refreshing Angel One session", method, resp.GetErrorcode())
+	newJWT, err := refresher(ctx)
+	if err != nil {
+		log.Printf("Angel One session refresh failed: %v", err)
+		return nil // Keep the original broker response so the caller sees the token error
+	}
+
+	reqMsg.ProtoReflect().Set(jwtField, protoreflect.ValueOfString(newJWT))
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -214,10 +214,12 @@ func (h *APIAuthHandler) Logout(c *gin.Context) {
 
 	// 2. Call Auth Service to invalidate the user_session_token (JTI)
 	if userTokenStr != "" { // Only if we had a token from middleware
-		_, authServiceLogoutErr := h.authService.Client.Logout(ctx, &authpb.TokenActionRequest{UserToken: userTokenStr})
+		authLogoutResp, authServiceLogoutErr := h.authService.Client.Logout(ctx, &authpb.TokenActionRequest{UserToken: userTokenStr})
 		// Assuming h.authClient has distinct fields for AuthGRPCClient and BrokerGRPCClient
 		if authServiceLogoutErr != nil {
 			log.Printf("/api/logout: Error calling Auth Service Logout for user_session_token: %v", authServiceLogoutErr)
+		} else if authLogoutResp != nil && !authLogoutResp.Success {
+			log.Printf("/api/logout: Auth Service could not revoke user_session_token: %s", authLogoutResp.Message)
 		} else {
 			log.Println("/api/logout: Successfully invalidated user_session_token with Auth Service.")
 		}
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/api/clients"
+	"github.com/Sagar-v4/Angel-Two/services/api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BracketHandler serves bracket (OCO) orders for delivery, which the broker
+// service emulates: once the entry fills it places a stop-loss, and swaps it
+// for a target order when the LTP reaches the target.
+type BracketHandler struct {
+	brokerClient *clients.BrokerServiceClientWrapper
+}
+
+func NewBracketHandler(brokerClient *clients.BrokerServiceClientWrapper) *BracketHandler {
+	return &BracketHandler{brokerClient: brokerClient}
+}
+
+// POST /api/orders/bracket
+func (h *BracketHandler) PlaceBracketOrder(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload brokerpb.PlaceBracketOrderRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid bracket order payload", "details": err.Error()})
+		return
+	}
+	if payload.Entry == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid bracket order payload", "details": "entry is required"})
+		return
+	}
+	payload.AngelOneJwt = angelTokens[0]
+
+	// Brackets are only emulated for delivery buys, so those are the defaults
+	entry := payload.Entry
+	if entry.Variety == "" {
+		entry.Variety = "NORMAL"
+	}
+	if entry.Producttype == "" {
+		entry.Producttype = "DELIVERY"
+	}
+	if entry.Transactiontype == "" {
+		entry.Transactiontype = "BUY"
+	}
+	if entry.Duration == "" {
+		entry.Duration = "DAY"
+	}
+
+	payload.ClientLocalIp = c.ClientIP()
+	payload.ClientPublicIp = c.GetHeader("X-Forwarded-For")
+	if payload.ClientPublicIp == "" {
+		payload.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 20*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.PlaceBracketOrder(ctx, &payload)
+	if err != nil {
+		writeBrokerError(c, "place bracket order", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/orders/bracket?active=true
+func (h *BracketHandler) ListBracketOrders(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.ListBracketOrdersRequest{
+		AngelOneJwt:    angelTokens[0],
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+	if value := c.Query("active"); value != "" {
+		active, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid active", "details": err.Error()})
+			return
+		}
+		req.ActiveOnly = active
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.ListBracketOrders(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "list bracket orders", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/orders/bracket/:id
+func (h *BracketHandler) GetBracketOrder(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.BracketOrderIDRequest{
+		AngelOneJwt:    angelTokens[0],
+		Id:             c.Param("id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.GetBracketOrder(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "get bracket order", err) // 404 for unknown ids
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DELETE /api/orders/bracket/:id
+func (h *BracketHandler) CancelBracketOrder(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.BracketOrderIDRequest{
+		AngelOneJwt:    angelTokens[0],
+		Id:             c.Param("id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.CancelBracketOrder(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "cancel bracket order", err) // 422 once the bracket has finished
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// writeBrokerError maps a gRPC error from the Broker service to an HTTP response.
+// Validation failures become 400 with their field-level details, unknown
+// resources 404 and unmet preconditions (e.g. insufficient margin) 422.
+// Anything else means the broker service could not be reached or failed, so
+// it is reported as 503.
+func writeBrokerError(c *gin.Context, op string, err error) {
+	log.Printf("%s: gRPC error from Broker: %v", op, err)
+
+	st, ok := status.FromError(err)
+	if !ok {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Failed to " + op + " via broker service"})
+		return
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		fieldErrors := []gin.H{}
+		for _, detail := range st.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.FieldViolations {
+					fieldErrors = append(fieldErrors, gin.H{"field": violation.Field, "description": violation.Description})
+				}
+			}
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message(), "field_errors": fieldErrors})
+	case codes.FailedPrecondition:
+		failures := []gin.H{}
+		for _, detail := range st.Details() {
+			if precondition, ok := detail.(*errdetails.PreconditionFailure); ok {
+				for _, violation := range precondition.Violations {
+					failures = append(failures, gin.H{"type": violation.Type, "subject": violation.Subject, "description": violation.Description})
+				}
+			}
+		}
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": st.Message(), "precondition_failures": failures})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+	default:
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Failed to " + op + " via broker service", "detail": st.Message()})
+	}
+}
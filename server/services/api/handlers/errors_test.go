@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/validation"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fieldError struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+type brokerErrorBody struct {
+	Error       string       `json:"error"`
+	FieldErrors []fieldError `json:"field_errors"`
+}
+
+func TestWriteBrokerError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	invalidOrder := validation.New(nil).PlaceOrder(&pb.PlaceOrderRequest{
+		Variety:         validation.VarietyNormal,
+		Tradingsymbol:   "SBIN-EQ",
+		Symboltoken:     "3045",
+		Transactiontype: validation.TransactionTypeBuy,
+		Exchange:        "NSE",
+		Ordertype:       validation.OrderTypeLimit,
+		Producttype:     validation.ProductTypeDelivery,
+		Duration:        "GTC",
+		Quantity:        10,
+	})
+
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantMessage string
+		wantFields  []fieldError
+	}{
+		{
+			name:        "validation failure",
+			err:         invalidOrder,
+			wantStatus:  http.StatusBadRequest,
+			wantMessage: `invalid order: duration must be one of [DAY IOC], got "GTC"`,
+			wantFields: []fieldError{
+				{Field: "duration", Description: `duration must be one of [DAY IOC], got "GTC"`},
+				{Field: "price", Description: "price is required for LIMIT orders"},
+			},
+		},
+		{
+			name:        "invalid argument without details",
+			err:         status.Error(codes.InvalidArgument, "id is required"),
+			wantStatus:  http.StatusBadRequest,
+			wantMessage: "id is required",
+			wantFields:  []fieldError{},
+		},
+		{
+			name:        "not found",
+			err:         status.Error(codes.NotFound, "order not found"),
+			wantStatus:  http.StatusNotFound,
+			wantMessage: "order not found",
+		},
+		{
+			name:        "unmet precondition",
+			err:         status.Error(codes.FailedPrecondition, "insufficient margin"),
+			wantStatus:  http.StatusUnprocessableEntity,
+			wantMessage: "insufficient margin",
+		},
+		{
+			name:        "broker unavailable",
+			err:         status.Error(codes.Unavailable, "connection refused"),
+			wantStatus:  http.StatusServiceUnavailable,
+			wantMessage: "Failed to place order via broker service",
+		},
+		{
+			name:        "not a gRPC error",
+			err:         errors.New("boom"),
+			wantStatus:  http.StatusServiceUnavailable,
+			wantMessage: "Failed to place order via broker service",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			writeBrokerError(c, "place order", tt.err)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			var body brokerErrorBody
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("body %q is not JSON: %v", rec.Body.String(), err)
+			}
+			if body.Error != tt.wantMessage {
+				t.Errorf("error = %q, want %q", body.Error, tt.wantMessage)
+			}
+			if !reflect.DeepEqual(body.FieldErrors, tt.wantFields) {
+				t.Errorf("field_errors = %+v, want %+v", body.FieldErrors, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/api/clients"
+	"github.com/Sagar-v4/Angel-Two/services/api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FundsHandler struct {
+	brokerClient *clients.BrokerServiceClientWrapper
+}
+
+func NewFundsHandler(brokerClient *clients.BrokerServiceClientWrapper) *FundsHandler {
+	return &FundsHandler{brokerClient: brokerClient}
+}
+
+// GET /api/funds
+func (h *FundsHandler) GetFunds(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.GetRMSLimitsRequest{
+		AngelOneJwt:    angelTokens[0],
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.GetRMSLimits(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "get funds", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/api/clients"
+	"github.com/Sagar-v4/Angel-Two/services/api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GTTHandler struct {
+	brokerClient *clients.BrokerServiceClientWrapper
+}
+
+func NewGTTHandler(brokerClient *clients.BrokerServiceClientWrapper) *GTTHandler {
+	return &GTTHandler{brokerClient: brokerClient}
+}
+
+// POST /api/gtt
+func (h *GTTHandler) CreateGTT(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload brokerpb.CreateGTTRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid GTT rule payload", "details": err.Error()})
+		return
+	}
+	payload.AngelOneJwt = angelTokens[0]
+	payload.ClientLocalIp = c.ClientIP()
+	payload.ClientPublicIp = c.GetHeader("X-Forwarded-For")
+	if payload.ClientPublicIp == "" {
+		payload.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.CreateGTT(ctx, &payload)
+	if err != nil {
+		writeBrokerError(c, "create GTT rule", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/gtt?status=ACTIVE,NEW&page=1&count=10
+func (h *GTTHandler) ListGTT(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.ListGTTRequest{
+		AngelOneJwt:    angelTokens[0],
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+	for _, st := range c.QueryArray("status") { // Both ?status=A&status=B and ?status=A,B
+		for _, part := range strings.Split(st, ",") {
+			if part = strings.ToUpper(strings.TrimSpace(part)); part != "" {
+				req.Status = append(req.Status, part)
+			}
+		}
+	}
+	for name, field := range map[string]*int32{"page": &req.Page, "count": &req.Count} {
+		if value := c.Query(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + name, "details": err.Error()})
+				return
+			}
+			*field = int32(n)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.ListGTT(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "list GTT rules", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/gtt/:id
+func (h *GTTHandler) GetGTTDetails(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.GetGTTDetailsRequest{
+		AngelOneJwt:    angelTokens[0],
+		Id:             c.Param("id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.GetGTTDetails(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "get GTT rule", err) // 404 for unknown ids
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// PUT /api/gtt/:id
+func (h *GTTHandler) ModifyGTT(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload brokerpb.ModifyGTTRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid GTT modification payload", "details": err.Error()})
+		return
+	}
+	payload.Id = c.Param("id")
+	payload.AngelOneJwt = angelTokens[0]
+	payload.ClientLocalIp = c.ClientIP()
+	payload.ClientPublicIp = c.GetHeader("X-Forwarded-For")
+	if payload.ClientPublicIp == "" {
+		payload.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.ModifyGTT(ctx, &payload)
+	if err != nil {
+		writeBrokerError(c, "modify GTT rule", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DELETE /api/gtt/:id (exchange and symboltoken query parameters are optional)
+func (h *GTTHandler) CancelGTT(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.CancelGTTRequest{
+		AngelOneJwt:    angelTokens[0],
+		Id:             c.Param("id"),
+		Exchange:       c.Query("exchange"),
+		Symboltoken:    c.Query("symboltoken"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.CancelGTT(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "cancel GTT rule", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
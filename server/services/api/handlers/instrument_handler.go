@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/api/clients"
+	"github.com/Sagar-v4/Angel-Two/services/api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InstrumentHandler struct {
+	brokerClient *clients.BrokerServiceClientWrapper
+}
+
+func NewInstrumentHandler(brokerClient *clients.BrokerServiceClientWrapper) *InstrumentHandler {
+	return &InstrumentHandler{brokerClient: brokerClient}
+}
+
+// GET /api/instruments/search?q=SBIN[&exchange=NSE][&instrumenttype=OPTIDX][&limit=20]
+func (h *InstrumentHandler) Search(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	req := brokerpb.SearchInstrumentsRequest{
+		Query:          c.Query("q"),
+		Exchange:       c.Query("exchange"),
+		Instrumenttype: c.Query("instrumenttype"),
+	}
+	if req.Query == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Query parameter q is required"})
+		return
+	}
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit", "details": err.Error()})
+			return
+		}
+		req.Limit = int32(n)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.SearchInstruments(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "search instruments", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
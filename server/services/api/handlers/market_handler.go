@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"context"
-	"log"
 	"net/http"
 	"time"
 
@@ -52,8 +51,7 @@ func (h *MarketHandler) GetLTP(c *gin.Context) {
 
 	resp, err := h.brokerClient.Client.GetLTP(ctx, &payload)
 	if err != nil {
-		log.Printf("GetLTP: gRPC error from Broker: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Failed to get LTP data via broker service"})
+		writeBrokerError(c, "get LTP data", err) // 400 for unknown tradingsymbols
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -90,8 +88,68 @@ func (h *MarketHandler) GetFullQuote(c *gin.Context) {
 
 	resp, err := h.brokerClient.Client.GetFullQuote(ctx, &payload)
 	if err != nil {
-		log.Printf("GetFullQuote: gRPC error from Broker: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Failed to get full quote data via broker service"})
+		writeBrokerError(c, "get full quote", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// candleRequest is the HTTP body for candle data; the interval is sent by name
+// (e.g. "FIVE_MINUTE") rather than as the proto enum number.
+type candleRequest struct {
+	Exchange    string `json:"exchange" binding:"required"`
+	Symboltoken string `json:"symboltoken" binding:"required"`
+	Interval    string `json:"interval" binding:"required"`
+	Fromdate    string `json:"fromdate" binding:"required"`
+	Todate      string `json:"todate" binding:"required"`
+}
+
+// POST /api/market/candles
+func (h *MarketHandler) GetCandleData(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload candleRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid candle payload", "details": err.Error()})
+		return
+	}
+	interval, ok := brokerpb.CandleInterval_value[payload.Interval]
+	if !ok || interval == int32(brokerpb.CandleInterval_CANDLE_INTERVAL_UNSPECIFIED) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid candle payload", "details": "unknown interval " + payload.Interval})
+		return
+	}
+
+	req := brokerpb.GetCandleDataRequest{
+		AngelOneJwt:    angelTokens[0],
+		Exchange:       payload.Exchange,
+		Symboltoken:    payload.Symboltoken,
+		Interval:       brokerpb.CandleInterval(interval),
+		Fromdate:       payload.Fromdate,
+		Todate:         payload.Todate,
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	// Long ranges are fetched from Angel One in several rate-limited calls.
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 60*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.GetCandleData(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "get candle data", err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
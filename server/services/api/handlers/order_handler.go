@@ -2,8 +2,12 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
@@ -11,8 +15,12 @@ import (
 	"github.com/Sagar-v4/Angel-Two/services/api/middleware"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
 )
 
+// orderStreamKeepAlive keeps proxies from closing an idle order update stream.
+const orderStreamKeepAlive = 20 * time.Second
+
 type OrderHandler struct {
 	brokerClient *clients.BrokerServiceClientWrapper
 }
@@ -42,6 +50,14 @@ func (h *OrderHandler) PlaceOrder(c *gin.Context) {
 	}
 	payload.AngelOneJwt = angelTokens[0] // Set JWT from middleware
 
+	// Defaults matching Angel One's most common order; other varieties must be explicit
+	if payload.Variety == "" {
+		payload.Variety = "NORMAL"
+	}
+	if payload.Duration == "" {
+		payload.Duration = "DAY"
+	}
+
 	// Set IP/MAC from request if needed for broker service
 	payload.ClientLocalIp = c.ClientIP()
 	payload.ClientPublicIp = c.GetHeader("X-Forwarded-For") // Or other relevant header
@@ -54,9 +70,7 @@ func (h *OrderHandler) PlaceOrder(c *gin.Context) {
 
 	resp, err := h.brokerClient.Client.PlaceOrder(ctx, &payload)
 	if err != nil {
-		// Handle gRPC error
-		log.Printf("PlaceOrder: gRPC error from Broker: %v", err)
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Failed to place order via broker service"})
+		writeBrokerError(c, "place order", err)
 		return
 	}
 	c.JSON(http.StatusOK, resp)
@@ -100,7 +114,44 @@ func (h *OrderHandler) CancelOrder(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
-// GET /api/orders/book
+// POST /api/orders/modify
+func (h *OrderHandler) ModifyOrder(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload brokerpb.ModifyOrderRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid modify order payload", "details": err.Error()})
+		return
+	}
+	payload.AngelOneJwt = angelTokens[0]
+	payload.ClientLocalIp = c.ClientIP()
+	payload.ClientPublicIp = c.GetHeader("X-Forwarded-For")
+	if payload.ClientPublicIp == "" {
+		payload.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 20*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.ModifyOrder(ctx, &payload)
+	if err != nil {
+		writeBrokerError(c, "modify order", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/orders/book[?include_trades=true]
 func (h *OrderHandler) GetOrderBook(c *gin.Context) {
 	authStatus, _ := c.Get(middleware.AuthStatusKey)
 	if authStatus != "verified" {
@@ -116,6 +167,7 @@ func (h *OrderHandler) GetOrderBook(c *gin.Context) {
 
 	req := brokerpb.GetOrderBookRequest{
 		AngelOneJwt:    angelTokens[0], // Use the primary JWT
+		IncludeTrades:  c.Query("include_trades") == "true",
 		ClientLocalIp:  c.ClientIP(),
 		ClientPublicIp: c.GetHeader("X-Forwarded-For"), // Or other relevant header
 	}
@@ -134,3 +186,244 @@ func (h *OrderHandler) GetOrderBook(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, resp)
 }
+
+// GET /api/orders/trades
+func (h *OrderHandler) GetTradeBook(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.GetTradeBookRequest{
+		AngelOneJwt:    angelTokens[0],
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.GetTradeBook(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "get trade book", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/orders/stream (Server-Sent Events)
+// Each event is named after the order's new state (placed, open,
+// partially_filled, complete, rejected, cancelled) and carries the OrderUpdate.
+func (h *OrderHandler) StreamOrderUpdates(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.StreamOrderUpdatesRequest{
+		AngelOneJwt:    angelTokens[0],
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context()) // No timeout; ends when the browser disconnects
+	defer cancel()
+
+	stream, err := h.brokerClient.Client.StreamOrderUpdates(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "stream order updates", err)
+		return
+	}
+
+	updates := make(chan *brokerpb.OrderUpdate)
+	streamErr := make(chan error, 1)
+	go func() {
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				streamErr <- err
+				return
+			}
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Disable nginx buffering
+
+	keepAlive := time.NewTicker(orderStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case update := <-updates:
+			c.SSEvent(strings.ToLower(update.Type.String()), update)
+			return true
+		case err := <-streamErr:
+			msg := "order update stream ended"
+			if !errors.Is(err, io.EOF) {
+				log.Printf("stream order updates: gRPC error from Broker: %v", err)
+				msg = err.Error()
+				if st, ok := status.FromError(err); ok {
+					msg = st.Message()
+				}
+			}
+			c.SSEvent("error", gin.H{"error": msg})
+			return false
+		case <-keepAlive.C:
+			io.WriteString(w, ": keepalive\n\n")
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+}
+
+// GET /api/orders/:id (id is the uniqueorderid)
+func (h *OrderHandler) GetOrderDetails(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.GetOrderDetailsRequest{
+		AngelOneJwt:    angelTokens[0],
+		Uniqueorderid:  c.Param("id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.GetOrderDetails(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "get order details", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// POST /api/orders/basket
+func (h *OrderHandler) PlaceBasket(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload brokerpb.PlaceBasketRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid basket payload", "details": err.Error()})
+		return
+	}
+	payload.AngelOneJwt = angelTokens[0]
+	for i, leg := range payload.Legs { // Same defaults as /api/orders/place
+		if leg == nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid basket payload", "details": fmt.Sprintf("legs[%d] is required", i)})
+			return
+		}
+		if leg.Variety == "" {
+			leg.Variety = "NORMAL"
+		}
+		if leg.Duration == "" {
+			leg.Duration = "DAY"
+		}
+	}
+	payload.ClientLocalIp = c.ClientIP()
+	payload.ClientPublicIp = c.GetHeader("X-Forwarded-For")
+	if payload.ClientPublicIp == "" {
+		payload.ClientPublicIp = c.ClientIP()
+	}
+
+	// Legs are placed a few at a time and may be cancelled again afterwards.
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 60*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.PlaceBasket(ctx, &payload)
+	if err != nil {
+		writeBrokerError(c, "place basket", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// POST /api/orders/margin
+func (h *OrderHandler) CalculateMargin(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload brokerpb.CalculateMarginRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid margin payload", "details": err.Error()})
+		return
+	}
+	payload.AngelOneJwt = angelTokens[0]
+	payload.ClientLocalIp = c.ClientIP()
+	payload.ClientPublicIp = c.GetHeader("X-Forwarded-For")
+	if payload.ClientPublicIp == "" {
+		payload.ClientPublicIp = c.ClientIP()
+	}
+
+	// One call for the basket plus one per leg.
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.CalculateMargin(ctx, &payload)
+	if err != nil {
+		writeBrokerError(c, "calculate margin", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
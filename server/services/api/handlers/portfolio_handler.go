@@ -55,3 +55,75 @@ func (h *PortfolioHandler) GetHoldings(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, resp)
 }
+
+// GET /api/portfolio/positions
+func (h *PortfolioHandler) GetPositions(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.GetPositionsRequest{
+		AngelOneJwt:    angelTokens[0],
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.GetPositions(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "get positions", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// POST /api/portfolio/positions/convert
+func (h *PortfolioHandler) ConvertPosition(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload brokerpb.ConvertPositionRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid convert position payload", "details": err.Error()})
+		return
+	}
+	payload.AngelOneJwt = angelTokens[0]
+	payload.ClientLocalIp = c.ClientIP()
+	payload.ClientPublicIp = c.GetHeader("X-Forwarded-For")
+	if payload.ClientPublicIp == "" {
+		payload.ClientPublicIp = c.ClientIP()
+	}
+
+	// The broker fetches positions before converting, so allow for two round trips.
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 20*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.ConvertPosition(ctx, &payload)
+	if err != nil {
+		writeBrokerError(c, "convert position", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
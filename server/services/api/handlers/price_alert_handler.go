@@ -0,0 +1,418 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/api/clients"
+	"github.com/Sagar-v4/Angel-Two/services/api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PriceAlertHandler serves price alerts, which only notify (through the
+// user's webhooks) and never place orders, along with the webhooks and the
+// delivery log.
+type PriceAlertHandler struct {
+	brokerClient *clients.BrokerServiceClientWrapper
+}
+
+func NewPriceAlertHandler(brokerClient *clients.BrokerServiceClientWrapper) *PriceAlertHandler {
+	return &PriceAlertHandler{brokerClient: brokerClient}
+}
+
+// priceAlertRequest is the HTTP body for a new alert; the condition type is
+// sent by name (e.g. "PERCENT_CHANGE_ABOVE").
+type priceAlertRequest struct {
+	Exchange      string         `json:"exchange" binding:"required"`
+	Tradingsymbol string         `json:"tradingsymbol"`
+	Symboltoken   string         `json:"symboltoken"`
+	Condition     *ruleCondition `json:"condition" binding:"required"`
+	Note          string         `json:"note"`
+	Repeat        bool           `json:"repeat"`
+}
+
+// priceAlertUpdate is the HTTP body of PUT /api/alerts/price/:id. note, repeat
+// and paused replace the stored values; the condition is kept when omitted.
+type priceAlertUpdate struct {
+	Condition *ruleCondition `json:"condition"`
+	Note      string         `json:"note"`
+	Repeat    bool           `json:"repeat"`
+	Paused    bool           `json:"paused"`
+}
+
+type webhookRequest struct {
+	URL string `json:"url" binding:"required"`
+}
+
+// POST /api/alerts/price
+func (h *PriceAlertHandler) CreateAlert(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload priceAlertRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid alert payload", "details": err.Error()})
+		return
+	}
+	condition, ok := alertCondition(c, payload.Condition)
+	if !ok {
+		return
+	}
+
+	req := brokerpb.CreatePriceAlertRequest{
+		AngelOneJwt: angelTokens[0],
+		Alert: &brokerpb.PriceAlert{
+			Exchange:      payload.Exchange,
+			Tradingsymbol: payload.Tradingsymbol,
+			Symboltoken:   payload.Symboltoken,
+			Condition:     condition,
+			Note:          payload.Note,
+			Repeat:        payload.Repeat,
+		},
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.CreatePriceAlert(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "create price alert", err) // 422 once the per-client limit is reached
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/alerts/price
+func (h *PriceAlertHandler) ListAlerts(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.ListPriceAlertsRequest{
+		AngelOneJwt:    angelTokens[0],
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.ListPriceAlerts(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "list price alerts", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/alerts/price/:id
+func (h *PriceAlertHandler) GetAlert(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.AlertIDRequest{
+		AngelOneJwt:    angelTokens[0],
+		Id:             c.Param("id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.GetPriceAlert(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "get price alert", err) // 404 for unknown ids
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// PUT /api/alerts/price/:id
+func (h *PriceAlertHandler) UpdateAlert(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload priceAlertUpdate
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid alert payload", "details": err.Error()})
+		return
+	}
+	req := brokerpb.UpdatePriceAlertRequest{
+		AngelOneJwt:    angelTokens[0],
+		Id:             c.Param("id"),
+		Note:           payload.Note,
+		Repeat:         payload.Repeat,
+		Paused:         payload.Paused,
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+	if payload.Condition != nil {
+		condition, ok := alertCondition(c, payload.Condition)
+		if !ok {
+			return
+		}
+		req.Condition = condition
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.UpdatePriceAlert(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "update price alert", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DELETE /api/alerts/price/:id
+func (h *PriceAlertHandler) DeleteAlert(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.AlertIDRequest{
+		AngelOneJwt:    angelTokens[0],
+		Id:             c.Param("id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.DeletePriceAlert(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "delete price alert", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// POST /api/alerts/price/webhooks
+func (h *PriceAlertHandler) CreateWebhook(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload webhookRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook payload", "details": err.Error()})
+		return
+	}
+	req := brokerpb.CreateAlertWebhookRequest{
+		AngelOneJwt:    angelTokens[0],
+		Url:            payload.URL,
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.CreateAlertWebhook(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "create webhook", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/alerts/price/webhooks
+func (h *PriceAlertHandler) ListWebhooks(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.ListAlertWebhooksRequest{
+		AngelOneJwt:    angelTokens[0],
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.ListAlertWebhooks(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "list webhooks", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DELETE /api/alerts/price/webhooks/:id
+func (h *PriceAlertHandler) DeleteWebhook(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.AlertIDRequest{
+		AngelOneJwt:    angelTokens[0],
+		Id:             c.Param("id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.DeleteAlertWebhook(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "delete webhook", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/alerts/price/deliveries?alert_id=7&limit=50
+func (h *PriceAlertHandler) ListDeliveries(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.ListAlertDeliveriesRequest{
+		AngelOneJwt:    angelTokens[0],
+		AlertId:        c.Query("alert_id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit", "details": err.Error()})
+			return
+		}
+		req.Limit = int32(limit)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.ListAlertDeliveries(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "list webhook deliveries", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// alertCondition maps a condition sent by name to the proto, writing a 400
+// for unknown types. Which types alerts accept is checked by the broker.
+func alertCondition(c *gin.Context, cond *ruleCondition) (*brokerpb.AlertCondition, bool) {
+	condType, ok := brokerpb.AlertConditionType_value[cond.Type]
+	if !ok || condType == int32(brokerpb.AlertConditionType_ALERT_CONDITION_TYPE_UNSPECIFIED) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid alert payload", "details": "unknown condition type " + cond.Type})
+		return nil, false
+	}
+	return &brokerpb.AlertCondition{Type: brokerpb.AlertConditionType(condType), Value: cond.Value}, true
+}
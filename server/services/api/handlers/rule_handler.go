@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/api/clients"
+	"github.com/Sagar-v4/Angel-Two/services/api/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RuleHandler serves the conditional order rules the broker service
+// evaluates locally ("buy INFY if LTP crosses 1500").
+type RuleHandler struct {
+	brokerClient *clients.BrokerServiceClientWrapper
+}
+
+func NewRuleHandler(brokerClient *clients.BrokerServiceClientWrapper) *RuleHandler {
+	return &RuleHandler{brokerClient: brokerClient}
+}
+
+// ruleRequest is the HTTP body for a new rule; condition types are sent by
+// name (e.g. "PRICE_ABOVE") rather than as the proto enum number.
+type ruleRequest struct {
+	Name           string                      `json:"name"`
+	Exchange       string                      `json:"exchange"` // Watched instrument; defaults to the order's
+	Tradingsymbol  string                      `json:"tradingsymbol"`
+	Symboltoken    string                      `json:"symboltoken"`
+	Conditions     []ruleCondition             `json:"conditions" binding:"required"`
+	Order          *brokerpb.PlaceOrderRequest `json:"order" binding:"required"`
+	ReferencePrice float64                     `json:"reference_price"`
+}
+
+type ruleCondition struct {
+	Type  string  `json:"type" binding:"required"`
+	Value float64 `json:"value"`
+	Time  string  `json:"time"` // HH:MM in IST
+}
+
+// POST /api/alerts
+func (h *RuleHandler) CreateRule(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	var payload ruleRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rule payload", "details": err.Error()})
+		return
+	}
+	rule := &brokerpb.AlertRule{
+		Name:           payload.Name,
+		Exchange:       payload.Exchange,
+		Tradingsymbol:  payload.Tradingsymbol,
+		Symboltoken:    payload.Symboltoken,
+		Order:          payload.Order,
+		ReferencePrice: payload.ReferencePrice,
+	}
+	for _, cond := range payload.Conditions {
+		condType, ok := brokerpb.AlertConditionType_value[cond.Type]
+		if !ok || condType == int32(brokerpb.AlertConditionType_ALERT_CONDITION_TYPE_UNSPECIFIED) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid rule payload", "details": "unknown condition type " + cond.Type})
+			return
+		}
+		rule.Conditions = append(rule.Conditions, &brokerpb.AlertCondition{Type: brokerpb.AlertConditionType(condType), Value: cond.Value, Time: cond.Time})
+	}
+	if rule.Order.Variety == "" { // Same defaults as /api/orders/place
+		rule.Order.Variety = "NORMAL"
+	}
+	if rule.Order.Duration == "" {
+		rule.Order.Duration = "DAY"
+	}
+
+	req := brokerpb.CreateAlertRuleRequest{
+		AngelOneJwt:    angelTokens[0],
+		FeedToken:      ruleFeedToken(angelTokens),
+		Rule:           rule,
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.CreateAlertRule(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "create rule", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/alerts?active=true
+func (h *RuleHandler) ListRules(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.ListAlertRulesRequest{
+		AngelOneJwt:    angelTokens[0],
+		FeedToken:      ruleFeedToken(angelTokens),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+	if value := c.Query("active"); value != "" {
+		active, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid active", "details": err.Error()})
+			return
+		}
+		req.ActiveOnly = active
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.ListAlertRules(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "list rules", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/alerts/:id
+func (h *RuleHandler) GetRule(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.GetAlertRuleRequest{
+		AngelOneJwt:    angelTokens[0],
+		FeedToken:      ruleFeedToken(angelTokens),
+		Id:             c.Param("id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.GetAlertRule(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "get rule", err) // 404 for unknown ids
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DELETE /api/alerts/:id
+func (h *RuleHandler) CancelRule(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) == 0 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	req := brokerpb.CancelAlertRuleRequest{
+		AngelOneJwt:    angelTokens[0],
+		FeedToken:      ruleFeedToken(angelTokens),
+		Id:             c.Param("id"),
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if req.ClientPublicIp == "" {
+		req.ClientPublicIp = c.ClientIP()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 15*time.Second)
+	defer cancel()
+
+	resp, err := h.brokerClient.Client.CancelAlertRule(ctx, &req)
+	if err != nil {
+		writeBrokerError(c, "cancel rule", err) // 422 once the rule has fired
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ruleFeedToken lets the broker watch rule prices on the tick stream; without
+// it they are polled.
+func ruleFeedToken(angelTokens []string) string {
+	if len(angelTokens) > 1 {
+		return angelTokens[1]
+	}
+	return ""
+}
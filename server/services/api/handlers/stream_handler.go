@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/api/clients"
+	"github.com/Sagar-v4/Angel-Two/services/api/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	streamPingInterval = 30 * time.Second
+	streamPongWait     = 60 * time.Second // Browsers answer pings automatically
+	streamWriteWait    = 10 * time.Second
+	streamMaxMessage   = 64 << 10
+)
+
+type StreamHandler struct {
+	brokerClient *clients.BrokerServiceClientWrapper
+	upgrader     websocket.Upgrader
+}
+
+// NewStreamHandler only accepts WebSocket connections from the given origins,
+// the same ones CORS allows.
+func NewStreamHandler(brokerClient *clients.BrokerServiceClientWrapper, allowedOrigins []string) *StreamHandler {
+	origins := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		origins[origin] = true
+	}
+	return &StreamHandler{
+		brokerClient: brokerClient,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 4096,
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				return origin == "" || origins[origin] // Non-browser clients send no Origin
+			},
+		},
+	}
+}
+
+// streamMessage is what the browser sends, e.g.
+// {"action":"subscribe","mode":"QUOTE","instruments":[{"exchange":"NSE","tradingsymbol":"SBIN-EQ"}]}
+type streamMessage struct {
+	Action      string                     `json:"action"` // subscribe or unsubscribe
+	Mode        string                     `json:"mode"`   // LTP (default), QUOTE or SNAP_QUOTE
+	Instruments []*brokerpb.TickInstrument `json:"instruments"`
+}
+
+// GET /api/market/stream
+func (h *StreamHandler) MarketStream(c *gin.Context) {
+	authStatus, _ := c.Get(middleware.AuthStatusKey)
+	if authStatus != "verified" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+	angelTokensVal, _ := c.Get(middleware.VerifiedAngelTokensKey)
+	angelTokens, _ := angelTokensVal.([]string)
+	if len(angelTokens) < 2 {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Session token error"})
+		return
+	}
+
+	base := &brokerpb.SubscribeTicksRequest{
+		AngelOneJwt:    angelTokens[0],
+		FeedToken:      angelTokens[1],
+		ClientLocalIp:  c.ClientIP(),
+		ClientPublicIp: c.GetHeader("X-Forwarded-For"),
+	}
+	if base.ClientPublicIp == "" {
+		base.ClientPublicIp = c.ClientIP()
+	}
+
+	ws, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Printf("Market stream: upgrade failed: %v", err) // The upgrader has already replied
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	session := &tickSession{
+		ctx:          ctx,
+		ws:           ws,
+		brokerClient: h.brokerClient,
+		base:         base,
+		subs:         make(map[brokerpb.TickMode]map[string]*brokerpb.TickInstrument),
+		cancels:      make(map[brokerpb.TickMode]context.CancelFunc),
+	}
+	session.run()
+}
+
+// tickSession relays one browser's subscriptions. Each mode is served by its
+// own SubscribeTicks stream, which is restarted whenever that mode's set of
+// instruments changes.
+type tickSession struct {
+	ctx          context.Context
+	ws           *websocket.Conn
+	brokerClient *clients.BrokerServiceClientWrapper
+	base         *brokerpb.SubscribeTicksRequest
+
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	subs    map[brokerpb.TickMode]map[string]*brokerpb.TickInstrument
+	cancels map[brokerpb.TickMode]context.CancelFunc
+}
+
+func (s *tickSession) run() {
+	defer s.ws.Close()
+	defer s.stopAll()
+
+	s.ws.SetReadLimit(streamMaxMessage)
+	s.ws.SetReadDeadline(time.Now().Add(streamPongWait))
+	s.ws.SetPongHandler(func(string) error {
+		return s.ws.SetReadDeadline(time.Now().Add(streamPongWait))
+	})
+	go s.ping()
+
+	for {
+		_, data, err := s.ws.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				log.Printf("Market stream: read error: %v", err)
+			}
+			return
+		}
+		var msg streamMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			s.send(gin.H{"type": "error", "error": "Invalid stream message", "details": err.Error()})
+			continue
+		}
+		s.handle(msg)
+	}
+}
+
+func (s *tickSession) handle(msg streamMessage) {
+	modeName := strings.ToUpper(msg.Mode)
+	if modeName == "" {
+		modeName = brokerpb.TickMode_LTP.String()
+	}
+	modeValue, ok := brokerpb.TickMode_value[modeName]
+	if !ok || modeValue == int32(brokerpb.TickMode_TICK_MODE_UNSPECIFIED) {
+		s.send(gin.H{"type": "error", "error": "mode must be one of LTP, QUOTE or SNAP_QUOTE"})
+		return
+	}
+	mode := brokerpb.TickMode(modeValue)
+	if len(msg.Instruments) == 0 {
+		s.send(gin.H{"type": "error", "mode": modeName, "error": "at least one instrument is required"})
+		return
+	}
+
+	s.mu.Lock()
+	set := s.subs[mode]
+	switch msg.Action {
+	case "subscribe":
+		if set == nil {
+			set = make(map[string]*brokerpb.TickInstrument)
+			s.subs[mode] = set
+		}
+		for _, inst := range msg.Instruments {
+			if inst != nil {
+				set[instrumentKey(inst)] = inst
+			}
+		}
+	case "unsubscribe":
+		for _, inst := range msg.Instruments {
+			if inst != nil {
+				delete(set, instrumentKey(inst))
+			}
+		}
+	default:
+		s.mu.Unlock()
+		s.send(gin.H{"type": "error", "error": "action must be subscribe or unsubscribe"})
+		return
+	}
+	instruments := s.restartLocked(mode)
+	s.mu.Unlock()
+
+	s.send(gin.H{"type": msg.Action + "d", "mode": modeName, "instruments": instruments})
+}
+
+// instrumentKey identifies an instrument the way the browser named it, so
+// unsubscribe must use the same exchange and token or tradingsymbol as subscribe.
+func instrumentKey(inst *brokerpb.TickInstrument) string {
+	return strings.ToUpper(inst.Exchange) + "|" + inst.Symboltoken + "|" + strings.ToUpper(inst.Tradingsymbol)
+}
+
+// restartLocked replaces the mode's stream and returns the instruments it now covers.
+func (s *tickSession) restartLocked(mode brokerpb.TickMode) []*brokerpb.TickInstrument {
+	if cancel, ok := s.cancels[mode]; ok {
+		cancel()
+		delete(s.cancels, mode)
+	}
+	set := s.subs[mode]
+	if len(set) == 0 {
+		delete(s.subs, mode)
+		return []*brokerpb.TickInstrument{}
+	}
+
+	req := &brokerpb.SubscribeTicksRequest{
+		AngelOneJwt:    s.base.AngelOneJwt,
+		FeedToken:      s.base.FeedToken,
+		Mode:           mode,
+		ClientLocalIp:  s.base.ClientLocalIp,
+		ClientPublicIp: s.base.ClientPublicIp,
+	}
+	for _, inst := range set {
+		req.Instruments = append(req.Instruments, inst)
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.cancels[mode] = cancel
+	go s.pump(ctx, mode, req)
+	return req.Instruments
+}
+
+func (s *tickSession) pump(ctx context.Context, mode brokerpb.TickMode, req *brokerpb.SubscribeTicksRequest) {
+	stream, err := s.brokerClient.Client.SubscribeTicks(ctx, req)
+	if err != nil {
+		s.streamFailed(ctx, mode, err)
+		return
+	}
+	for {
+		tick, err := stream.Recv()
+		if err != nil {
+			s.streamFailed(ctx, mode, err)
+			return
+		}
+		s.send(gin.H{"type": "tick", "data": tick})
+	}
+}
+
+// streamFailed drops the mode's subscriptions and tells the browser, which may
+// subscribe again. Streams that were replaced or closed on purpose end silently.
+func (s *tickSession) streamFailed(ctx context.Context, mode brokerpb.TickMode, err error) {
+	s.mu.Lock()
+	if ctx.Err() != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.cancels[mode]()
+	delete(s.cancels, mode)
+	delete(s.subs, mode)
+	s.mu.Unlock()
+
+	if errors.Is(err, io.EOF) {
+		err = errors.New("market data stream ended")
+	}
+	log.Printf("Market stream: %s stream failed: %v", mode, err)
+	msg := gin.H{"type": "error", "mode": mode.String(), "error": err.Error()}
+	if st, ok := status.FromError(err); ok {
+		msg["error"] = st.Message()
+		msg["code"] = st.Code().String()
+	}
+	s.send(msg)
+}
+
+func (s *tickSession) stopAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for mode, cancel := range s.cancels {
+		cancel()
+		delete(s.cancels, mode)
+	}
+}
+
+func (s *tickSession) ping() {
+	ticker := time.NewTicker(streamPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.writeMu.Lock()
+			err := s.ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteWait))
+			s.writeMu.Unlock()
+			if err != nil {
+				s.ws.Close() // Unblocks the read loop
+				return
+			}
+		}
+	}
+}
+
+func (s *tickSession) send(msg gin.H) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.ws.SetWriteDeadline(time.Now().Add(streamWriteWait))
+	if err := s.ws.WriteJSON(msg); err != nil {
+		s.ws.Close()
+	}
+}
@@ -45,10 +45,12 @@ func main() {
 	// gin.SetMode(gin.ReleaseMode) // For production
 	router := gin.Default()
 
+	// Allow your frontend's origin; the market data WebSocket accepts the same ones
+	allowedOrigins := []string{"http://localhost:3000", "http://your-frontend-domain.com"}
+
 	// CORS Middleware - Adjust origins as necessary
 	corsConfig := cors.DefaultConfig()
-	// Allow your frontend's origin
-	corsConfig.AllowOrigins = []string{"http://localhost:3000", "http://your-frontend-domain.com"}
+	corsConfig.AllowOrigins = allowedOrigins
 	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
 	corsConfig.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"}
 	corsConfig.AllowCredentials = true // Crucial for cookies to be sent and received
@@ -58,7 +60,7 @@ func main() {
 	// This middleware will run on every request to /api/*
 	// It will check for the cookie and verify it, setting context values.
 	apiGroup := router.Group("/api")
-	apiGroup.Use(middleware.AuthMiddleware(authClientWrapper, cfg)) // Global for /api group
+	apiGroup.Use(middleware.AuthMiddleware(authClientWrapper, brokerClientWrapper, cfg)) // Global for /api group
 
 	// Initialize Handlers
 	apiAuthHandler := handlers.NewAPIAuthHandler(authClientWrapper, brokerClientWrapper, cfg) // Pass both clients
@@ -66,25 +68,83 @@ func main() {
 	orderHandler := handlers.NewOrderHandler(brokerClientWrapper)
 	portfolioHandler := handlers.NewPortfolioHandler(brokerClientWrapper)
 	marketHandler := handlers.NewMarketHandler(brokerClientWrapper)
+	fundsHandler := handlers.NewFundsHandler(brokerClientWrapper)
+	instrumentHandler := handlers.NewInstrumentHandler(brokerClientWrapper)
+	streamHandler := handlers.NewStreamHandler(brokerClientWrapper, allowedOrigins)
+	gttHandler := handlers.NewGTTHandler(brokerClientWrapper)
+	ruleHandler := handlers.NewRuleHandler(brokerClientWrapper)
+	priceAlertHandler := handlers.NewPriceAlertHandler(brokerClientWrapper)
+	bracketHandler := handlers.NewBracketHandler(brokerClientWrapper)
 
 	// API Routes
 	apiGroup.POST("/login", apiAuthHandler.Login)
 	apiGroup.GET("/auth_status", apiAuthHandler.AuthStatus)
 	apiGroup.POST("/logout", apiAuthHandler.Logout)
 	apiGroup.GET("/profile", profileHandler.GetProfile)
+	apiGroup.GET("/funds", fundsHandler.GetFunds)
 
 	// Order Routes
 	ordersGroup := apiGroup.Group("/orders") // Grouping order related routes
 	{
 		ordersGroup.POST("/place", orderHandler.PlaceOrder)
 		ordersGroup.POST("/cancel", orderHandler.CancelOrder)
+		ordersGroup.POST("/modify", orderHandler.ModifyOrder)
+		ordersGroup.POST("/basket", orderHandler.PlaceBasket)
+		ordersGroup.POST("/margin", orderHandler.CalculateMargin)
 		ordersGroup.GET("/book", orderHandler.GetOrderBook)
+		ordersGroup.GET("/trades", orderHandler.GetTradeBook)
+		ordersGroup.GET("/stream", orderHandler.StreamOrderUpdates) // Server-Sent Events
+		ordersGroup.GET("/:id", orderHandler.GetOrderDetails)
+	}
+
+	// Bracket (OCO) orders for delivery, emulated by the broker service
+	bracketGroup := ordersGroup.Group("/bracket")
+	{
+		bracketGroup.POST("", bracketHandler.PlaceBracketOrder)
+		bracketGroup.GET("", bracketHandler.ListBracketOrders)
+		bracketGroup.GET("/:id", bracketHandler.GetBracketOrder)
+		bracketGroup.DELETE("/:id", bracketHandler.CancelBracketOrder)
+	}
+
+	// GTT Routes
+	gttGroup := apiGroup.Group("/gtt")
+	{
+		gttGroup.POST("", gttHandler.CreateGTT)
+		gttGroup.GET("", gttHandler.ListGTT)
+		gttGroup.GET("/:id", gttHandler.GetGTTDetails)
+		gttGroup.PUT("/:id", gttHandler.ModifyGTT)
+		gttGroup.DELETE("/:id", gttHandler.CancelGTT)
+	}
+
+	// Conditional order rules, evaluated by the broker service
+	alertsGroup := apiGroup.Group("/alerts")
+	{
+		alertsGroup.POST("", ruleHandler.CreateRule)
+		alertsGroup.GET("", ruleHandler.ListRules)
+		alertsGroup.GET("/:id", ruleHandler.GetRule)
+		alertsGroup.DELETE("/:id", ruleHandler.CancelRule)
+	}
+
+	// Price alerts, which notify through webhooks instead of placing orders
+	priceAlertsGroup := alertsGroup.Group("/price")
+	{
+		priceAlertsGroup.POST("", priceAlertHandler.CreateAlert)
+		priceAlertsGroup.GET("", priceAlertHandler.ListAlerts)
+		priceAlertsGroup.POST("/webhooks", priceAlertHandler.CreateWebhook)
+		priceAlertsGroup.GET("/webhooks", priceAlertHandler.ListWebhooks)
+		priceAlertsGroup.DELETE("/webhooks/:id", priceAlertHandler.DeleteWebhook)
+		priceAlertsGroup.GET("/deliveries", priceAlertHandler.ListDeliveries)
+		priceAlertsGroup.GET("/:id", priceAlertHandler.GetAlert)
+		priceAlertsGroup.PUT("/:id", priceAlertHandler.UpdateAlert)
+		priceAlertsGroup.DELETE("/:id", priceAlertHandler.DeleteAlert)
 	}
 
 	// Portfolio Routes
 	portfolioGroup := apiGroup.Group("/portfolio")
 	{
 		portfolioGroup.GET("/holdings", portfolioHandler.GetHoldings)
+		portfolioGroup.GET("/positions", portfolioHandler.GetPositions)
+		portfolioGroup.POST("/positions/convert", portfolioHandler.ConvertPosition)
 	}
 
 	// Market Data Routes
@@ -92,6 +152,14 @@ func main() {
 	{
 		marketGroup.POST("/ltp", marketHandler.GetLTP)
 		marketGroup.POST("/quote", marketHandler.GetFullQuote)
+		marketGroup.POST("/candles", marketHandler.GetCandleData)
+		marketGroup.GET("/stream", streamHandler.MarketStream) // WebSocket
+	}
+
+	// Instrument Routes
+	instrumentsGroup := apiGroup.Group("/instruments")
+	{
+		instrumentsGroup.GET("/search", instrumentHandler.Search)
 	}
 
 	// HTTP Server
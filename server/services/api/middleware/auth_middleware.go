@@ -2,10 +2,14 @@ package middleware
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	authpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/auth"
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
 	"github.com/Sagar-v4/Angel-Two/services/api/clients" // For AuthServiceClientWrapper
 	"github.com/Sagar-v4/Angel-Two/services/api/config"
 
@@ -22,8 +26,11 @@ const (
 )
 
 // AuthMiddleware checks for user_token cookie and verifies it.
-// It sets context values for downstream handlers.
-func AuthMiddleware(authClient *clients.AuthServiceClientWrapper, cfg *config.Config) gin.HandlerFunc {
+// It sets context values for downstream handlers, lets broker calls
+// made with the request context refresh an expired Angel One session
+// and hands the session to the broker for its background work.
+func AuthMiddleware(authClient *clients.AuthServiceClientWrapper, brokerClient *clients.BrokerServiceClientWrapper, cfg *config.Config) gin.HandlerFunc {
+	publisher := newSessionPublisher(brokerClient)
 	return func(c *gin.Context) {
 		userToken, err := c.Cookie(cfg.UserTokenCookieName)
 
@@ -55,6 +62,15 @@ func AuthMiddleware(authClient *clients.AuthServiceClientWrapper, cfg *config.Co
 			log.Printf("AuthMiddleware: Token verified successfully. JTI: (not directly available from VerifyResponse in this design)")
 			c.Set(AuthStatusKey, "verified")
 			c.Set(VerifiedAngelTokensKey, verifyResp.Tokens)
+			clientPublicIP := c.GetHeader("X-Forwarded-For")
+			if clientPublicIP == "" {
+				clientPublicIP = c.ClientIP()
+			}
+			refresher := sessionRefresher(authClient, brokerClient, publisher, userToken, verifyResp.Tokens, c.ClientIP(), clientPublicIP)
+			c.Request = c.Request.WithContext(clients.WithTokenRefresher(c.Request.Context(), refresher))
+			if len(verifyResp.Tokens) >= 2 {
+				publisher.publish(c.Request.Context(), verifyResp.Tokens[0], verifyResp.Tokens[1], c.ClientIP(), clientPublicIP)
+			}
 			// We don't get JTI directly from current VerifyResponse, but we know it's valid.
 			// If you need JTI, Auth service's VerifyResponse would need to return it.
 			// For now, we'll just mark as verified.
@@ -68,3 +84,75 @@ func AuthMiddleware(authClient *clients.AuthServiceClientWrapper, cfg *config.Co
 		c.Next()
 	}
 }
+
+// sessionRefresher renews the Angel One session with the stored refresh token and
+// saves the new tokens with the Auth service, so the user_token cookie stays valid.
+// The new session is handed to the broker for its background work as well.
+// The refresh happens at most once per request; later calls reuse the new JWT.
+func sessionRefresher(
+	authClient *clients.AuthServiceClientWrapper,
+	brokerClient *clients.BrokerServiceClientWrapper,
+	publisher *sessionPublisher,
+	userToken string,
+	angelTokens []string, // [jwt, feed, refresh]
+	clientLocalIP, clientPublicIP string,
+) clients.TokenRefresher {
+	var (
+		mu         sync.Mutex
+		refreshed  string
+		refreshErr error
+		done       bool
+	)
+
+	return func(ctx context.Context) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if done {
+			return refreshed, refreshErr
+		}
+		done = true
+
+		if len(angelTokens) < 3 || angelTokens[2] == "" {
+			refreshErr = errors.New("no refresh token stored for this session")
+			return "", refreshErr
+		}
+
+		genResp, err := brokerClient.Client.GenerateTokens(ctx, &brokerpb.GenerateTokensRequest{
+			AngelOneJwt:  angelTokens[0],
+			RefreshToken: angelTokens[2],
+		})
+		if err != nil {
+			refreshErr = fmt.Errorf("broker GenerateTokens: %w", err)
+			return "", refreshErr
+		}
+		if !genResp.Status || genResp.Data == nil || genResp.Data.JwtToken == "" {
+			refreshErr = fmt.Errorf("angel one refused token refresh: %s (%s)", genResp.Message, genResp.Errorcode)
+			return "", refreshErr
+		}
+
+		feedToken := genResp.Data.FeedToken
+		if feedToken == "" {
+			feedToken = angelTokens[1]
+		}
+		refreshResp, err := authClient.Client.Refresh(ctx, &authpb.RefreshRequest{
+			UserToken:    userToken,
+			JwtToken:     genResp.Data.JwtToken,
+			FeedToken:    feedToken,
+			RefreshToken: genResp.Data.RefreshToken,
+		})
+		if err != nil {
+			refreshErr = fmt.Errorf("auth Refresh: %w", err)
+			return "", refreshErr
+		}
+		if !refreshResp.Success {
+			refreshErr = fmt.Errorf("auth service rejected refresh: %s", refreshResp.Message)
+			return "", refreshErr
+		}
+
+		log.Println("AuthMiddleware: Angel One session refreshed.")
+		refreshed = genResp.Data.JwtToken
+		// Without the refresher, so a rejected session is not refreshed from within the refresh
+		publisher.publish(clients.WithTokenRefresher(ctx, nil), refreshed, feedToken, clientLocalIP, clientPublicIP)
+		return refreshed, nil
+	}
+}
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	brokerpb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/api/clients"
+)
+
+const (
+	// sessionPublishInterval is how often the same Angel One JWT is handed to
+	// the broker again, e.g. to catch up after a broker restart.
+	sessionPublishInterval = time.Minute
+	// idlePublishInterval replaces sessionPublishInterval for clients the
+	// broker has no background work for. Creating a rule, price alert or
+	// bracket order hands the broker the session anyway.
+	idlePublishInterval = 15 * time.Minute
+	// sessionPublishTimeout bounds one SetSession call.
+	sessionPublishTimeout = 5 * time.Second
+	// maxPublishedSessions bounds the JWTs remembered; stale ones go first.
+	maxPublishedSessions = 1024
+)
+
+// sessionPublisher hands the broker the Angel One session of authenticated
+// requests, so the broker's rules, price alerts and bracket orders keep
+// running in the background: the broker only holds sessions in memory, only
+// for clients with background work, and forgets the ones Angel One rejects.
+type sessionPublisher struct {
+	brokerClient *clients.BrokerServiceClientWrapper
+
+	mu   sync.Mutex
+	next map[string]time.Time // When each JWT is due again, keyed by JWT
+}
+
+func newSessionPublisher(brokerClient *clients.BrokerServiceClientWrapper) *sessionPublisher {
+	return &sessionPublisher{brokerClient: brokerClient, next: make(map[string]time.Time)}
+}
+
+// publish sends the session to the broker in the background unless the JWT
+// is not due yet, so the request never waits for the broker. ctx only passes
+// on its values. Failures are only logged.
+func (p *sessionPublisher) publish(ctx context.Context, jwt, feedToken, clientLocalIP, clientPublicIP string) {
+	if jwt == "" || !p.due(jwt, time.Now()) {
+		return
+	}
+	go p.send(context.WithoutCancel(ctx), jwt, feedToken, clientLocalIP, clientPublicIP)
+}
+
+func (p *sessionPublisher) send(ctx context.Context, jwt, feedToken, clientLocalIP, clientPublicIP string) {
+	ctx, cancel := context.WithTimeout(ctx, sessionPublishTimeout)
+	defer cancel()
+	resp, err := p.brokerClient.Client.SetSession(ctx, &brokerpb.SetSessionRequest{
+		AngelOneJwt:    jwt,
+		FeedToken:      feedToken,
+		ClientLocalIp:  clientLocalIP,
+		ClientPublicIp: clientPublicIP,
+	})
+	switch {
+	case err != nil:
+		log.Printf("AuthMiddleware: Failed to hand the Angel One session to the broker: %v", err)
+	case !resp.Status:
+		log.Printf("AuthMiddleware: Broker did not take the Angel One session: %s (%s)", resp.Message, resp.Errorcode)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch {
+	case err != nil || !resp.Status:
+		delete(p.next, jwt) // Try again with the next request
+	case !resp.Kept:
+		if _, ok := p.next[jwt]; ok {
+			p.next[jwt] = time.Now().Add(idlePublishInterval)
+		}
+	}
+}
+
+// due records jwt as published at now and reports whether it was due.
+func (p *sessionPublisher) due(jwt string, now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if next, ok := p.next[jwt]; ok && now.Before(next) {
+		return false
+	}
+	if len(p.next) >= maxPublishedSessions {
+		for key, next := range p.next {
+			if !now.Before(next) {
+				delete(p.next, key)
+			}
+		}
+		if len(p.next) >= maxPublishedSessions {
+			clear(p.next)
+		}
+	}
+	p.next[jwt] = now.Add(sessionPublishInterval)
+	return true
+}
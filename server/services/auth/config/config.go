@@ -10,17 +10,35 @@ type Config struct {
 	GRPCPort         string
 	UserJWTSecretKey string
 	UserJWTDuration  time.Duration
+
+	TokenStoreType     string // "memory" or "bolt"
+	TokenStorePath     string // BoltDB file, used when TokenStoreType is "bolt"
+	TokenEncryptionKey string // Encrypts stored Angel One tokens at rest
+
+	SessionSweepInterval time.Duration // How often expired sessions are evicted
+	MetricsPort          string        // Serves expvar metrics on /debug/vars; empty disables it
 }
 
 func Load() *Config {
 	port := getEnv("GRPC_PORT", "50051")
 	secret := getEnv("USER_JWT_SECRET_KEY", "a-very-secure-secret-for-user-token") // CHANGE IN PRODUCTION!
 	durationHours, _ := strconv.Atoi(getEnv("USER_JWT_DURATION_HOURS", "24"))
+	sweepSeconds, err := strconv.Atoi(getEnv("SESSION_SWEEP_INTERVAL_SECONDS", "60"))
+	if err != nil || sweepSeconds <= 0 {
+		sweepSeconds = 60
+	}
 
 	return &Config{
 		GRPCPort:         port,
 		UserJWTSecretKey: secret,
 		UserJWTDuration:  time.Duration(durationHours) * time.Hour,
+
+		TokenStoreType:     getEnv("TOKEN_STORE", "memory"),
+		TokenStorePath:     getEnv("TOKEN_STORE_PATH", "auth-sessions.db"),
+		TokenEncryptionKey: getEnv("TOKEN_ENCRYPTION_KEY", ""), // Required for the bolt store
+
+		SessionSweepInterval: time.Duration(sweepSeconds) * time.Second,
+		MetricsPort:          getEnv("METRICS_PORT", ""),
 	}
 }
 
@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
+	"expvar"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	// Adjust these import paths based on your actual module path and directory structure
 	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/auth" // Path to generated proto Go files
@@ -34,9 +38,25 @@ func main() {
 	}
 
 	// Initialize dependencies
-	tokenStore := store.NewInMemoryStore()
+	tokenStore, err := newTokenStore(config)
+	if err != nil {
+		log.Fatalf("Failed to initialize token store: %v", err)
+	}
+	defer tokenStore.Close()
+
+	// Revoked JTIs survive restarts when the token store can persist them
+	revocationStore, _ := tokenStore.(store.RevocationStore)
+	revocations, err := store.NewRevocationList(revocationStore)
+	if err != nil {
+		log.Fatalf("Failed to load revoked JTIs: %v", err)
+	}
+
+	// Evict expired sessions and revoked JTIs in the background
+	janitor := store.NewJanitor(tokenStore, revocations, config.SessionSweepInterval)
+	janitor.Start()
+
 	jwtManager := jwtm.NewManager(config.UserJWTSecretKey, config.UserJWTDuration)
-	grpcServer := authserver.NewAuthServer(tokenStore, jwtManager)
+	grpcServer := authserver.NewAuthServer(tokenStore, revocations, jwtManager)
 
 	// Create and register gRPC server
 	s := grpc.NewServer()
@@ -47,6 +67,20 @@ func main() {
 
 	log.Printf("gRPC Auth Service listening on :%s", config.GRPCPort)
 
+	// Optional metrics listener (live sessions, expired sessions, revoked JTIs)
+	var metricsServer *http.Server
+	if config.MetricsPort != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/vars", expvar.Handler())
+		metricsServer = &http.Server{Addr: ":" + config.MetricsPort, Handler: mux}
+		go func() {
+			log.Printf("Auth Service metrics listening on :%s/debug/vars", config.MetricsPort)
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Printf("Metrics server error: %v", err)
+			}
+		}()
+	}
+
 	// Graceful shutdown
 	go func() {
 		if err := s.Serve(lis); err != nil {
@@ -62,4 +96,31 @@ func main() {
 	log.Println("Shutting down gRPC server...")
 	s.GracefulStop()
 	log.Println("gRPC server stopped.")
+
+	if metricsServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := metricsServer.Shutdown(ctx); err != nil {
+			log.Printf("Metrics server shutdown error: %v", err)
+		}
+		cancel()
+	}
+
+	janitor.Stop()
+	log.Println("Session janitor stopped.")
+}
+
+// newTokenStore builds the TokenStore selected by TOKEN_STORE.
+func newTokenStore(config *cfg.Config) (store.TokenStore, error) {
+	switch config.TokenStoreType {
+	case "memory":
+		return store.NewInMemoryStore(config.UserJWTDuration), nil
+	case "bolt":
+		if config.TokenEncryptionKey == "" {
+			return nil, fmt.Errorf("TOKEN_ENCRYPTION_KEY is required for the bolt store")
+		}
+		log.Printf("Using BoltDB token store at %s (single replica only)", config.TokenStorePath)
+		return store.NewBoltStore(config.TokenStorePath, config.TokenEncryptionKey, config.UserJWTDuration)
+	default:
+		return nil, fmt.Errorf("unknown TOKEN_STORE %q (expected \"memory\" or \"bolt\")", config.TokenStoreType)
+	}
 }
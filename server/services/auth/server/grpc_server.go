@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"log"
+	"time"
 
 	// Use the correct import path based on your go.mod and gen folder
 	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/auth"
@@ -13,15 +14,17 @@ import (
 // AuthServer implements the gRPC Auth service.
 type AuthServer struct {
 	pb.UnimplementedAuthServer
-	tokenStore *store.InMemoryStore
-	jwtManager *jwt.Manager
+	tokenStore  store.TokenStore
+	revocations *store.RevocationList
+	jwtManager  *jwt.Manager
 }
 
 // NewAuthServer creates a new instance of the AuthServer.
-func NewAuthServer(tokenStore *store.InMemoryStore, jwtManager *jwt.Manager) *AuthServer {
+func NewAuthServer(tokenStore store.TokenStore, revocations *store.RevocationList, jwtManager *jwt.Manager) *AuthServer {
 	return &AuthServer{
-		tokenStore: tokenStore,
-		jwtManager: jwtManager,
+		tokenStore:  tokenStore,
+		revocations: revocations,
+		jwtManager:  jwtManager,
 	}
 }
 
@@ -51,7 +54,9 @@ func (s *AuthServer) Generate(ctx context.Context, req *pb.GenerateRequest) (*pb
 	if err != nil {
 		log.Printf("Error generating user_token: %v", err)
 		// Important: If user_token generation fails, consider cleaning up the recently stored tokens
-		s.tokenStore.Delete(storedID)
+		if delErr := s.tokenStore.Delete(storedID); delErr != nil {
+			log.Printf("Error cleaning up tokens for ID %s: %v", storedID, delErr)
+		}
 		// return nil, status.Errorf(codes.Internal, "Failed to generate user token: %v", err)
 		return &pb.GenerateResponse{UserToken: ""}, err
 	}
@@ -84,6 +89,12 @@ func (s *AuthServer) Verify(ctx context.Context, req *pb.TokenActionRequest) (*p
 		return failureResponse, nil // Return the specified failure structure
 	}
 
+	// A logged out user_token keeps a valid signature until it expires, so check the revocation list
+	if s.revocations.IsRevoked(claims.JTI) {
+		log.Printf("User_token with revoked JTI %s presented for verification", claims.JTI)
+		return failureResponse, nil
+	}
+
 	// If verification is successful, retrieve the original tokens from the store
 	storedTokenSet, err := s.tokenStore.Get(claims.JTI)
 	if err != nil {
@@ -103,3 +114,67 @@ func (s *AuthServer) Verify(ctx context.Context, req *pb.TokenActionRequest) (*p
 		},
 	}, nil
 }
+
+// Logout handles the Logout RPC call.
+// It deletes the stored Angel One tokens for the user_token and revokes its JTI.
+func (s *AuthServer) Logout(ctx context.Context, req *pb.TokenActionRequest) (*pb.LogoutResponse, error) {
+	log.Printf("Received Logout request for user_token: %.10s...", req.UserToken)
+
+	if req.UserToken == "" {
+		log.Println("Error: Empty user_token in LogoutRequest")
+		return &pb.LogoutResponse{Success: false, Message: "user_token cannot be empty"}, nil
+	}
+
+	claims, err := s.jwtManager.Verify(req.UserToken)
+	if err != nil {
+		log.Printf("User_token verification failed during logout: %v", err)
+		return &pb.LogoutResponse{Success: false, Message: "Invalid user_token"}, nil
+	}
+
+	if err := s.tokenStore.Delete(claims.JTI); err != nil {
+		log.Printf("Error deleting stored tokens for JTI %s: %v", claims.JTI, err)
+		return &pb.LogoutResponse{Success: false, Message: "Failed to delete session data"}, nil
+	}
+
+	// Keep the JTI revoked until the user_token would have expired on its own
+	expiresAt := time.Now()
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+	if err := s.revocations.Revoke(claims.JTI, expiresAt); err != nil {
+		// Revoked in memory and the session data is gone; only a restart would forget the JTI
+		log.Printf("Error persisting revocation of JTI %s: %v", claims.JTI, err)
+	}
+
+	log.Printf("Session revoked. JTI: %s", claims.JTI)
+	return &pb.LogoutResponse{Success: true, Message: "Session revoked"}, nil
+}
+
+// Refresh handles the Refresh RPC call.
+// It replaces the stored Angel One tokens of a live session with freshly generated ones.
+func (s *AuthServer) Refresh(ctx context.Context, req *pb.RefreshRequest) (*pb.RefreshResponse, error) {
+	log.Printf("Received Refresh request for user_token: %.10s...", req.UserToken)
+
+	if req.UserToken == "" || req.JwtToken == "" || req.FeedToken == "" {
+		log.Println("Error: Missing user_token or new tokens in RefreshRequest")
+		return &pb.RefreshResponse{Success: false, Message: "user_token, jwt_token and feed_token are required"}, nil
+	}
+
+	claims, err := s.jwtManager.Verify(req.UserToken)
+	if err != nil {
+		log.Printf("User_token verification failed during refresh: %v", err)
+		return &pb.RefreshResponse{Success: false, Message: "Invalid user_token"}, nil
+	}
+	if s.revocations.IsRevoked(claims.JTI) {
+		log.Printf("Refresh attempted for revoked JTI %s", claims.JTI)
+		return &pb.RefreshResponse{Success: false, Message: "Session has been logged out"}, nil
+	}
+
+	if err := s.tokenStore.Update(claims.JTI, req.JwtToken, req.FeedToken, req.RefreshToken); err != nil {
+		log.Printf("Failed to update stored tokens for JTI %s: %v", claims.JTI, err)
+		return &pb.RefreshResponse{Success: false, Message: "Session data not found"}, nil
+	}
+
+	log.Printf("Angel One tokens refreshed for JTI: %s", claims.JTI)
+	return &pb.RefreshResponse{Success: true, Message: "Session refreshed"}, nil
+}
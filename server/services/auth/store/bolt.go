@@ -0,0 +1,276 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	tokenSetsBucket = []byte("token_sets")
+	revokedBucket   = []byte("revoked_jtis")
+	metaBucket      = []byte("meta")
+	saltKey         = []byte("salt")
+)
+
+// BoltStore keeps token sets in a BoltDB file so sessions survive restarts.
+// Every record is encrypted with a key derived from the configured secret and
+// a random salt kept in the file. Revoked JTIs are kept in the same file, in
+// the clear, so logouts survive restarts too.
+//
+// BoltDB holds an exclusive lock on the file, so a BoltStore serves a single
+// Auth replica; replicas cannot share it.
+type BoltStore struct {
+	db     *bolt.DB
+	cipher *Cipher
+	ttl    time.Duration
+}
+
+// NewBoltStore opens (or creates) the BoltDB file at path and encrypts its
+// entries with a key derived from encryptionKey; entries expire after ttl.
+// Records written under another key or salt can no longer be decrypted and
+// are removed by Expire.
+func NewBoltStore(path, encryptionKey string, ttl time.Duration) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening token store %s: %w", path, err)
+	}
+
+	var salt []byte
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(tokenSetsBucket); err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucketIfNotExists(revokedBucket); err != nil {
+			return err
+		}
+		meta, err := tx.CreateBucketIfNotExists(metaBucket)
+		if err != nil {
+			return err
+		}
+		if stored := meta.Get(saltKey); stored != nil {
+			salt = append([]byte(nil), stored...)
+			return nil
+		}
+		if salt, err = newSalt(); err != nil {
+			return err
+		}
+		return meta.Put(saltKey, salt)
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating token store buckets: %w", err)
+	}
+
+	cipher, err := NewCipher(encryptionKey, salt)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltStore{db: db, cipher: cipher, ttl: ttl}, nil
+}
+
+func (s *BoltStore) encode(tokenSet StoredTokenSet) ([]byte, error) {
+	plaintext, err := json.Marshal(tokenSet)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling token set: %w", err)
+	}
+	return s.cipher.Seal(plaintext, []byte(tokenSet.ID))
+}
+
+func (s *BoltStore) decode(id string, data []byte) (StoredTokenSet, error) {
+	plaintext, err := s.cipher.Open(data, []byte(id))
+	if err != nil {
+		return StoredTokenSet{}, err
+	}
+	var tokenSet StoredTokenSet
+	if err := json.Unmarshal(plaintext, &tokenSet); err != nil {
+		return StoredTokenSet{}, fmt.Errorf("unmarshalling token set: %w", err)
+	}
+	if tokenSet.ExpiresAt.IsZero() { // Written before entries carried their own expiry
+		tokenSet.ExpiresAt = tokenSet.CreatedAt.Add(s.ttl)
+	}
+	return tokenSet, nil
+}
+
+func (s *BoltStore) put(tx *bolt.Tx, tokenSet StoredTokenSet) error {
+	data, err := s.encode(tokenSet)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(tokenSetsBucket).Put([]byte(tokenSet.ID), data)
+}
+
+// Store saves the three tokens and returns a unique ID for them.
+func (s *BoltStore) Store(jwtToken, feedToken, refreshToken string) (string, error) {
+	id, err := generateRandomID(16) // 16 bytes -> 32 hex characters
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	tokenSet := StoredTokenSet{
+		ID:           id,
+		JWTToken:     jwtToken,
+		FeedToken:    feedToken,
+		RefreshToken: refreshToken,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(s.ttl),
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return s.put(tx, tokenSet)
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Get retrieves the stored token set by the internal ID.
+func (s *BoltStore) Get(id string) (StoredTokenSet, error) {
+	var tokenSet StoredTokenSet
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(tokenSetsBucket).Get([]byte(id))
+		if data == nil {
+			return ErrTokenNotFound
+		}
+		var err error
+		tokenSet, err = s.decode(id, data)
+		if err == nil && tokenSet.expired(time.Now()) {
+			return ErrTokenNotFound
+		}
+		return err
+	})
+	return tokenSet, err
+}
+
+// Update replaces the Angel One tokens of an existing token set, keeping its ID.
+func (s *BoltStore) Update(id, jwtToken, feedToken, refreshToken string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		data := tx.Bucket(tokenSetsBucket).Get([]byte(id))
+		if data == nil {
+			return ErrTokenNotFound
+		}
+		tokenSet, err := s.decode(id, data)
+		if err != nil {
+			return err
+		}
+		if tokenSet.expired(time.Now()) {
+			return ErrTokenNotFound
+		}
+
+		tokenSet.JWTToken = jwtToken
+		tokenSet.FeedToken = feedToken
+		if refreshToken != "" { // Angel One may not rotate the refresh token
+			tokenSet.RefreshToken = refreshToken
+		}
+		return s.put(tx, tokenSet)
+	})
+}
+
+// Delete removes the stored token set, e.g. when the user logs out.
+func (s *BoltStore) Delete(id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(tokenSetsBucket).Delete([]byte(id))
+	})
+}
+
+// List returns all stored token sets. Records that fail to decrypt are skipped.
+func (s *BoltStore) List() ([]StoredTokenSet, error) {
+	var tokenSets []StoredTokenSet
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(tokenSetsBucket).ForEach(func(k, v []byte) error {
+			tokenSet, err := s.decode(string(k), v)
+			if err != nil {
+				return nil // Written with another key or corrupted; Expire cleans these up
+			}
+			tokenSets = append(tokenSets, tokenSet)
+			return nil
+		})
+	})
+	return tokenSets, err
+}
+
+// Count returns how many token sets are stored. Records are not decrypted,
+// so ones Expire has yet to remove are counted too.
+func (s *BoltStore) Count() (int, error) {
+	count := 0
+	err := s.db.View(func(tx *bolt.Tx) error {
+		count = tx.Bucket(tokenSetsBucket).Stats().KeyN
+		return nil
+	})
+	return count, err
+}
+
+// Expire removes token sets that expired before now and returns how many were removed.
+// Records that can no longer be decrypted (e.g. after a key change) are removed as well.
+func (s *BoltStore) Expire(now time.Time) (int, error) {
+	removed := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(tokenSetsBucket)
+		var expiredIDs [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			tokenSet, err := s.decode(string(k), v)
+			if err != nil || tokenSet.expired(now) {
+				expiredIDs = append(expiredIDs, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, id := range expiredIDs { // Deleting inside ForEach is not allowed by BoltDB
+			if err := bucket.Delete(id); err != nil {
+				return err
+			}
+		}
+		removed = len(expiredIDs)
+		return nil
+	})
+	return removed, err
+}
+
+// SaveRevocation records the JTI as revoked until expiresAt.
+func (s *BoltStore) SaveRevocation(jti string, expiresAt time.Time) error {
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, uint64(expiresAt.UnixNano()))
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(revokedBucket).Put([]byte(jti), value)
+	})
+}
+
+// Revocations returns every revoked JTI with the expiry of its user_token.
+func (s *BoltStore) Revocations() (map[string]time.Time, error) {
+	revoked := make(map[string]time.Time)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(revokedBucket).ForEach(func(k, v []byte) error {
+			if len(v) != 8 {
+				return fmt.Errorf("revoked JTI %s has a malformed expiry", k)
+			}
+			revoked[string(k)] = time.Unix(0, int64(binary.BigEndian.Uint64(v)))
+			return nil
+		})
+	})
+	return revoked, err
+}
+
+// DeleteRevocations removes the JTIs, e.g. once their user_tokens expired.
+func (s *BoltStore) DeleteRevocations(jtis []string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(revokedBucket)
+		for _, jti := range jtis {
+			if err := bucket.Delete([]byte(jti)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Close closes the underlying BoltDB file.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,171 @@
+package store
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+func openBolt(t *testing.T, path, key string, ttl time.Duration) *BoltStore {
+	t.Helper()
+	s, err := NewBoltStore(path, key, ttl)
+	if err != nil {
+		t.Fatalf("NewBoltStore() error = %v", err)
+	}
+	return s
+}
+
+func TestBoltStoreRoundTrip(t *testing.T) {
+	s := openBolt(t, filepath.Join(t.TempDir(), "sessions.db"), "key", time.Hour)
+	defer s.Close()
+
+	id, err := s.Store("jwt", "feed", "refresh")
+	if err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+	got, err := s.Get(id)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.ID != id || got.JWTToken != "jwt" || got.FeedToken != "feed" || got.RefreshToken != "refresh" {
+		t.Errorf("Get() = %+v", got)
+	}
+
+	if err := s.Update(id, "jwt2", "feed2", ""); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	got, _ = s.Get(id)
+	if got.JWTToken != "jwt2" || got.FeedToken != "feed2" || got.RefreshToken != "refresh" {
+		t.Errorf("after Update() = %+v, want the refresh token kept", got)
+	}
+
+	if err := s.Delete(id); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := s.Get(id); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("Get() after Delete() error = %v, want ErrTokenNotFound", err)
+	}
+	if err := s.Update(id, "jwt", "feed", ""); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("Update() after Delete() error = %v, want ErrTokenNotFound", err)
+	}
+}
+
+func TestBoltStoreEncryptsAtRest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.db")
+	s := openBolt(t, path, "key", time.Hour)
+	id, _ := s.Store("secret-jwt", "feed", "refresh")
+
+	// A record moved under another ID fails its additional data check.
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(tokenSetsBucket)
+		return bucket.Put([]byte("copied"), bucket.Get([]byte(id)))
+	})
+	if err != nil {
+		t.Fatalf("copying record: %v", err)
+	}
+	if _, err := s.Get("copied"); err == nil || errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("Get() of a copied record error = %v, want a decryption error", err)
+	}
+	s.Close()
+
+	// Reopening with another key leaves the records unreadable; Expire removes them.
+	other := openBolt(t, path, "other key", time.Hour)
+	defer other.Close()
+	if _, err := other.Get(id); err == nil {
+		t.Errorf("Get() with another key succeeded")
+	}
+	if list, _ := other.List(); len(list) != 0 {
+		t.Errorf("List() with another key = %v, want undecryptable records skipped", list)
+	}
+	removed, err := other.Expire(time.Now())
+	if err != nil || removed != 2 {
+		t.Errorf("Expire() = %d, %v, want both records removed", removed, err)
+	}
+}
+
+func TestBoltStoreReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.db")
+	s := openBolt(t, path, "key", time.Hour)
+	id, _ := s.Store("jwt", "feed", "refresh")
+	if err := s.SaveRevocation("revoked-jti", time.Now().Add(time.Hour).Round(0)); err != nil {
+		t.Fatalf("SaveRevocation() error = %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	reopened := openBolt(t, path, "key", time.Hour)
+	defer reopened.Close()
+	got, err := reopened.Get(id)
+	if err != nil || got.JWTToken != "jwt" {
+		t.Errorf("Get() after reopening = %+v, %v", got, err)
+	}
+	if count, _ := reopened.Count(); count != 1 {
+		t.Errorf("Count() after reopening = %d, want 1", count)
+	}
+	revoked, err := reopened.Revocations()
+	if _, ok := revoked["revoked-jti"]; err != nil || !ok {
+		t.Errorf("Revocations() after reopening = %v, %v", revoked, err)
+	}
+}
+
+func TestBoltStoreLocked(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.db")
+	s := openBolt(t, path, "key", time.Hour)
+	defer s.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		second, err := NewBoltStore(path, "key", time.Hour)
+		if err == nil {
+			second.Close()
+		}
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Errorf("NewBoltStore() on an open file succeeded, want the lock to refuse it")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("NewBoltStore() on an open file did not time out")
+	}
+}
+
+func TestBoltStoreExpire(t *testing.T) {
+	s := openBolt(t, filepath.Join(t.TempDir(), "sessions.db"), "key", time.Hour)
+	defer s.Close()
+
+	old, _ := s.Store("old", "feed", "refresh")
+	now := time.Now()
+	if removed, err := s.Expire(now); err != nil || removed != 0 {
+		t.Fatalf("Expire() before the TTL = %d, %v, want 0", removed, err)
+	}
+
+	later := now.Add(2 * time.Hour)
+	fresh, _ := s.Store("fresh", "feed", "refresh")
+	err := s.db.Update(func(tx *bolt.Tx) error { // Move the fresh one's expiry past later
+		tokenSet, err := s.decode(fresh, tx.Bucket(tokenSetsBucket).Get([]byte(fresh)))
+		if err != nil {
+			return err
+		}
+		tokenSet.ExpiresAt = later.Add(time.Hour)
+		return s.put(tx, tokenSet)
+	})
+	if err != nil {
+		t.Fatalf("extending record: %v", err)
+	}
+
+	if removed, err := s.Expire(later); err != nil || removed != 1 {
+		t.Fatalf("Expire() after the TTL = %d, %v, want 1", removed, err)
+	}
+	if _, err := s.Get(old); !errors.Is(err, ErrTokenNotFound) {
+		t.Errorf("Get() of the expired set error = %v, want ErrTokenNotFound", err)
+	}
+	if _, err := s.Get(fresh); err != nil {
+		t.Errorf("Get() of the live set error = %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// Cipher encrypts token sets at rest with AES-256-GCM.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// saltSize is the length of the random salt stored alongside the encrypted data.
+const saltSize = 32
+
+// keyInfo binds derived keys to their use, so the same secret used elsewhere
+// yields a different key.
+const keyInfo = "angel-two token store v1"
+
+// NewCipher derives a 256-bit key from the configured secret and salt using
+// HKDF-SHA256.
+func NewCipher(secret string, salt []byte) (*Cipher, error) {
+	if secret == "" {
+		return nil, errors.New("encryption key cannot be empty")
+	}
+	if len(salt) < saltSize {
+		return nil, fmt.Errorf("salt must be at least %d bytes", saltSize)
+	}
+	key, err := hkdf.Key(sha256.New, []byte(secret), salt, keyInfo, 32)
+	if err != nil {
+		return nil, fmt.Errorf("deriving key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("creating AES cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("creating GCM: %w", err)
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// newSalt returns a random salt for NewCipher.
+func newSalt() ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generating salt: %w", err)
+	}
+	return salt, nil
+}
+
+// Seal encrypts plaintext and returns nonce||ciphertext.
+// The additional data (e.g. the record key) is authenticated but not encrypted,
+// so a ciphertext copied under another key fails to open.
+func (c *Cipher) Seal(plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+	return c.aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Open decrypts data produced by Seal.
+func (c *Cipher) Open(data, additionalData []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errors.New("ciphertext too short")
+	}
+	plaintext, err := c.aead.Open(nil, data[:nonceSize], data[nonceSize:], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting token set: %w", err)
+	}
+	return plaintext, nil
+}
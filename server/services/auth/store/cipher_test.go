@@ -0,0 +1,107 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+)
+
+func testSalt(b byte) []byte {
+	return bytes.Repeat([]byte{b}, saltSize)
+}
+
+func TestNewCipher(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		salt    []byte
+		wantErr bool
+	}{
+		{name: "valid", secret: "secret", salt: testSalt(1)},
+		{name: "longer salt", secret: "secret", salt: bytes.Repeat([]byte{1}, saltSize+8)},
+		{name: "empty secret", secret: "", salt: testSalt(1), wantErr: true},
+		{name: "short salt", secret: "secret", salt: testSalt(1)[:saltSize-1], wantErr: true},
+		{name: "no salt", secret: "secret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCipher(tt.secret, tt.salt)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCipher() error = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCipherRoundTrip(t *testing.T) {
+	c, err := NewCipher("secret", testSalt(1))
+	if err != nil {
+		t.Fatalf("NewCipher() error = %v", err)
+	}
+	plaintext := []byte(`{"JWTToken":"eyJ..."}`)
+	aad := []byte("session-1")
+
+	sealed, err := c.Seal(plaintext, aad)
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	if bytes.Contains(sealed, plaintext) {
+		t.Errorf("Seal() output contains the plaintext")
+	}
+	again, _ := c.Seal(plaintext, aad)
+	if bytes.Equal(sealed, again) {
+		t.Errorf("Seal() reused a nonce: two seals of the same plaintext are equal")
+	}
+
+	opened, err := c.Open(sealed, aad)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if !bytes.Equal(opened, plaintext) {
+		t.Errorf("Open() = %q, want %q", opened, plaintext)
+	}
+}
+
+func TestCipherOpenRejects(t *testing.T) {
+	c, err := NewCipher("secret", testSalt(1))
+	if err != nil {
+		t.Fatalf("NewCipher() error = %v", err)
+	}
+	aad := []byte("session-1")
+	sealed, err := c.Seal([]byte("tokens"), aad)
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	otherSecret, _ := NewCipher("other secret", testSalt(1))
+	otherSalt, _ := NewCipher("secret", testSalt(2))
+
+	flip := func(i int) []byte {
+		tampered := append([]byte(nil), sealed...)
+		tampered[i] ^= 0x01
+		return tampered
+	}
+
+	tests := []struct {
+		name   string
+		cipher *Cipher
+		data   []byte
+		aad    []byte
+	}{
+		{name: "tampered ciphertext", cipher: c, data: flip(len(sealed) - 1), aad: aad},
+		{name: "tampered nonce", cipher: c, data: flip(0), aad: aad},
+		{name: "wrong additional data", cipher: c, data: sealed, aad: []byte("session-2")},
+		{name: "missing additional data", cipher: c, data: sealed},
+		{name: "another secret", cipher: otherSecret, data: sealed, aad: aad},
+		{name: "another salt", cipher: otherSalt, data: sealed, aad: aad},
+		{name: "too short", cipher: c, data: sealed[:4], aad: aad},
+		{name: "empty", cipher: c, aad: aad},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if plaintext, err := tt.cipher.Open(tt.data, tt.aad); err == nil {
+				t.Errorf("Open() = %q, want an error", plaintext)
+			}
+		})
+	}
+}
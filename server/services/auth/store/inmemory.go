@@ -1,42 +1,25 @@
 package store
 
 import (
-	"crypto/rand"
-	"encoding/hex"
-	"errors"
 	"sync"
 	"time"
 )
 
-var ErrTokenNotFound = errors.New("token data not found")
-
-type StoredTokenSet struct {
-	ID           string
-	JWTToken     string
-	FeedToken    string
-	RefreshToken string
-	CreatedAt    time.Time
-}
-
+// InMemoryStore keeps token sets in process memory; they are lost on restart.
 type InMemoryStore struct {
 	mu     sync.RWMutex
 	tokens map[string]StoredTokenSet // Key: internal generated ID (data for user_token)
+	ttl    time.Duration
 }
 
-func NewInMemoryStore() *InMemoryStore {
+// NewInMemoryStore creates a store whose entries expire after ttl.
+func NewInMemoryStore(ttl time.Duration) *InMemoryStore {
 	return &InMemoryStore{
 		tokens: make(map[string]StoredTokenSet),
+		ttl:    ttl,
 	}
 }
 
-func generateRandomID(length int) (string, error) {
-	bytes := make([]byte, length)
-	if _, err := rand.Read(bytes); err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(bytes), nil
-}
-
 // Store saves the three tokens and returns a unique ID for them.
 func (s *InMemoryStore) Store(jwtToken, feedToken, refreshToken string) (string, error) {
 	s.mu.Lock()
@@ -47,12 +30,14 @@ func (s *InMemoryStore) Store(jwtToken, feedToken, refreshToken string) (string,
 		return "", err
 	}
 
+	now := time.Now()
 	s.tokens[id] = StoredTokenSet{
 		ID:           id,
 		JWTToken:     jwtToken,
 		FeedToken:    feedToken,
 		RefreshToken: refreshToken,
-		CreatedAt:    time.Now(),
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(s.ttl),
 	}
 	return id, nil
 }
@@ -63,15 +48,74 @@ func (s *InMemoryStore) Get(id string) (StoredTokenSet, error) {
 	defer s.mu.RUnlock()
 
 	tokenSet, ok := s.tokens[id]
-	if !ok {
+	if !ok || tokenSet.expired(time.Now()) {
 		return StoredTokenSet{}, ErrTokenNotFound
 	}
 	return tokenSet, nil
 }
 
-// Delete (Optional, if you need logout functionality beyond just token expiry)
-func (s *InMemoryStore) Delete(id string) {
+// Delete removes the stored token set, e.g. when the user logs out.
+func (s *InMemoryStore) Delete(id string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	delete(s.tokens, id)
+	return nil
+}
+
+// Update replaces the Angel One tokens of an existing token set, keeping its ID
+// so that user_tokens issued for it stay valid after a session refresh.
+func (s *InMemoryStore) Update(id, jwtToken, feedToken, refreshToken string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokenSet, ok := s.tokens[id]
+	if !ok || tokenSet.expired(time.Now()) {
+		return ErrTokenNotFound
+	}
+	tokenSet.JWTToken = jwtToken
+	tokenSet.FeedToken = feedToken
+	if refreshToken != "" { // Angel One may not rotate the refresh token
+		tokenSet.RefreshToken = refreshToken
+	}
+	s.tokens[id] = tokenSet
+	return nil
+}
+
+// List returns all stored token sets.
+func (s *InMemoryStore) List() ([]StoredTokenSet, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tokenSets := make([]StoredTokenSet, 0, len(s.tokens))
+	for _, tokenSet := range s.tokens {
+		tokenSets = append(tokenSets, tokenSet)
+	}
+	return tokenSets, nil
+}
+
+// Count returns how many token sets are stored.
+func (s *InMemoryStore) Count() (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.tokens), nil
+}
+
+// Expire removes token sets that expired before now and returns how many were removed.
+func (s *InMemoryStore) Expire(now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed := 0
+	for id, tokenSet := range s.tokens {
+		if tokenSet.expired(now) {
+			delete(s.tokens, id)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// Close is a no-op; it exists to satisfy TokenStore.
+func (s *InMemoryStore) Close() error {
+	return nil
 }
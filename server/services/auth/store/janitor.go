@@ -0,0 +1,97 @@
+package store
+
+import (
+	"expvar"
+	"log"
+	"sync"
+	"time"
+)
+
+// Session metrics, published on /debug/vars when the metrics listener is enabled.
+var (
+	liveSessionsGauge    = expvar.NewInt("auth_live_sessions")
+	revokedJTIsGauge     = expvar.NewInt("auth_revoked_jtis")
+	expiredSessionsTotal = expvar.NewInt("auth_expired_sessions_total")
+)
+
+// Janitor periodically evicts expired token sets and revoked JTIs that can no
+// longer be replayed, and refreshes the session metrics.
+type Janitor struct {
+	store       TokenStore
+	revocations *RevocationList
+	interval    time.Duration
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+func NewJanitor(store TokenStore, revocations *RevocationList, interval time.Duration) *Janitor {
+	return &Janitor{
+		store:       store,
+		revocations: revocations,
+		interval:    interval,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+// Start runs the sweeper in a background goroutine until Stop is called.
+// Calling it more than once has no effect.
+func (j *Janitor) Start() {
+	j.startOnce.Do(j.run)
+}
+
+func (j *Janitor) run() {
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		j.sweep()
+		for {
+			select {
+			case <-ticker.C:
+				j.sweep()
+			case <-j.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop signals the sweeper to exit and waits for it to finish. It returns at
+// once if Start was never called, and Start does nothing afterwards.
+func (j *Janitor) Stop() {
+	j.stopOnce.Do(func() { close(j.stop) })
+	j.startOnce.Do(func() { close(j.done) }) // Never started: nothing to wait for
+	<-j.done
+}
+
+func (j *Janitor) sweep() {
+	now := time.Now()
+
+	removed, err := j.store.Expire(now)
+	if err != nil {
+		log.Printf("Janitor: Failed to expire sessions: %v", err)
+	} else if removed > 0 {
+		log.Printf("Janitor: Expired %d sessions", removed)
+		expiredSessionsTotal.Add(int64(removed))
+	}
+
+	if pruned, err := j.revocations.Prune(now); err != nil {
+		log.Printf("Janitor: Failed to prune revoked JTIs: %v", err)
+	} else if pruned > 0 {
+		log.Printf("Janitor: Pruned %d revoked JTIs past their expiry", pruned)
+	}
+
+	live, err := j.store.Count()
+	if err != nil {
+		log.Printf("Janitor: Failed to count live sessions: %v", err)
+		return
+	}
+	liveSessionsGauge.Set(int64(live))
+	revokedJTIsGauge.Set(int64(j.revocations.Len()))
+}
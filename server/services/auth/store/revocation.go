@@ -0,0 +1,102 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RevocationStore persists revoked JTIs so a restart does not forget them.
+type RevocationStore interface {
+	// SaveRevocation records the JTI as revoked until expiresAt.
+	SaveRevocation(jti string, expiresAt time.Time) error
+	// Revocations returns every recorded JTI with its expiry.
+	Revocations() (map[string]time.Time, error)
+	// DeleteRevocations removes the JTIs.
+	DeleteRevocations(jtis []string) error
+}
+
+// RevocationList keeps the JTIs of user_tokens that were explicitly logged out.
+// A revoked JTI stays on the list until the user_token itself would have expired,
+// so a copied cookie cannot be replayed in the meantime. Lookups are served from
+// memory; with a RevocationStore every change is written through to it.
+type RevocationList struct {
+	persist RevocationStore // nil keeps revocations in memory only
+
+	mu      sync.RWMutex
+	revoked map[string]time.Time // Key: JTI, Value: ExpiresAt of the revoked user_token
+}
+
+// NewRevocationList loads the JTIs already revoked in persist, which may be nil.
+func NewRevocationList(persist RevocationStore) (*RevocationList, error) {
+	r := &RevocationList{
+		persist: persist,
+		revoked: make(map[string]time.Time),
+	}
+	if persist != nil {
+		revoked, err := persist.Revocations()
+		if err != nil {
+			return nil, fmt.Errorf("loading revoked JTIs: %w", err)
+		}
+		r.revoked = revoked
+	}
+	return r, nil
+}
+
+// Revoke adds the JTI to the list until expiresAt. The JTI is revoked in
+// memory even when persisting it fails. Expired entries are left for Prune,
+// which the Janitor calls on its interval.
+func (r *RevocationList) Revoke(jti string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.revoked[jti] = expiresAt
+	if r.persist != nil {
+		return r.persist.SaveRevocation(jti, expiresAt)
+	}
+	return nil
+}
+
+// IsRevoked reports whether the JTI has been revoked.
+func (r *RevocationList) IsRevoked(jti string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.revoked[jti]
+	return ok
+}
+
+// Prune removes entries whose user_token has already expired and returns how many were removed.
+func (r *RevocationList) Prune(now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pruned := r.pruneLocked(now)
+	return len(pruned), r.deleteLocked(pruned)
+}
+
+func (r *RevocationList) pruneLocked(now time.Time) []string {
+	var pruned []string
+	for jti, expiresAt := range r.revoked {
+		if now.After(expiresAt) {
+			delete(r.revoked, jti)
+			pruned = append(pruned, jti)
+		}
+	}
+	return pruned
+}
+
+// deleteLocked removes pruned JTIs from the RevocationStore, if any.
+func (r *RevocationList) deleteLocked(jtis []string) error {
+	if r.persist == nil || len(jtis) == 0 {
+		return nil
+	}
+	return r.persist.DeleteRevocations(jtis)
+}
+
+// Len returns the number of revoked JTIs still being tracked.
+func (r *RevocationList) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.revoked)
+}
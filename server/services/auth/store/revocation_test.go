@@ -0,0 +1,63 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRevocationList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.db")
+	s := openBolt(t, path, "key", time.Hour)
+
+	now := time.Now()
+	tests := []struct {
+		name    string
+		persist RevocationStore
+	}{
+		{name: "in memory"},
+		{name: "bolt", persist: s},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRevocationList(tt.persist)
+			if err != nil {
+				t.Fatalf("NewRevocationList() error = %v", err)
+			}
+			if err := r.Revoke("live", now.Add(time.Hour)); err != nil {
+				t.Fatalf("Revoke() error = %v", err)
+			}
+			if err := r.Revoke("short", now.Add(time.Minute)); err != nil {
+				t.Fatalf("Revoke() error = %v", err)
+			}
+			if err := r.Revoke("expired", now.Add(-time.Minute)); err != nil {
+				t.Fatalf("Revoke() error = %v", err)
+			}
+			if !r.IsRevoked("live") || !r.IsRevoked("short") || r.IsRevoked("other") {
+				t.Errorf("IsRevoked() does not match the revoked JTIs")
+			}
+			if r.Len() != 3 {
+				t.Errorf("Len() = %d, want 3: Revoke leaves pruning to the janitor", r.Len())
+			}
+
+			if pruned, err := r.Prune(now.Add(10 * time.Minute)); err != nil || pruned != 2 {
+				t.Errorf("Prune() = %d, %v, want 2", pruned, err)
+			}
+			if r.IsRevoked("short") || !r.IsRevoked("live") || r.Len() != 1 {
+				t.Errorf("after Prune() short revoked %t, live revoked %t, len %d", r.IsRevoked("short"), r.IsRevoked("live"), r.Len())
+			}
+		})
+	}
+	s.Close()
+
+	// Revocations persisted in the bolt file outlive the process.
+	reopened := openBolt(t, path, "key", time.Hour)
+	defer reopened.Close()
+	r, err := NewRevocationList(reopened)
+	if err != nil {
+		t.Fatalf("NewRevocationList() after reopening error = %v", err)
+	}
+	if !r.IsRevoked("live") || r.IsRevoked("short") || r.Len() != 1 {
+		t.Errorf("after reopening live revoked %t, short revoked %t, len %d, want only live", r.IsRevoked("live"), r.IsRevoked("short"), r.Len())
+	}
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+var ErrTokenNotFound = errors.New("token data not found")
+
+type StoredTokenSet struct {
+	ID           string
+	JWTToken     string
+	FeedToken    string
+	RefreshToken string
+	CreatedAt    time.Time
+	ExpiresAt    time.Time // Same lifetime as the user_token issued for this set
+}
+
+// expired reports whether the token set can no longer be used at the given time.
+func (t StoredTokenSet) expired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && now.After(t.ExpiresAt)
+}
+
+// TokenStore persists the Angel One token sets behind each user_token.
+// The ID returned by Store becomes the JTI of the user_token.
+type TokenStore interface {
+	// Store saves the three tokens and returns a unique ID for them.
+	Store(jwtToken, feedToken, refreshToken string) (string, error)
+	// Get retrieves the stored token set by its ID; expired sets are reported as ErrTokenNotFound.
+	Get(id string) (StoredTokenSet, error)
+	// Update replaces the tokens of an existing token set, keeping its ID.
+	Update(id, jwtToken, feedToken, refreshToken string) error
+	// Delete removes the token set; deleting an unknown ID is not an error.
+	Delete(id string) error
+	// List returns all stored token sets.
+	List() ([]StoredTokenSet, error)
+	// Count returns how many token sets are stored, without decrypting them.
+	Count() (int, error)
+	// Expire removes token sets that expired before now and returns how many were removed.
+	Expire(now time.Time) (int, error)
+	// Close releases any resources held by the store.
+	Close() error
+}
+
+var (
+	_ TokenStore = (*InMemoryStore)(nil)
+	_ TokenStore = (*BoltStore)(nil)
+
+	_ RevocationStore = (*BoltStore)(nil)
+)
+
+func generateRandomID(length int) (string, error) {
+	bytes := make([]byte, length)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
@@ -0,0 +1,95 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicHost is returned by CheckHost when a webhook host does not
+// resolve, or resolves to an address webhooks may not be delivered to.
+var ErrNonPublicHost = errors.New("webhook host is not public")
+
+// nonPublicPrefixes are special-purpose IPv4 ranges that the netip predicates
+// below do not cover: "this network", carrier-grade NAT, IETF protocol
+// assignments and the benchmarking range.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+}
+
+// PublicAddress reports whether webhooks may be delivered to ip. Loopback,
+// private, shared (CGNAT), link-local, unspecified and other special-purpose
+// addresses are refused, so a webhook cannot be used to reach the broker host
+// or its network.
+func PublicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() ||
+		ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsUnspecified() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckHost resolves a webhook host and returns an error unless every address
+// it resolves to is public.
+func CheckHost(ctx context.Context, host string) error {
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("%w: resolving %s: %v", ErrNonPublicHost, host, err)
+	}
+	for _, addr := range addrs {
+		if !PublicAddress(addr) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrNonPublicHost, host, addr.Unmap())
+		}
+	}
+	return nil
+}
+
+// newWebhookClient returns the client deliveries are POSTed with. The address
+// is checked again on every dial, as DNS may have changed since the webhook
+// was created, and redirects are not followed: a 3xx is a failed attempt.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !PublicAddress(addrPort.Addr()) {
+				return fmt.Errorf("refusing to deliver to %s, which is not a public address", addrPort.Addr().Unmap())
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,425 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/url"
+	"sync"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+	"github.com/Sagar-v4/Angel-Two/services/broker/sessions"
+	"github.com/Sagar-v4/Angel-Two/services/broker/stream"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const DefaultPollInterval = 10 * time.Second
+
+// errUnchanged tells Store.UpdateAlert to leave an alert as it is.
+var errUnchanged = errors.New("unchanged")
+
+// Monitor evaluates alerts every interval, against GetLTP for price alerts
+// and GetFullQuote (whose percent change is from the previous close) for
+// percent alerts. An alert that holds moves to PRICE_ALERT_TRIGGERED and its
+// notifications are queued for the Notifier. A repeating alert is re-armed
+// once its condition stops holding, so it notifies once per crossing. A
+// client's alerts are evaluated while the session registry holds a session
+// for the client.
+type Monitor struct {
+	store    *Store
+	sessions *sessions.Registry
+	notifier *Notifier
+	interval time.Duration
+
+	mu     sync.Mutex
+	prices map[stream.Instrument]float64 // Latest LTP of the watched instruments
+
+	wake chan struct{}
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+type quote struct {
+	ltp           float64
+	percentChange float64
+	full          bool // percentChange is known
+}
+
+func NewMonitor(store *Store, registry *sessions.Registry, notifier *Notifier, interval time.Duration) *Monitor {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	m := &Monitor{
+		store:    store,
+		sessions: registry,
+		notifier: notifier,
+		interval: interval,
+		prices:   make(map[stream.Instrument]float64),
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+	}
+	registry.Watch(m.notify)
+	return m
+}
+
+// Start begins evaluating alerts and sending notifications in the background.
+func (m *Monitor) Start() {
+	m.notifier.Start()
+	m.wg.Add(1)
+	go m.run()
+}
+
+func (m *Monitor) Stop() {
+	close(m.stop)
+	m.wg.Wait()
+	m.notifier.Stop()
+}
+
+// Create stores a new alert for its client.
+func (m *Monitor) Create(alert *pb.PriceAlert) error {
+	if err := m.store.CreateAlert(alert, time.Now()); err != nil {
+		return err
+	}
+	m.notify()
+	return nil
+}
+
+// Get returns the client's alert with the given id, or ErrNotFound.
+func (m *Monitor) Get(clientCode, id string) (*pb.PriceAlert, error) {
+	alert, err := m.store.GetAlert(id)
+	if err != nil {
+		return nil, err
+	}
+	if alert.ClientCode != clientCode {
+		return nil, ErrNotFound
+	}
+	m.withLiveState(alert)
+	return alert, nil
+}
+
+// List returns the client's alerts, newest first.
+func (m *Monitor) List(clientCode string) ([]*pb.PriceAlert, error) {
+	alerts, err := m.store.ListAlerts(func(alert *pb.PriceAlert) bool {
+		return alert.ClientCode == clientCode
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, alert := range alerts {
+		m.withLiveState(alert)
+	}
+	return alerts, nil
+}
+
+// Watching reports whether any of the client's alerts is still watched, so
+// the client's session is needed.
+func (m *Monitor) Watching(clientCode string) (bool, error) {
+	alerts, err := m.store.ListAlerts(func(alert *pb.PriceAlert) bool {
+		return alert.ClientCode == clientCode && watched(alert)
+	})
+	return len(alerts) > 0, err
+}
+
+// Update applies change to the client's alert.
+func (m *Monitor) Update(clientCode, id string, change func(*pb.PriceAlert)) (*pb.PriceAlert, error) {
+	alert, err := m.store.UpdateAlert(id, func(alert *pb.PriceAlert) error {
+		if alert.ClientCode != clientCode {
+			return ErrNotFound
+		}
+		change(alert)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.notify()
+	m.withLiveState(alert)
+	return alert, nil
+}
+
+// Delete removes the client's alert.
+func (m *Monitor) Delete(clientCode, id string) error {
+	if _, err := m.Get(clientCode, id); err != nil {
+		return err
+	}
+	return m.store.DeleteAlert(id)
+}
+
+// CreateWebhook registers a webhook for the client once its host is checked
+// with CheckHost. The returned webhook holds its signing secret, which is
+// never returned again.
+func (m *Monitor) CreateWebhook(ctx context.Context, clientCode, rawURL string) (*pb.AlertWebhook, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckHost(ctx, u.Hostname()); err != nil {
+		return nil, err
+	}
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+	webhook := &pb.AlertWebhook{ClientCode: clientCode, Url: rawURL, Secret: secret}
+	if err := m.store.CreateWebhook(webhook, time.Now()); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// ListWebhooks returns the client's webhooks without their secrets.
+func (m *Monitor) ListWebhooks(clientCode string) ([]*pb.AlertWebhook, error) {
+	webhooks, err := m.store.ListWebhooks(clientCode)
+	if err != nil {
+		return nil, err
+	}
+	for _, webhook := range webhooks {
+		webhook.Secret = ""
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook removes the client's webhook; deliveries still queued for it
+// fail.
+func (m *Monitor) DeleteWebhook(clientCode, id string) error {
+	webhook, err := m.store.GetWebhook(id)
+	if err != nil {
+		return err
+	}
+	if webhook.ClientCode != clientCode {
+		return ErrNotFound
+	}
+	return m.store.DeleteWebhook(id)
+}
+
+// Deliveries returns up to limit of the client's deliveries, newest first,
+// only those of alertID when it is given.
+func (m *Monitor) Deliveries(clientCode, alertID string, limit int) ([]*pb.WebhookDelivery, error) {
+	return m.store.ListDeliveries(func(d *pb.WebhookDelivery) bool {
+		return d.ClientCode == clientCode && (alertID == "" || d.AlertId == alertID)
+	}, limit)
+}
+
+// withLiveState fills in the fields of an alert that are not persisted: the
+// latest price and whether the alert waits for a session.
+func (m *Monitor) withLiveState(alert *pb.PriceAlert) {
+	_, hasSession := m.sessions.Get(alert.ClientCode)
+	alert.WaitingForSession = watched(alert) && !hasSession
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	alert.LastPrice = m.prices[stream.Instrument{Exchange: alert.Exchange, Symboltoken: alert.Symboltoken}]
+}
+
+func (m *Monitor) notify() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Monitor) run() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		m.poll()
+		select {
+		case <-ticker.C:
+		case <-m.wake:
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+// watched reports whether the alert needs quotes: active alerts to trigger
+// them and triggered repeating alerts to re-arm them.
+func watched(alert *pb.PriceAlert) bool {
+	return alert.State == pb.PriceAlertState_PRICE_ALERT_ACTIVE ||
+		alert.State == pb.PriceAlertState_PRICE_ALERT_TRIGGERED && alert.Repeat
+}
+
+// poll fetches the quotes of every watched alert whose client has a session
+// and triggers or re-arms the alerts.
+func (m *Monitor) poll() {
+	alerts, err := m.store.ListAlerts(watched)
+	if err != nil {
+		log.Printf("Broker Service: Failed to load price alerts: %v", err)
+		return
+	}
+	byClient := make(map[string][]*pb.PriceAlert)
+	for _, alert := range alerts {
+		byClient[alert.ClientCode] = append(byClient[alert.ClientCode], alert)
+	}
+
+	prices := make(map[stream.Instrument]float64)
+	for code, list := range byClient {
+		sess, ok := m.sessions.Get(code)
+		if !ok {
+			continue
+		}
+
+		quotes := m.fetchQuotes(sess, list)
+		now := time.Now()
+		for _, alert := range list {
+			inst := stream.Instrument{Exchange: alert.Exchange, Symboltoken: alert.Symboltoken}
+			q, ok := quotes[inst]
+			if !ok {
+				continue
+			}
+			prices[inst] = q.ltp
+			met := holds(alert.Condition, q)
+			switch {
+			case alert.State == pb.PriceAlertState_PRICE_ALERT_ACTIVE && met:
+				m.trigger(alert, q, now)
+			case alert.State == pb.PriceAlertState_PRICE_ALERT_TRIGGERED && !met:
+				m.rearm(alert.Id)
+			}
+		}
+	}
+
+	m.mu.Lock()
+	m.prices = prices
+	m.mu.Unlock()
+}
+
+// holds reports whether the condition holds for the quote.
+func holds(cond *pb.AlertCondition, q quote) bool {
+	if cond == nil {
+		return false
+	}
+	switch cond.Type {
+	case pb.AlertConditionType_PRICE_ABOVE:
+		return q.ltp >= cond.Value
+	case pb.AlertConditionType_PRICE_BELOW:
+		return q.ltp <= cond.Value
+	case pb.AlertConditionType_PERCENT_CHANGE_ABOVE:
+		return q.full && q.percentChange >= cond.Value
+	case pb.AlertConditionType_PERCENT_CHANGE_BELOW:
+		return q.full && q.percentChange <= cond.Value
+	}
+	return false
+}
+
+func isPercent(cond *pb.AlertCondition) bool {
+	return cond != nil && (cond.Type == pb.AlertConditionType_PERCENT_CHANGE_ABOVE || cond.Type == pb.AlertConditionType_PERCENT_CHANGE_BELOW)
+}
+
+// trigger marks the alert as triggered and wakes the notifier for the
+// deliveries it queued.
+func (m *Monitor) trigger(alert *pb.PriceAlert, q quote, now time.Time) {
+	triggered, deliveries, err := m.store.Trigger(alert.Id, q.ltp, now,
+		func(current *pb.PriceAlert) bool { return holds(current.Condition, q) },
+		func(current *pb.PriceAlert, deliveryID string) ([]byte, error) {
+			return json.Marshal(newNotification(current, deliveryID, q, now))
+		})
+	if errors.Is(err, ErrNotActive) || errors.Is(err, ErrNotFound) {
+		return // Paused, edited or deleted since the poll began
+	} else if err != nil {
+		log.Printf("Broker Service: Failed to trigger price alert %s: %v", alert.Id, err)
+		return
+	}
+	log.Printf("Broker Service: Price alert %s triggered at %.2f; %d notifications queued", triggered.Id, q.ltp, len(deliveries))
+	if len(deliveries) > 0 {
+		m.notifier.Notify()
+	}
+}
+
+// rearm makes a triggered repeating alert active again.
+func (m *Monitor) rearm(id string) {
+	_, err := m.store.UpdateAlert(id, func(alert *pb.PriceAlert) error {
+		if alert.State != pb.PriceAlertState_PRICE_ALERT_TRIGGERED || !alert.Repeat {
+			return errUnchanged
+		}
+		alert.State = pb.PriceAlertState_PRICE_ALERT_ACTIVE
+		return nil
+	})
+	if err != nil && !errors.Is(err, errUnchanged) && !errors.Is(err, ErrNotFound) {
+		log.Printf("Broker Service: Failed to re-arm price alert %s: %v", id, err)
+	}
+}
+
+// fetchQuotes returns the quotes of the alerts' instruments: full quotes for
+// instruments with a percent alert, LTPs for the rest. Instruments Angel One
+// did not quote are missing.
+func (m *Monitor) fetchQuotes(sess sessions.Session, alerts []*pb.PriceAlert) map[stream.Instrument]quote {
+	full := make(map[stream.Instrument]bool)
+	var ordered []stream.Instrument
+	for _, alert := range alerts {
+		inst := stream.Instrument{Exchange: alert.Exchange, Symboltoken: alert.Symboltoken}
+		if _, seen := full[inst]; !seen {
+			ordered = append(ordered, inst)
+		}
+		full[inst] = full[inst] || isPercent(alert.Condition)
+	}
+	var ltpOnly, withChange []stream.Instrument
+	for _, inst := range ordered {
+		if full[inst] {
+			withChange = append(withChange, inst)
+		} else {
+			ltpOnly = append(ltpOnly, inst)
+		}
+	}
+
+	quotes := make(map[stream.Instrument]quote)
+	prices, err := m.sessions.LTP(sess, ltpOnly)
+	for inst, ltp := range prices {
+		quotes[inst] = quote{ltp: ltp}
+	}
+	if err == nil && len(withChange) > 0 {
+		var fulls map[stream.Instrument]*pb.FullQuoteData
+		fulls, err = m.sessions.FullQuotes(sess, withChange)
+		for inst, fq := range fulls {
+			quotes[inst] = quote{ltp: fq.Ltp, percentChange: fq.PercentChange, full: true}
+		}
+	}
+	if err != nil {
+		log.Printf("Broker Service: Failed to fetch quotes for price alerts of client %s: %v", sess.ClientCode, err)
+	}
+	return quotes
+}
+
+// notification is the JSON body POSTed to webhooks when an alert triggers.
+type notification struct {
+	Event         string   `json:"event"`
+	DeliveryID    string   `json:"delivery_id"`
+	AlertID       string   `json:"alert_id"`
+	ClientCode    string   `json:"client_code"`
+	Exchange      string   `json:"exchange"`
+	Tradingsymbol string   `json:"tradingsymbol"`
+	Symboltoken   string   `json:"symboltoken"`
+	Condition     string   `json:"condition"` // e.g. PRICE_ABOVE
+	Value         float64  `json:"value"`
+	Note          string   `json:"note,omitempty"`
+	Ltp           float64  `json:"ltp"`
+	PercentChange *float64 `json:"percent_change,omitempty"` // Set for percent alerts
+	TriggerCount  int32    `json:"trigger_count"`
+	TriggeredAt   string   `json:"triggered_at"` // RFC 3339, IST
+}
+
+func newNotification(alert *pb.PriceAlert, deliveryID string, q quote, now time.Time) notification {
+	n := notification{
+		Event:         EventTriggered,
+		DeliveryID:    deliveryID,
+		AlertID:       alert.Id,
+		ClientCode:    alert.ClientCode,
+		Exchange:      alert.Exchange,
+		Tradingsymbol: alert.Tradingsymbol,
+		Symboltoken:   alert.Symboltoken,
+		Condition:     alert.GetCondition().GetType().String(),
+		Value:         alert.GetCondition().GetValue(),
+		Note:          alert.Note,
+		Ltp:           q.ltp,
+		TriggerCount:  alert.TriggerCount,
+		TriggeredAt:   now.In(angelone.IST).Format(time.RFC3339),
+	}
+	if q.full {
+		n.PercentChange = proto.Float64(q.percentChange)
+	}
+	return n
+}
@@ -0,0 +1,215 @@
+package alerts
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+const (
+	// EventTriggered is the X-Angel-Two-Event of a price alert notification.
+	EventTriggered = "price_alert.triggered"
+
+	notifierInterval = 5 * time.Second
+	pruneInterval    = time.Hour
+	// deliveryRetention is how long finished deliveries stay in the log.
+	deliveryRetention = 7 * 24 * time.Hour
+)
+
+// retryDelays are the waits after each failed attempt; a delivery fails for
+// good once they run out.
+var retryDelays = []time.Duration{30 * time.Second, 2 * time.Minute, 10 * time.Minute, 30 * time.Minute, 2 * time.Hour}
+
+// Notifier POSTs queued deliveries to their webhooks. Each request carries
+//
+//	X-Angel-Two-Event:     price_alert.triggered
+//	X-Angel-Two-Delivery:  the delivery id, the same across retries
+//	X-Angel-Two-Timestamp: unix seconds of this attempt
+//	X-Angel-Two-Signature: sha256=<hex HMAC-SHA256 of "<timestamp>.<body>" keyed by the webhook secret>
+//
+// and any 2xx response counts as delivered.
+type Notifier struct {
+	store      *Store
+	httpClient *http.Client
+
+	wake chan struct{}
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewNotifier(store *Store) *Notifier {
+	return &Notifier{
+		store:      store,
+		httpClient: newWebhookClient(),
+		wake:       make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+	}
+}
+
+// Start begins sending deliveries in the background, including those left
+// pending by a previous run.
+func (n *Notifier) Start() {
+	n.wg.Add(1)
+	go n.run()
+}
+
+func (n *Notifier) Stop() {
+	close(n.stop)
+	n.wg.Wait()
+}
+
+// Notify makes the notifier look for due deliveries now.
+func (n *Notifier) Notify() {
+	select {
+	case n.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (n *Notifier) run() {
+	defer n.wg.Done()
+	ticker := time.NewTicker(notifierInterval)
+	defer ticker.Stop()
+	var pruned time.Time
+	for {
+		now := time.Now()
+		n.deliverDue(now)
+		if now.Sub(pruned) >= pruneInterval {
+			if removed, err := n.store.PruneDeliveries(now.Add(-deliveryRetention)); err != nil {
+				log.Printf("Broker Service: Failed to prune webhook deliveries: %v", err)
+			} else if removed > 0 {
+				log.Printf("Broker Service: Pruned %d old webhook deliveries", removed)
+			}
+			pruned = now
+		}
+		select {
+		case <-ticker.C:
+		case <-n.wake:
+		case <-n.stop:
+			return
+		}
+	}
+}
+
+func (n *Notifier) deliverDue(now time.Time) {
+	due, err := n.store.ListDeliveries(func(d *pb.WebhookDelivery) bool {
+		return d.Status == pb.DeliveryStatus_DELIVERY_PENDING && d.NextAttemptAt <= now.UnixMilli()
+	}, 0)
+	if err != nil {
+		log.Printf("Broker Service: Failed to load webhook deliveries: %v", err)
+		return
+	}
+	for i := len(due) - 1; i >= 0; i-- { // Oldest first
+		select {
+		case <-n.stop:
+			return
+		default:
+		}
+		n.deliver(due[i])
+	}
+}
+
+// deliver makes one attempt at a delivery and records its outcome.
+func (n *Notifier) deliver(delivery *pb.WebhookDelivery) {
+	webhook, err := n.store.GetWebhook(delivery.WebhookId)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			log.Printf("Broker Service: Failed to load webhook %s: %v", delivery.WebhookId, err)
+			return
+		}
+		n.record(delivery.Id, 0, errors.New("webhook was deleted"), false)
+		return
+	}
+
+	code, err := n.post(webhook, delivery, time.Now())
+	n.record(delivery.Id, code, err, true)
+}
+
+func (n *Notifier) post(webhook *pb.AlertWebhook, delivery *pb.WebhookDelivery, now time.Time) (int32, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequest(http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Angel-Two-Alerts")
+	req.Header.Set("X-Angel-Two-Event", EventTriggered)
+	req.Header.Set("X-Angel-Two-Delivery", delivery.Id)
+	req.Header.Set("X-Angel-Two-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Angel-Two-Signature", Sign(webhook.Secret, timestamp, body))
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10)) // Lets the connection be reused
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return int32(resp.StatusCode), fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return int32(resp.StatusCode), nil
+}
+
+// record stores the outcome of an attempt, scheduling the next one when the
+// attempt failed and retry allows it.
+func (n *Notifier) record(id string, code int32, attemptErr error, retry bool) {
+	err := n.store.UpdateDelivery(id, func(d *pb.WebhookDelivery) {
+		now := time.Now()
+		d.Attempts++
+		d.ResponseCode = code
+		d.Error = ""
+		switch {
+		case attemptErr == nil:
+			d.Status = pb.DeliveryStatus_DELIVERY_DELIVERED
+			d.DeliveredAt = now.UnixMilli()
+			d.NextAttemptAt = 0
+		case retry && int(d.Attempts) <= len(retryDelays):
+			d.Error = attemptErr.Error()
+			d.NextAttemptAt = now.Add(retryDelays[d.Attempts-1]).UnixMilli()
+		default:
+			d.Status = pb.DeliveryStatus_DELIVERY_FAILED
+			d.Error = attemptErr.Error()
+			d.NextAttemptAt = 0
+		}
+		if attemptErr != nil {
+			log.Printf("Broker Service: Webhook delivery %s attempt %d failed: %v", d.Id, d.Attempts, attemptErr)
+		}
+	})
+	if err != nil {
+		log.Printf("Broker Service: Failed to record webhook delivery %s: %v", id, err)
+	}
+}
+
+// Sign returns the X-Angel-Two-Signature of body sent at timestamp (unix
+// seconds) to a webhook with the given secret. Receivers should recompute it
+// and compare with hmac.Equal, and reject stale timestamps.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newSecret returns a random webhook signing secret.
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generating webhook secret: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,28 @@
+package alerts
+
+import "testing"
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"alert":"a1"}`)
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      []byte
+		want      string
+	}{
+		{"body", "whsec", 1700000000, body, "sha256=98cae63f0e3b8321e5bd5f089236efdf58350a7b5f43d45d67b3584b135cc714"},
+		{"empty body", "whsec", 1700000000, nil, "sha256=ab5fdf6f7cdf5f7abf2f4d61c6b0376dc6bf75beafc17135e5fd06513ee7afd8"},
+		{"another timestamp", "whsec", 1700000001, body, "sha256=81fc4bb690a1984bb657ba5b4f6252801ee5f8e23b4239131d15bd22473684f2"},
+		{"another secret", "other", 1700000000, body, "sha256=c20863e3cd5da048108de17b145dbfd62ac9c15e4feb38407f66b8f164e07cb6"},
+		{"empty secret", "", 0, nil, "sha256=b849d5a581847b281957065739df36df2463d1977ea8d6e1e4e6cf33fadc68c3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sign(tt.secret, tt.timestamp, tt.body); got != tt.want {
+				t.Errorf("Sign() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,396 @@
+// Package alerts notifies users when an instrument reaches a price or a
+// percent change from the previous close. Alerts, webhooks and the log of
+// webhook deliveries are kept in a BoltDB file; notifications are POSTed to
+// the owner's webhooks, signed with HMAC-SHA256, and retried with backoff.
+package alerts
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	MaxAlertsPerClient   = 200
+	MaxWebhooksPerClient = 5
+)
+
+var (
+	alertsBucket     = []byte("alerts")
+	webhooksBucket   = []byte("webhooks")
+	deliveriesBucket = []byte("deliveries")
+)
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrLimitReached = errors.New("limit reached")
+	// ErrNotActive is returned by Trigger when the alert is no longer active
+	// or its condition no longer holds, e.g. it was paused or edited meanwhile.
+	ErrNotActive = errors.New("alert is not active")
+)
+
+// Store is a BoltDB-backed store of alerts, webhooks and deliveries. Records
+// are keyed by a per-bucket sequence number, which is also their id.
+type Store struct {
+	db *bolt.DB
+}
+
+// Open opens (or creates) the alert file at path. BoltDB holds an exclusive
+// lock on the file, so only one broker process can use it at a time.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening alert store %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{alertsBucket, webhooksBucket, deliveriesBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating alert buckets: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// CreateAlert assigns the alert its id and stores it as active.
+func (s *Store) CreateAlert(alert *pb.PriceAlert, now time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(alertsBucket)
+		if count(bucket, func(a *pb.PriceAlert) bool { return a.ClientCode == alert.ClientCode }) >= MaxAlertsPerClient {
+			return ErrLimitReached
+		}
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		alert.Id = strconv.FormatUint(seq, 10)
+		alert.State = pb.PriceAlertState_PRICE_ALERT_ACTIVE
+		alert.CreatedAt = now.UnixMilli()
+		return put(bucket, seq, alert)
+	})
+}
+
+// GetAlert returns the alert with the given id, or ErrNotFound.
+func (s *Store) GetAlert(id string) (*pb.PriceAlert, error) {
+	alert := &pb.PriceAlert{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return get(tx.Bucket(alertsBucket), id, alert)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// ListAlerts returns the alerts accepted by keep, newest first.
+func (s *Store) ListAlerts(keep func(*pb.PriceAlert) bool) ([]*pb.PriceAlert, error) {
+	alerts := []*pb.PriceAlert{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return each(tx.Bucket(alertsBucket), func() proto.Message { return &pb.PriceAlert{} }, func(m proto.Message) bool {
+			if alert := m.(*pb.PriceAlert); keep(alert) {
+				alerts = append(alerts, alert)
+			}
+			return true
+		})
+	})
+	return alerts, err
+}
+
+// UpdateAlert applies change to the stored alert and returns the result.
+// change may veto the update by returning an error.
+func (s *Store) UpdateAlert(id string, change func(*pb.PriceAlert) error) (*pb.PriceAlert, error) {
+	alert := &pb.PriceAlert{}
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(alertsBucket)
+		if err := get(bucket, id, alert); err != nil {
+			return err
+		}
+		if err := change(alert); err != nil {
+			return err
+		}
+		seq, _ := strconv.ParseUint(id, 10, 64)
+		return put(bucket, seq, alert)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+// DeleteAlert removes the alert. Its deliveries stay in the log.
+func (s *Store) DeleteAlert(id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return remove(tx.Bucket(alertsBucket), id)
+	})
+}
+
+// Trigger marks an active alert whose condition still holds (per met) as
+// triggered and queues one delivery per webhook of its owner, in the same
+// transaction, so a trigger is notified exactly once. payload builds the body
+// of each delivery.
+func (s *Store) Trigger(id string, ltp float64, now time.Time, met func(*pb.PriceAlert) bool, payload func(alert *pb.PriceAlert, deliveryID string) ([]byte, error)) (*pb.PriceAlert, []*pb.WebhookDelivery, error) {
+	alert := &pb.PriceAlert{}
+	var deliveries []*pb.WebhookDelivery
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(alertsBucket)
+		if err := get(bucket, id, alert); err != nil {
+			return err
+		}
+		if alert.State != pb.PriceAlertState_PRICE_ALERT_ACTIVE || !met(alert) {
+			return ErrNotActive
+		}
+		alert.State = pb.PriceAlertState_PRICE_ALERT_TRIGGERED
+		alert.TriggeredAt = now.UnixMilli()
+		alert.TriggerPrice = ltp
+		alert.TriggerCount++
+		seq, _ := strconv.ParseUint(id, 10, 64)
+		if err := put(bucket, seq, alert); err != nil {
+			return err
+		}
+
+		var webhooks []*pb.AlertWebhook
+		err := each(tx.Bucket(webhooksBucket), func() proto.Message { return &pb.AlertWebhook{} }, func(m proto.Message) bool {
+			if webhook := m.(*pb.AlertWebhook); webhook.ClientCode == alert.ClientCode {
+				webhooks = append(webhooks, webhook)
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+
+		log := tx.Bucket(deliveriesBucket)
+		for _, webhook := range webhooks {
+			dseq, err := log.NextSequence()
+			if err != nil {
+				return err
+			}
+			delivery := &pb.WebhookDelivery{
+				Id:            strconv.FormatUint(dseq, 10),
+				ClientCode:    alert.ClientCode,
+				AlertId:       alert.Id,
+				WebhookId:     webhook.Id,
+				Url:           webhook.Url,
+				Status:        pb.DeliveryStatus_DELIVERY_PENDING,
+				CreatedAt:     now.UnixMilli(),
+				NextAttemptAt: now.UnixMilli(),
+			}
+			body, err := payload(alert, delivery.Id)
+			if err != nil {
+				return fmt.Errorf("building payload: %w", err)
+			}
+			delivery.Payload = string(body)
+			if err := put(log, dseq, delivery); err != nil {
+				return err
+			}
+			deliveries = append(deliveries, delivery)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return alert, deliveries, nil
+}
+
+// CreateWebhook assigns the webhook its id and stores it.
+func (s *Store) CreateWebhook(webhook *pb.AlertWebhook, now time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(webhooksBucket)
+		if count(bucket, func(w *pb.AlertWebhook) bool { return w.ClientCode == webhook.ClientCode }) >= MaxWebhooksPerClient {
+			return ErrLimitReached
+		}
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		webhook.Id = strconv.FormatUint(seq, 10)
+		webhook.CreatedAt = now.UnixMilli()
+		return put(bucket, seq, webhook)
+	})
+}
+
+// GetWebhook returns the webhook with the given id, or ErrNotFound.
+func (s *Store) GetWebhook(id string) (*pb.AlertWebhook, error) {
+	webhook := &pb.AlertWebhook{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return get(tx.Bucket(webhooksBucket), id, webhook)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// ListWebhooks returns the client's webhooks, newest first.
+func (s *Store) ListWebhooks(clientCode string) ([]*pb.AlertWebhook, error) {
+	webhooks := []*pb.AlertWebhook{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return each(tx.Bucket(webhooksBucket), func() proto.Message { return &pb.AlertWebhook{} }, func(m proto.Message) bool {
+			if webhook := m.(*pb.AlertWebhook); webhook.ClientCode == clientCode {
+				webhooks = append(webhooks, webhook)
+			}
+			return true
+		})
+	})
+	return webhooks, err
+}
+
+// DeleteWebhook removes the webhook; its pending deliveries then fail.
+func (s *Store) DeleteWebhook(id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return remove(tx.Bucket(webhooksBucket), id)
+	})
+}
+
+// ListDeliveries returns up to limit deliveries accepted by keep, newest first.
+func (s *Store) ListDeliveries(keep func(*pb.WebhookDelivery) bool, limit int) ([]*pb.WebhookDelivery, error) {
+	deliveries := []*pb.WebhookDelivery{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return each(tx.Bucket(deliveriesBucket), func() proto.Message { return &pb.WebhookDelivery{} }, func(m proto.Message) bool {
+			if delivery := m.(*pb.WebhookDelivery); keep(delivery) {
+				deliveries = append(deliveries, delivery)
+			}
+			return limit <= 0 || len(deliveries) < limit
+		})
+	})
+	return deliveries, err
+}
+
+// UpdateDelivery applies change to the stored delivery.
+func (s *Store) UpdateDelivery(id string, change func(*pb.WebhookDelivery)) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(deliveriesBucket)
+		delivery := &pb.WebhookDelivery{}
+		if err := get(bucket, id, delivery); err != nil {
+			return err
+		}
+		change(delivery)
+		seq, _ := strconv.ParseUint(id, 10, 64)
+		return put(bucket, seq, delivery)
+	})
+}
+
+// PruneDeliveries removes finished deliveries created before the cutoff and
+// returns how many were removed.
+func (s *Store) PruneDeliveries(before time.Time) (int, error) {
+	removed := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(deliveriesBucket).Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			var delivery pb.WebhookDelivery
+			if err := proto.Unmarshal(v, &delivery); err != nil {
+				return fmt.Errorf("unmarshalling delivery %x: %w", k, err)
+			}
+			if delivery.CreatedAt >= before.UnixMilli() {
+				break // Keys are in creation order
+			}
+			if delivery.Status == pb.DeliveryStatus_DELIVERY_PENDING {
+				continue
+			}
+			if err := cursor.Delete(); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+	return removed, err
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func idKey(id string) ([]byte, bool) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return seqKey(seq), true
+}
+
+func get(bucket *bolt.Bucket, id string, m proto.Message) error {
+	key, ok := idKey(id)
+	if !ok {
+		return ErrNotFound
+	}
+	data := bucket.Get(key)
+	if data == nil {
+		return ErrNotFound
+	}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return fmt.Errorf("unmarshalling record %s: %w", id, err)
+	}
+	return nil
+}
+
+func put(bucket *bolt.Bucket, seq uint64, m proto.Message) error {
+	if alert, ok := m.(*pb.PriceAlert); ok {
+		alert.LastPrice, alert.WaitingForSession = 0, false // Only meaningful in memory
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("marshalling record: %w", err)
+	}
+	return bucket.Put(seqKey(seq), data)
+}
+
+func remove(bucket *bolt.Bucket, id string) error {
+	key, ok := idKey(id)
+	if !ok || bucket.Get(key) == nil {
+		return ErrNotFound
+	}
+	return bucket.Delete(key)
+}
+
+// each unmarshals the bucket's records newest first until fn returns false.
+func each(bucket *bolt.Bucket, newMsg func() proto.Message, fn func(proto.Message) bool) error {
+	cursor := bucket.Cursor()
+	for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+		m := newMsg()
+		if err := proto.Unmarshal(v, m); err != nil {
+			return fmt.Errorf("unmarshalling record %x: %w", k, err)
+		}
+		if !fn(m) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// count returns how many of the bucket's records match.
+func count[T any, PT interface {
+	*T
+	proto.Message
+}](bucket *bolt.Bucket, match func(PT) bool) int {
+	n := 0
+	cursor := bucket.Cursor()
+	for _, v := cursor.First(); v != nil; _, v = cursor.Next() {
+		m := PT(new(T))
+		if proto.Unmarshal(v, m) == nil && match(m) {
+			n++
+		}
+	}
+	return n
+}
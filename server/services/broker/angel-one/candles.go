@@ -0,0 +1,251 @@
+package angelone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// CandleDateLayout is the "yyyy-MM-dd HH:mm" format Angel One expects for
+// fromdate/todate, always in IST.
+const CandleDateLayout = "2006-01-02 15:04"
+
+// IST is India Standard Time, which all Angel One timestamps use.
+var IST = time.FixedZone("IST", 5*60*60+30*60)
+
+// Angel One allows about three historical requests per second, so split
+// ranges are fetched with a pause between calls.
+const candleChunkDelay = 350 * time.Millisecond
+
+// MaxCandleChunks is the most calls one candle request may be split into,
+// which keeps a request inside the gateway's 60s timeout.
+const MaxCandleChunks = 40
+
+// maxCandleWindowDays is the longest range Angel One serves in one call per interval.
+var maxCandleWindowDays = map[pb.CandleInterval]int{
+	pb.CandleInterval_ONE_MINUTE:     30,
+	pb.CandleInterval_THREE_MINUTE:   60,
+	pb.CandleInterval_FIVE_MINUTE:    100,
+	pb.CandleInterval_TEN_MINUTE:     100,
+	pb.CandleInterval_FIFTEEN_MINUTE: 200,
+	pb.CandleInterval_THIRTY_MINUTE:  200,
+	pb.CandleInterval_ONE_HOUR:       400,
+	pb.CandleInterval_ONE_DAY:        2000,
+}
+
+// MaxCandleWindow returns the longest range Angel One serves in one call for
+// the interval, or zero for an unknown interval.
+func MaxCandleWindow(interval pb.CandleInterval) time.Duration {
+	return time.Duration(maxCandleWindowDays[interval]) * 24 * time.Hour
+}
+
+// CandleChunks returns how many calls fetching from..to takes for the
+// interval, or zero for an unknown interval.
+func CandleChunks(interval pb.CandleInterval, from, to time.Time) int {
+	window := MaxCandleWindow(interval)
+	if window == 0 {
+		return 0
+	}
+	chunks := int(to.Sub(from) / window)
+	if to.Sub(from)%window != 0 || chunks == 0 {
+		chunks++
+	}
+	return chunks
+}
+
+// ParseCandleDate parses a fromdate/todate value in IST.
+func ParseCandleDate(s string) (time.Time, error) {
+	return time.ParseInLocation(CandleDateLayout, s, IST)
+}
+
+// FormatCandleDate formats t as a fromdate/todate value.
+func FormatCandleDate(t time.Time) string {
+	return t.In(IST).Format(CandleDateLayout)
+}
+
+type AngelCandlePayload struct {
+	Exchange    string `json:"exchange"`
+	SymbolToken string `json:"symboltoken"`
+	Interval    string `json:"interval"`
+	FromDate    string `json:"fromdate"`
+	ToDate      string `json:"todate"`
+}
+
+// AngelCandle is one row of Angel One's candle data:
+// [timestamp, open, high, low, close, volume].
+type AngelCandle struct {
+	Timestamp string
+	Open      flexFloat
+	High      flexFloat
+	Low       flexFloat
+	Close     flexFloat
+	Volume    flexInt
+}
+
+func (c *AngelCandle) UnmarshalJSON(b []byte) error {
+	var row []json.RawMessage
+	if err := json.Unmarshal(b, &row); err != nil {
+		return err
+	}
+	if len(row) < 6 {
+		return fmt.Errorf("candle row has %d fields, want 6", len(row))
+	}
+	if err := json.Unmarshal(row[0], &c.Timestamp); err != nil {
+		return err
+	}
+	for i, dst := range []json.Unmarshaler{&c.Open, &c.High, &c.Low, &c.Close, &c.Volume} {
+		if err := dst.UnmarshalJSON(row[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type AngelCandleRawResponse struct {
+	Status    bool          `json:"status"`
+	Message   string        `json:"message"`
+	ErrorCode string        `json:"errorcode"`
+	Data      []AngelCandle `json:"data"`
+}
+
+// GetCandleData fetches candles between fromdate and todate. Ranges longer
+// than Angel One's window for the interval are fetched in several calls and
+// merged into one oldest-first series without duplicates. It stops between
+// calls once ctx is done.
+func (c *Client) GetCandleData(ctx context.Context, reqData *pb.GetCandleDataRequest) (*pb.GetCandleDataResponse, error) {
+	from, err := ParseCandleDate(reqData.Fromdate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing fromdate: %w", err)
+	}
+	to, err := ParseCandleDate(reqData.Todate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing todate: %w", err)
+	}
+	window := MaxCandleWindow(reqData.Interval)
+	if window == 0 {
+		return nil, fmt.Errorf("unsupported candle interval %s", reqData.Interval)
+	}
+	if chunks := CandleChunks(reqData.Interval, from, to); chunks > MaxCandleChunks {
+		return nil, fmt.Errorf("candle range needs %d calls, at most %d are allowed", chunks, MaxCandleChunks)
+	}
+
+	var candles []*pb.Candle
+	var last *AngelCandleRawResponse
+	for chunkFrom := from; ; {
+		chunkTo := chunkFrom.Add(window)
+		if chunkTo.After(to) {
+			chunkTo = to
+		}
+
+		resp, err := c.fetchCandles(ctx, reqData, chunkFrom, chunkTo)
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Status {
+			log.Printf("AngelOne Client (GetCandleData): Angel One API reported status:false for %s to %s. Message: %s, ErrorCode: %s", FormatCandleDate(chunkFrom), FormatCandleDate(chunkTo), resp.Message, resp.ErrorCode)
+			return &pb.GetCandleDataResponse{
+				Status:    false,
+				Message:   fmt.Sprintf("%s (range %s to %s)", resp.Message, FormatCandleDate(chunkFrom), FormatCandleDate(chunkTo)),
+				Errorcode: resp.ErrorCode,
+			}, nil
+		}
+		for _, raw := range resp.Data {
+			candles = append(candles, &pb.Candle{
+				Timestamp: raw.Timestamp,
+				Open:      float64(raw.Open),
+				High:      float64(raw.High),
+				Low:       float64(raw.Low),
+				Close:     float64(raw.Close),
+				Volume:    int64(raw.Volume),
+			})
+		}
+		last = resp
+
+		if !chunkTo.Before(to) {
+			break
+		}
+		// Windows share their boundary minute; the overlap is removed by MergeCandles.
+		chunkFrom = chunkTo
+		select {
+		case <-time.After(candleChunkDelay):
+		case <-ctx.Done():
+			return nil, fmt.Errorf("fetching candles: %w", ctx.Err())
+		}
+	}
+
+	return &pb.GetCandleDataResponse{
+		Status:    true,
+		Message:   last.Message,
+		Errorcode: last.ErrorCode,
+		Data:      MergeCandles(candles),
+	}, nil
+}
+
+func (c *Client) fetchCandles(ctx context.Context, reqData *pb.GetCandleDataRequest, from, to time.Time) (*AngelCandleRawResponse, error) {
+	url := angelOneBaseURL + candleDataURLPath
+	payloadBytes, err := json.Marshal(AngelCandlePayload{
+		Exchange:    reqData.Exchange,
+		SymbolToken: reqData.Symboltoken,
+		Interval:    reqData.Interval.String(),
+		FromDate:    FormatCandleDate(from),
+		ToDate:      FormatCandleDate(to),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling candle payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return nil, fmt.Errorf("creating candle request: %w", err)
+	}
+	c.setCommonHeaders(httpReq, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+
+	res, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return &AngelCandleRawResponse{Status: false, Message: "Failed to execute request to Angel One: " + err.Error(), ErrorCode: "HTTP_EXECUTION_ERROR"}, nil
+	}
+
+	var apiResponse AngelCandleRawResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (GetCandleData): Error unmarshalling Angel One response: %v. Body: %s", err, string(body))
+		msg := "Failed to parse Angel One candle response"
+		if res.StatusCode != http.StatusOK {
+			msg = fmt.Sprintf("Angel One API Error: %s (and failed to parse body)", res.Status)
+		}
+		return &AngelCandleRawResponse{Status: false, Message: msg, ErrorCode: "UNMARSHAL_ERROR"}, nil
+	}
+	return &apiResponse, nil
+}
+
+// MergeCandles sorts candles oldest first and drops repeated timestamps,
+// keeping the last occurrence. Candles with unparseable timestamps are dropped.
+func MergeCandles(candles []*pb.Candle) []*pb.Candle {
+	byTime := make(map[int64]*pb.Candle, len(candles))
+	for _, candle := range candles {
+		t, err := time.Parse(time.RFC3339, candle.Timestamp)
+		if err != nil {
+			log.Printf("AngelOne Client (GetCandleData): Skipping candle with bad timestamp %q: %v", candle.Timestamp, err)
+			continue
+		}
+		byTime[t.Unix()] = candle
+	}
+
+	keys := make([]int64, 0, len(byTime))
+	for k := range byTime {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	merged := make([]*pb.Candle, len(keys))
+	for i, k := range keys {
+		merged[i] = byTime[k]
+	}
+	return merged
+}
@@ -14,13 +14,28 @@ import (
 
 const (
 	angelOneBaseURL        = "https://apiconnect.angelone.in/rest/secure/angelbroking"
+	angelOneAuthBaseURL    = "https://apiconnect.angelone.in/rest/auth/angelbroking"
+	generateTokensURLPath  = "/jwt/v1/generateTokens"
 	profileURLPath         = "/user/v1/getProfile"
 	logoutURLPath          = "/user/v1/logout"
+	rmsURLPath             = "/user/v1/getRMS"
+	marginBatchURLPath     = "/margin/v1/batch"
 	placeOrderURLPath      = "/order/v1/placeOrder"
 	cancelOrderURLPath     = "/order/v1/cancelOrder"
+	modifyOrderURLPath     = "/order/v1/modifyOrder"
 	orderBookURLPath       = "/order/v1/getOrderBook"
+	tradeBookURLPath       = "/order/v1/getTradeBook"
+	orderDetailsURLPath    = "/order/v1/details/"
 	holdingsURLPath        = "/portfolio/v1/getAllHolding"
+	positionsURLPath       = "/order/v1/getPosition"
+	convertPositionURLPath = "/order/v1/convertPosition"
 	marketDataQuoteURLPath = "/market/v1/quote"
+	candleDataURLPath      = "/historical/v1/getCandleData"
+	gttCreateURLPath       = "/gtt/v1/createRule"
+	gttModifyURLPath       = "/gtt/v1/modifyRule"
+	gttCancelURLPath       = "/gtt/v1/cancelRule"
+	gttDetailsURLPath      = "/gtt/v1/ruleDetails"
+	gttListURLPath         = "/gtt/v1/ruleList"
 )
 
 type Client struct {
@@ -264,13 +279,19 @@ type AngelPlaceOrderPayload struct {
 	SquareOff       float64 `json:"squareoff,omitempty"`
 	StopLoss        float64 `json:"stoploss,omitempty"`
 	Quantity        int32   `json:"quantity"`
-	// Add other optional fields here with `json:",omitempty"`
+	// Optional fields, only sent when set
+	TriggerPrice      float64 `json:"triggerprice,omitempty"`
+	DisclosedQuantity int32   `json:"disclosedquantity,omitempty"`
+	TrailingStopLoss  float64 `json:"trailingStopLoss,omitempty"`
+	OrderTag          string  `json:"ordertag,omitempty"`
+	MarketProtection  float64 `json:"marketprotection,omitempty"`
 }
 
 // Response structure (data part) for Angel One Place Order
 type AngelPlaceOrderDataResponse struct {
-	Script  string `json:"script"`
-	OrderID string `json:"orderid"`
+	Script        string `json:"script"`
+	OrderID       string `json:"orderid"`
+	UniqueOrderID string `json:"uniqueorderid"`
 }
 type AngelPlaceOrderRawResponse struct {
 	Status    bool                         `json:"status"`
@@ -294,6 +315,12 @@ func (c *Client) PlaceOrder(reqData *pb.PlaceOrderRequest) (*pb.PlaceOrderRespon
 		SquareOff:       reqData.Squareoff,
 		StopLoss:        reqData.Stoploss,
 		Quantity:        reqData.Quantity,
+
+		TriggerPrice:      reqData.Triggerprice,
+		DisclosedQuantity: reqData.Disclosedquantity,
+		TrailingStopLoss:  reqData.Trailingstoploss,
+		OrderTag:          reqData.Ordertag,
+		MarketProtection:  reqData.Marketprotection,
 	}
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
@@ -320,8 +347,9 @@ func (c *Client) PlaceOrder(reqData *pb.PlaceOrderRequest) (*pb.PlaceOrderRespon
 	var pbData *pb.PlaceOrderAngelData
 	if apiResponse.Data != nil {
 		pbData = &pb.PlaceOrderAngelData{
-			Script:  apiResponse.Data.Script,
-			Orderid: apiResponse.Data.OrderID,
+			Script:        apiResponse.Data.Script,
+			Orderid:       apiResponse.Data.OrderID,
+			Uniqueorderid: apiResponse.Data.UniqueOrderID,
 		}
 	}
 
@@ -380,6 +408,82 @@ func (c *Client) CancelOrder(reqData *pb.CancelOrderRequest) (*pb.CancelOrderRes
 	}, nil
 }
 
+// --- Modify Order ---
+type AngelModifyOrderPayload struct {
+	Variety       string  `json:"variety"`
+	OrderID       string  `json:"orderid"`
+	OrderType     string  `json:"ordertype"`
+	ProductType   string  `json:"producttype"`
+	Duration      string  `json:"duration"`
+	Price         float64 `json:"price"`
+	Quantity      int32   `json:"quantity"`
+	TriggerPrice  float64 `json:"triggerprice,omitempty"`
+	TradingSymbol string  `json:"tradingsymbol,omitempty"`
+	SymbolToken   string  `json:"symboltoken,omitempty"`
+	Exchange      string  `json:"exchange,omitempty"`
+}
+type AngelModifyOrderDataResponse struct {
+	OrderID       string `json:"orderid"`
+	UniqueOrderID string `json:"uniqueorderid"`
+}
+type AngelModifyOrderRawResponse struct {
+	Status    bool                          `json:"status"`
+	Message   string                        `json:"message"`
+	ErrorCode string                        `json:"errorcode"`
+	Data      *AngelModifyOrderDataResponse `json:"data"`
+}
+
+func (c *Client) ModifyOrder(reqData *pb.ModifyOrderRequest) (*pb.ModifyOrderResponse, error) {
+	url := angelOneBaseURL + modifyOrderURLPath
+	payload := AngelModifyOrderPayload{
+		Variety:       reqData.Variety,
+		OrderID:       reqData.Orderid,
+		OrderType:     reqData.Ordertype,
+		ProductType:   reqData.Producttype,
+		Duration:      reqData.Duration,
+		Price:         reqData.Price,
+		Quantity:      reqData.Quantity,
+		TriggerPrice:  reqData.Triggerprice,
+		TradingSymbol: reqData.Tradingsymbol,
+		SymbolToken:   reqData.Symboltoken,
+		Exchange:      reqData.Exchange,
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling modify order payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return nil, fmt.Errorf("creating modify order request: %w", err)
+	}
+	c.setCommonHeaders(httpReq, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+
+	_, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse AngelModifyOrderRawResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (ModifyOrder): Error unmarshalling response: %v. Body: %s", err, string(body))
+		return &pb.ModifyOrderResponse{Status: false, Message: "Failed to parse Angel One response", Errorcode: "UNMARSHAL_ERROR"}, nil
+	}
+	var pbData *pb.ModifyOrderAngelData
+	if apiResponse.Data != nil {
+		pbData = &pb.ModifyOrderAngelData{
+			Orderid:       apiResponse.Data.OrderID,
+			Uniqueorderid: apiResponse.Data.UniqueOrderID,
+		}
+	}
+	return &pb.ModifyOrderResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Data:      pbData,
+	}, nil
+}
+
 // --- Get Order Book ---
 // This struct matches Angel One's JSON structure for individual order items
 type AngelOneOrderBookRawResponse struct {
@@ -684,3 +788,66 @@ func (c *Client) GetFullQuote(reqData *pb.GetFullQuoteRequest) (*pb.GetFullQuote
 		Data:      pbFullQuoteResponseData,
 	}, nil
 }
+
+// --- Generate Tokens ---
+type AngelGenerateTokensPayload struct {
+	RefreshToken string `json:"refreshToken"`
+}
+type AngelGenerateTokensDataResponse struct {
+	JWTToken     string `json:"jwtToken"`
+	RefreshToken string `json:"refreshToken"`
+	FeedToken    string `json:"feedToken"`
+}
+type AngelGenerateTokensRawResponse struct {
+	Status    bool                             `json:"status"`
+	Message   string                           `json:"message"`
+	ErrorCode string                           `json:"errorcode"`
+	Data      *AngelGenerateTokensDataResponse `json:"data"`
+}
+
+// GenerateTokens exchanges the refresh token received at login for a new JWT and feed token.
+func (c *Client) GenerateTokens(reqData *pb.GenerateTokensRequest) (*pb.GenerateTokensResponse, error) {
+	url := angelOneAuthBaseURL + generateTokensURLPath
+	payload := AngelGenerateTokensPayload{RefreshToken: reqData.RefreshToken}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling generate tokens payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return nil, fmt.Errorf("creating generate tokens request: %w", err)
+	}
+	c.setCommonHeaders(httpReq, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+
+	res, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return &pb.GenerateTokensResponse{Status: false, Message: "Failed to execute generate tokens request to Angel One: " + err.Error(), Errorcode: "HTTP_EXECUTION_ERROR"}, nil
+	}
+
+	var apiResponse AngelGenerateTokensRawResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (GenerateTokens): Error unmarshalling response: %v. Body: %s", err, string(body))
+		msg := "Failed to parse Angel One generate tokens response"
+		if res.StatusCode != http.StatusOK {
+			msg = fmt.Sprintf("Angel One API Error: %s (and failed to parse body)", res.Status)
+		}
+		return &pb.GenerateTokensResponse{Status: false, Message: msg, Errorcode: "UNMARSHAL_ERROR"}, nil
+	}
+
+	var pbData *pb.GenerateTokensAngelData
+	if apiResponse.Data != nil {
+		pbData = &pb.GenerateTokensAngelData{
+			JwtToken:     apiResponse.Data.JWTToken,
+			RefreshToken: apiResponse.Data.RefreshToken,
+			FeedToken:    apiResponse.Data.FeedToken,
+		}
+	}
+
+	return &pb.GenerateTokensResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Data:      pbData,
+	}, nil
+}
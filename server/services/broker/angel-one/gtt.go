@@ -0,0 +1,310 @@
+package angelone
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// ErrGTTRuleNotFound is returned by GetGTTDetails when Angel One has no rule
+// with the requested id.
+var ErrGTTRuleNotFound = errors.New("GTT rule not found")
+
+type AngelCreateGTTPayload struct {
+	TradingSymbol   string  `json:"tradingsymbol"`
+	SymbolToken     string  `json:"symboltoken"`
+	Exchange        string  `json:"exchange"`
+	TransactionType string  `json:"transactiontype"`
+	ProductType     string  `json:"producttype"`
+	Price           float64 `json:"price"`
+	Qty             int32   `json:"qty"`
+	TriggerPrice    float64 `json:"triggerprice"`
+	DisclosedQty    int32   `json:"disclosedqty"`
+	TimePeriod      int32   `json:"timeperiod,omitempty"`
+}
+
+type AngelModifyGTTPayload struct {
+	ID           string  `json:"id"`
+	SymbolToken  string  `json:"symboltoken"`
+	Exchange     string  `json:"exchange"`
+	Price        float64 `json:"price"`
+	Qty          int32   `json:"qty"`
+	TriggerPrice float64 `json:"triggerprice"`
+	DisclosedQty int32   `json:"disclosedqty"`
+	TimePeriod   int32   `json:"timeperiod,omitempty"`
+}
+
+type AngelCancelGTTPayload struct {
+	ID          string `json:"id"`
+	SymbolToken string `json:"symboltoken"`
+	Exchange    string `json:"exchange"`
+}
+
+type AngelGTTDetailsPayload struct {
+	ID string `json:"id"`
+}
+
+type AngelListGTTPayload struct {
+	Status []string `json:"status"`
+	Page   int32    `json:"page"`
+	Count  int32    `json:"count"`
+}
+
+// AngelGTTRule matches a rule in Angel One's ruleDetails and ruleList
+// responses. Amounts and quantities may be strings or numbers.
+type AngelGTTRule struct {
+	ID              flexString `json:"id"`
+	Status          string     `json:"status"`
+	TradingSymbol   string     `json:"tradingsymbol"`
+	SymbolToken     string     `json:"symboltoken"`
+	Exchange        string     `json:"exchange"`
+	ProductType     string     `json:"producttype"`
+	TransactionType string     `json:"transactiontype"`
+	Price           flexFloat  `json:"price"`
+	Qty             flexInt    `json:"qty"`
+	TriggerPrice    flexFloat  `json:"triggerprice"`
+	DisclosedQty    flexInt    `json:"disclosedqty"`
+	CreatedDate     string     `json:"createddate"`
+	UpdatedDate     string     `json:"updateddate"`
+	ExpiryDate      string     `json:"expirydate"`
+	ClientID        string     `json:"clientid"`
+}
+
+func (r *AngelGTTRule) toProto() *pb.GTTRule {
+	return &pb.GTTRule{
+		Id:              string(r.ID),
+		Status:          r.Status,
+		Tradingsymbol:   r.TradingSymbol,
+		Symboltoken:     r.SymbolToken,
+		Exchange:        r.Exchange,
+		Producttype:     r.ProductType,
+		Transactiontype: r.TransactionType,
+		Price:           float64(r.Price),
+		Qty:             int32(r.Qty),
+		Triggerprice:    float64(r.TriggerPrice),
+		Disclosedqty:    int32(r.DisclosedQty),
+		Createddate:     r.CreatedDate,
+		Updateddate:     r.UpdatedDate,
+		Expirydate:      r.ExpiryDate,
+		Clientid:        r.ClientID,
+	}
+}
+
+// AngelGTTRawResponse is the envelope shared by the /gtt/v1 endpoints; Data
+// is decoded by each caller.
+type AngelGTTRawResponse struct {
+	Status    bool            `json:"status"`
+	Message   string          `json:"message"`
+	ErrorCode string          `json:"errorcode"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// gttIDData is the data of createRule, modifyRule and cancelRule.
+type gttIDData struct {
+	ID flexString `json:"id"`
+}
+
+// gttRequest posts a payload to a /gtt/v1 endpoint. Transport and parse
+// failures are reported as status:false responses, like the other calls.
+func (c *Client) gttRequest(op, path string, payload interface{}, authToken, clientLocalIP, clientPublicIP, macAddress string) (*AngelGTTRawResponse, error) {
+	url := angelOneBaseURL + path
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling %s payload: %w", op, err)
+	}
+
+	httpReq, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return nil, fmt.Errorf("creating %s request: %w", op, err)
+	}
+	c.setCommonHeaders(httpReq, authToken, clientLocalIP, clientPublicIP, macAddress)
+
+	res, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return &AngelGTTRawResponse{Status: false, Message: "Failed to execute request to Angel One: " + err.Error(), ErrorCode: "HTTP_EXECUTION_ERROR"}, nil
+	}
+
+	var apiResponse AngelGTTRawResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (%s): Error unmarshalling Angel One response: %v. Body: %s", op, err, string(body))
+		msg := "Failed to parse Angel One GTT response"
+		if res.StatusCode != http.StatusOK {
+			msg = fmt.Sprintf("Angel One API Error: %s (and failed to parse body)", res.Status)
+		}
+		return &AngelGTTRawResponse{Status: false, Message: msg, ErrorCode: "UNMARSHAL_ERROR"}, nil
+	}
+	if !apiResponse.Status {
+		log.Printf("AngelOne Client (%s): Angel One API reported status:false. Message: %s, ErrorCode: %s", op, apiResponse.Message, apiResponse.ErrorCode)
+	}
+	return &apiResponse, nil
+}
+
+// ruleID decodes the {"id": ...} data of a successful create, modify or cancel.
+func (r *AngelGTTRawResponse) ruleID() (string, error) {
+	if !r.Status || len(r.Data) == 0 {
+		return "", nil
+	}
+	var data gttIDData
+	if err := json.Unmarshal(r.Data, &data); err != nil {
+		return "", err
+	}
+	return string(data.ID), nil
+}
+
+func (c *Client) CreateGTT(reqData *pb.CreateGTTRequest) (*pb.CreateGTTResponse, error) {
+	payload := AngelCreateGTTPayload{
+		TradingSymbol:   reqData.Tradingsymbol,
+		SymbolToken:     reqData.Symboltoken,
+		Exchange:        reqData.Exchange,
+		TransactionType: reqData.Transactiontype,
+		ProductType:     reqData.Producttype,
+		Price:           reqData.Price,
+		Qty:             reqData.Qty,
+		TriggerPrice:    reqData.Triggerprice,
+		DisclosedQty:    reqData.Disclosedqty,
+		TimePeriod:      reqData.Timeperiod,
+	}
+	apiResponse, err := c.gttRequest("CreateGTT", gttCreateURLPath, payload, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	id, err := apiResponse.ruleID()
+	if err != nil {
+		log.Printf("AngelOne Client (CreateGTT): Error unmarshalling rule id: %v. Data: %s", err, string(apiResponse.Data))
+		return &pb.CreateGTTResponse{Status: false, Message: "Failed to parse Angel One GTT response", Errorcode: "UNMARSHAL_ERROR"}, nil
+	}
+	return &pb.CreateGTTResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Id:        id,
+	}, nil
+}
+
+func (c *Client) ModifyGTT(reqData *pb.ModifyGTTRequest) (*pb.ModifyGTTResponse, error) {
+	payload := AngelModifyGTTPayload{
+		ID:           reqData.Id,
+		SymbolToken:  reqData.Symboltoken,
+		Exchange:     reqData.Exchange,
+		Price:        reqData.Price,
+		Qty:          reqData.Qty,
+		TriggerPrice: reqData.Triggerprice,
+		DisclosedQty: reqData.Disclosedqty,
+		TimePeriod:   reqData.Timeperiod,
+	}
+	apiResponse, err := c.gttRequest("ModifyGTT", gttModifyURLPath, payload, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	id, err := apiResponse.ruleID()
+	if err != nil {
+		log.Printf("AngelOne Client (ModifyGTT): Error unmarshalling rule id: %v. Data: %s", err, string(apiResponse.Data))
+		return &pb.ModifyGTTResponse{Status: false, Message: "Failed to parse Angel One GTT response", Errorcode: "UNMARSHAL_ERROR"}, nil
+	}
+	return &pb.ModifyGTTResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Id:        id,
+	}, nil
+}
+
+func (c *Client) CancelGTT(reqData *pb.CancelGTTRequest) (*pb.CancelGTTResponse, error) {
+	payload := AngelCancelGTTPayload{
+		ID:          reqData.Id,
+		SymbolToken: reqData.Symboltoken,
+		Exchange:    reqData.Exchange,
+	}
+	apiResponse, err := c.gttRequest("CancelGTT", gttCancelURLPath, payload, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	id, err := apiResponse.ruleID()
+	if err != nil {
+		log.Printf("AngelOne Client (CancelGTT): Error unmarshalling rule id: %v. Data: %s", err, string(apiResponse.Data))
+		return &pb.CancelGTTResponse{Status: false, Message: "Failed to parse Angel One GTT response", Errorcode: "UNMARSHAL_ERROR"}, nil
+	}
+	return &pb.CancelGTTResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Id:        id,
+	}, nil
+}
+
+func (c *Client) GetGTTDetails(reqData *pb.GetGTTDetailsRequest) (*pb.GetGTTDetailsResponse, error) {
+	payload := AngelGTTDetailsPayload{ID: reqData.Id}
+	apiResponse, err := c.gttRequest("GetGTTDetails", gttDetailsURLPath, payload, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !apiResponse.Status {
+		if notFoundError(apiResponse.ErrorCode, apiResponse.Message) {
+			return nil, ErrGTTRuleNotFound
+		}
+		// Session errors (AG8001 etc.) must stay in the response so the gateway can refresh and retry.
+		return &pb.GetGTTDetailsResponse{Status: false, Message: apiResponse.Message, Errorcode: apiResponse.ErrorCode}, nil
+	}
+
+	var rule *AngelGTTRule
+	if len(apiResponse.Data) > 0 {
+		if err := json.Unmarshal(apiResponse.Data, &rule); err != nil {
+			log.Printf("AngelOne Client (GetGTTDetails): Error unmarshalling rule: %v. Data: %s", err, string(apiResponse.Data))
+			return &pb.GetGTTDetailsResponse{Status: false, Message: "Failed to parse Angel One GTT response", Errorcode: "UNMARSHAL_ERROR"}, nil
+		}
+	}
+	if rule == nil || (rule.SymbolToken == "" && rule.ID == "") {
+		return nil, ErrGTTRuleNotFound
+	}
+	if rule.ID == "" {
+		rule.ID = flexString(reqData.Id) // ruleDetails does not echo the id
+	}
+
+	return &pb.GetGTTDetailsResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Data:      rule.toProto(),
+	}, nil
+}
+
+func (c *Client) ListGTT(reqData *pb.ListGTTRequest) (*pb.ListGTTResponse, error) {
+	payload := AngelListGTTPayload{
+		Status: reqData.Status,
+		Page:   reqData.Page,
+		Count:  reqData.Count,
+	}
+	apiResponse, err := c.gttRequest("ListGTT", gttListURLPath, payload, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !apiResponse.Status {
+		return &pb.ListGTTResponse{Status: false, Message: apiResponse.Message, Errorcode: apiResponse.ErrorCode}, nil
+	}
+
+	var rules []*AngelGTTRule
+	if len(apiResponse.Data) > 0 {
+		if err := json.Unmarshal(apiResponse.Data, &rules); err != nil {
+			log.Printf("AngelOne Client (ListGTT): Error unmarshalling rules: %v. Data: %s", err, string(apiResponse.Data))
+			return &pb.ListGTTResponse{Status: false, Message: "Failed to parse Angel One GTT response", Errorcode: "UNMARSHAL_ERROR"}, nil
+		}
+	}
+	data := make([]*pb.GTTRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule != nil {
+			data = append(data, rule.toProto())
+		}
+	}
+
+	return &pb.ListGTTResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Data:      data,
+	}, nil
+}
@@ -0,0 +1,213 @@
+package angelone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// --- Margin Calculator ---
+
+// marginLegParallel bounds the per-leg margin calls in flight, so a request
+// with many legs stays under Angel One's rate limit and the gateway's timeout.
+const marginLegParallel = 5
+
+type AngelMarginPosition struct {
+	Exchange    string  `json:"exchange"`
+	Qty         int32   `json:"qty"`
+	Price       float64 `json:"price"`
+	ProductType string  `json:"productType"`
+	Token       string  `json:"token"`
+	TradeType   string  `json:"tradeType"`
+	OrderType   string  `json:"orderType"`
+}
+
+type AngelMarginPayload struct {
+	Positions []AngelMarginPosition `json:"positions"`
+}
+
+type AngelMarginComponents struct {
+	NetPremium        flexFloat `json:"netPremium"`
+	SpanMargin        flexFloat `json:"spanMargin"`
+	MarginBenefit     flexFloat `json:"marginBenefit"`
+	DeliveryMargin    flexFloat `json:"deliveryMargin"`
+	NonNFOMargin      flexFloat `json:"nonNFOMargin"`
+	TotOptionsPremium flexFloat `json:"totOptionsPremium"`
+}
+
+type AngelMarginData struct {
+	TotalMarginRequired flexFloat              `json:"totalMarginRequired"`
+	MarginComponents    *AngelMarginComponents `json:"marginComponents"`
+}
+
+type AngelMarginRawResponse struct {
+	Status    bool             `json:"status"`
+	Message   string           `json:"message"`
+	ErrorCode string           `json:"errorcode"`
+	Data      *AngelMarginData `json:"data"`
+}
+
+// CalculateMargin returns the margin required for all legs together and, when
+// there is more than one leg, for each leg on its own. Angel One only reports
+// the combined figure per call, so per-leg margins cost one extra call per leg;
+// these run marginLegParallel at a time and stop when ctx is done.
+func (c *Client) CalculateMargin(ctx context.Context, reqData *pb.CalculateMarginRequest) (*pb.CalculateMarginResponse, error) {
+	positions := make([]AngelMarginPosition, len(reqData.Legs))
+	for i, leg := range reqData.Legs {
+		positions[i] = AngelMarginPosition{
+			Exchange:    leg.Exchange,
+			Qty:         leg.Quantity,
+			Price:       leg.Price,
+			ProductType: leg.Producttype,
+			Token:       leg.Symboltoken,
+			TradeType:   leg.Transactiontype,
+			OrderType:   leg.Ordertype,
+		}
+	}
+
+	total, err := c.marginBatch(ctx, reqData, positions)
+	if err != nil {
+		return nil, err
+	}
+	if !total.Status || total.Data == nil {
+		log.Printf("AngelOne Client (CalculateMargin): Angel One API reported status:%t with no data. Message: %s, ErrorCode: %s", total.Status, total.Message, total.ErrorCode)
+		return &pb.CalculateMarginResponse{Status: false, Message: total.Message, Errorcode: total.ErrorCode}, nil
+	}
+
+	data := &pb.MarginData{
+		Totalmarginrequired: float64(total.Data.TotalMarginRequired),
+		Legs:                make([]*pb.LegMargin, len(reqData.Legs)),
+	}
+	if mc := total.Data.MarginComponents; mc != nil {
+		data.Components = &pb.MarginComponents{
+			Netpremium:        float64(mc.NetPremium),
+			Spanmargin:        float64(mc.SpanMargin),
+			Marginbenefit:     float64(mc.MarginBenefit),
+			Deliverymargin:    float64(mc.DeliveryMargin),
+			Nonnfomargin:      float64(mc.NonNFOMargin),
+			Totoptionspremium: float64(mc.TotOptionsPremium),
+		}
+	}
+
+	legMargins := []float64{data.Totalmarginrequired}
+	if len(reqData.Legs) > 1 {
+		var failed *pb.CalculateMarginResponse
+		legMargins, failed, err = c.legMargins(ctx, reqData, positions)
+		if err != nil || failed != nil {
+			return failed, err
+		}
+	}
+	for i, leg := range reqData.Legs {
+		data.Legs[i] = &pb.LegMargin{
+			Index:          int32(i),
+			Exchange:       leg.Exchange,
+			Symboltoken:    leg.Symboltoken,
+			Tradingsymbol:  leg.Tradingsymbol,
+			Marginrequired: legMargins[i],
+		}
+	}
+
+	return &pb.CalculateMarginResponse{
+		Status:    total.Status,
+		Message:   total.Message,
+		Errorcode: total.ErrorCode,
+		Data:      data,
+	}, nil
+}
+
+// legMargins calculates the margin of each position on its own. The first
+// failure stops further calls and is returned as a status:false response.
+func (c *Client) legMargins(ctx context.Context, reqData *pb.CalculateMarginRequest, positions []AngelMarginPosition) ([]float64, *pb.CalculateMarginResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	margins := make([]float64, len(positions))
+	var (
+		mu       sync.Mutex
+		failed   *pb.CalculateMarginResponse
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, marginLegParallel)
+	for i := range positions {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := c.marginBatch(ctx, reqData, positions[i:i+1])
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil:
+				if firstErr == nil && failed == nil {
+					firstErr = err
+				}
+				cancel()
+			case !resp.Status || resp.Data == nil:
+				if firstErr == nil && failed == nil {
+					failed = &pb.CalculateMarginResponse{
+						Status:    false,
+						Message:   fmt.Sprintf("Failed to calculate margin for leg %d: %s", i, resp.Message),
+						Errorcode: resp.ErrorCode,
+					}
+				}
+				cancel()
+			default:
+				margins[i] = float64(resp.Data.TotalMarginRequired)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil || failed != nil {
+		return nil, failed, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("calculating leg margins: %w", err)
+	}
+	return margins, nil, nil
+}
+
+func (c *Client) marginBatch(ctx context.Context, reqData *pb.CalculateMarginRequest, positions []AngelMarginPosition) (*AngelMarginRawResponse, error) {
+	url := angelOneBaseURL + marginBatchURLPath
+	payloadBytes, err := json.Marshal(AngelMarginPayload{Positions: positions})
+	if err != nil {
+		return nil, fmt.Errorf("marshalling margin payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return nil, fmt.Errorf("creating margin request: %w", err)
+	}
+	c.setCommonHeaders(httpReq, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+
+	res, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return &AngelMarginRawResponse{Status: false, Message: "Failed to execute request to Angel One: " + err.Error(), ErrorCode: "HTTP_EXECUTION_ERROR"}, nil
+	}
+
+	var apiResponse AngelMarginRawResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (CalculateMargin): Error unmarshalling Angel One response: %v. Body: %s", err, string(body))
+		msg := "Failed to parse Angel One margin response"
+		if res.StatusCode != http.StatusOK {
+			msg = fmt.Sprintf("Angel One API Error: %s (and failed to parse body)", res.Status)
+		}
+		return &AngelMarginRawResponse{Status: false, Message: msg, ErrorCode: "UNMARSHAL_ERROR"}, nil
+	}
+	return &apiResponse, nil
+}
@@ -0,0 +1,88 @@
+package angelone
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// ErrOrderNotFound is returned by GetOrderDetails when Angel One has no order
+// with the requested uniqueorderid.
+var ErrOrderNotFound = errors.New("order not found")
+
+// errorCodeOrderNotFound is Angel One's errorcode for an unknown order id.
+const errorCodeOrderNotFound = "AB1013"
+
+// notFoundError reports whether a status:false reply means the requested
+// object does not exist. Not every endpoint documents its errorcode for this,
+// so the message is checked as well; session errors never match.
+func notFoundError(errorCode, message string) bool {
+	if errorCode == errorCodeOrderNotFound {
+		return true
+	}
+	if strings.HasPrefix(errorCode, "AG") { // AG8001 and friends are session errors
+		return false
+	}
+	return strings.Contains(strings.ToLower(message), "not found")
+}
+
+type AngelOrderDetailsRawResponse struct {
+	Status    bool              `json:"status"`
+	Message   string            `json:"message"`
+	ErrorCode string            `json:"errorcode"`
+	Data      *pb.OrderBookItem `json:"data"`
+}
+
+func (c *Client) GetOrderDetails(reqData *pb.GetOrderDetailsRequest) (*pb.GetOrderDetailsResponse, error) {
+	endpoint := angelOneBaseURL + orderDetailsURLPath + url.PathEscape(reqData.Uniqueorderid)
+	httpReq, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return &pb.GetOrderDetailsResponse{Status: false, Message: "Failed to create order details request", Errorcode: "REQUEST_ERROR"}, nil
+	}
+
+	c.setCommonHeaders(httpReq, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+
+	res, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return &pb.GetOrderDetailsResponse{Status: false, Message: "Failed to execute request to Angel One: " + err.Error(), Errorcode: "HTTP_EXECUTION_ERROR"}, nil
+	}
+
+	var apiResponse AngelOrderDetailsRawResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (GetOrderDetails): Error unmarshalling Angel One response: %v. Body: %s", err, string(body))
+		msg := "Failed to parse Angel One order details response"
+		if res.StatusCode != http.StatusOK {
+			msg = fmt.Sprintf("Angel One API Error: %s (and failed to parse body)", res.Status)
+		}
+		return &pb.GetOrderDetailsResponse{Status: false, Message: msg, Errorcode: "UNMARSHAL_ERROR"}, nil
+	}
+
+	if !apiResponse.Status {
+		if notFoundError(apiResponse.ErrorCode, apiResponse.Message) {
+			return nil, ErrOrderNotFound
+		}
+		// Session errors (AG8001 etc.) must stay in the response so the gateway can refresh and retry.
+		log.Printf("AngelOne Client (GetOrderDetails): Angel One API reported status:false. Message: %s, ErrorCode: %s", apiResponse.Message, apiResponse.ErrorCode)
+		return &pb.GetOrderDetailsResponse{
+			Status:    false,
+			Message:   apiResponse.Message,
+			Errorcode: apiResponse.ErrorCode,
+		}, nil
+	}
+	if apiResponse.Data == nil || apiResponse.Data.Uniqueorderid == "" {
+		return nil, ErrOrderNotFound
+	}
+
+	return &pb.GetOrderDetailsResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Data:      apiResponse.Data,
+	}, nil
+}
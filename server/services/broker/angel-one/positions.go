@@ -0,0 +1,299 @@
+package angelone
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// AngelPositionItem matches one entry of Angel One's getPosition "data" array.
+// Angel One sends all numbers as strings, so they are parsed with flexFloat/flexInt.
+type AngelPositionItem struct {
+	Exchange          string     `json:"exchange"`
+	SymbolToken       string     `json:"symboltoken"`
+	ProductType       string     `json:"producttype"`
+	TradingSymbol     string     `json:"tradingsymbol"`
+	SymbolName        string     `json:"symbolname"`
+	InstrumentType    string     `json:"instrumenttype"`
+	OptionType        string     `json:"optiontype"`
+	StrikePrice       flexFloat  `json:"strikeprice"`
+	ExpiryDate        string     `json:"expirydate"`
+	LotSize           flexInt    `json:"lotsize"`
+	BuyQty            flexInt    `json:"buyqty"`
+	SellQty           flexInt    `json:"sellqty"`
+	BuyAmount         flexFloat  `json:"buyamount"`
+	SellAmount        flexFloat  `json:"sellamount"`
+	BuyAvgPrice       flexFloat  `json:"buyavgprice"`
+	SellAvgPrice      flexFloat  `json:"sellavgprice"`
+	CFBuyQty          flexInt    `json:"cfbuyqty"`
+	CFSellQty         flexInt    `json:"cfsellqty"`
+	CFBuyAvgPrice     flexFloat  `json:"cfbuyavgprice"`
+	CFSellAvgPrice    flexFloat  `json:"cfsellavgprice"`
+	TotalBuyValue     flexFloat  `json:"totalbuyvalue"`
+	TotalSellValue    flexFloat  `json:"totalsellvalue"`
+	TotalBuyAvgPrice  flexFloat  `json:"totalbuyavgprice"`
+	TotalSellAvgPrice flexFloat  `json:"totalsellavgprice"`
+	NetQty            flexInt    `json:"netqty"`
+	NetPrice          flexFloat  `json:"netprice"`
+	NetValue          flexFloat  `json:"netvalue"`
+	LTP               flexFloat  `json:"ltp"`
+	Realised          *flexFloat `json:"realised"`   // Not always sent; computed when missing
+	Unrealised        *flexFloat `json:"unrealised"` // Not always sent; computed when missing
+
+	// Contract details Angel One expects back verbatim when converting the position
+	PriceDen     string `json:"priceden"`
+	PriceNum     string `json:"pricenum"`
+	GenDen       string `json:"genden"`
+	GenNum       string `json:"gennum"`
+	Precision    string `json:"precision"`
+	Multiplier   string `json:"multiplier"`
+	BoardLotSize string `json:"boardlotsize"`
+}
+
+// DayNetQty is today's open quantity: positive for long, negative for short.
+func (p *AngelPositionItem) DayNetQty() int64 {
+	return int64(p.BuyQty - p.SellQty)
+}
+
+// CarryForwardNetQty is the open quantity brought forward from previous sessions.
+func (p *AngelPositionItem) CarryForwardNetQty() int64 {
+	return int64(p.CFBuyQty - p.CFSellQty)
+}
+
+type AngelPositionsRawResponse struct {
+	Status    bool                 `json:"status"`
+	Message   string               `json:"message"`
+	ErrorCode string               `json:"errorcode"`
+	Data      []*AngelPositionItem `json:"data"`
+}
+
+// realisedPnL is the P&L of the quantity bought and sold back.
+func realisedPnL(buyQty, sellQty int64, buyAvg, sellAvg float64) float64 {
+	closedQty := math.Min(float64(buyQty), float64(sellQty))
+	return closedQty * (sellAvg - buyAvg)
+}
+
+// unrealisedPnL marks the open quantity to the last traded price.
+func unrealisedPnL(netQty int64, avgPrice, ltp float64) float64 {
+	if ltp == 0 || netQty == 0 {
+		return 0
+	}
+	return float64(netQty) * (ltp - avgPrice)
+}
+
+// netPosition maps the overall position, including carried forward quantity.
+func (p *AngelPositionItem) netPosition() *pb.PositionItem {
+	item := p.basePosition()
+	item.Buyqty = int64(p.CFBuyQty + p.BuyQty)
+	item.Sellqty = int64(p.CFSellQty + p.SellQty)
+	item.Netqty = int64(p.NetQty)
+	item.Buyavgprice = float64(p.TotalBuyAvgPrice)
+	item.Sellavgprice = float64(p.TotalSellAvgPrice)
+	item.Netprice = float64(p.NetPrice)
+	item.Buyamount = float64(p.TotalBuyValue)
+	item.Sellamount = float64(p.TotalSellValue)
+	item.Netvalue = float64(p.NetValue)
+	item.Cfbuyqty = int64(p.CFBuyQty)
+	item.Cfsellqty = int64(p.CFSellQty)
+	item.Cfbuyavgprice = float64(p.CFBuyAvgPrice)
+	item.Cfsellavgprice = float64(p.CFSellAvgPrice)
+
+	if p.Realised != nil {
+		item.Realised = float64(*p.Realised)
+	} else {
+		item.Realised = realisedPnL(item.Buyqty, item.Sellqty, item.Buyavgprice, item.Sellavgprice)
+	}
+	if p.Unrealised != nil {
+		item.Unrealised = float64(*p.Unrealised)
+	} else {
+		item.Unrealised = unrealisedPnL(item.Netqty, item.Netprice, item.Ltp)
+	}
+	return item
+}
+
+// dayPosition maps only today's buys and sells, or returns nil if there were none.
+func (p *AngelPositionItem) dayPosition() *pb.PositionItem {
+	if p.BuyQty == 0 && p.SellQty == 0 {
+		return nil
+	}
+	item := p.basePosition()
+	item.Buyqty = int64(p.BuyQty)
+	item.Sellqty = int64(p.SellQty)
+	item.Netqty = item.Buyqty - item.Sellqty
+	item.Buyavgprice = float64(p.BuyAvgPrice)
+	item.Sellavgprice = float64(p.SellAvgPrice)
+	item.Buyamount = float64(p.BuyAmount)
+	item.Sellamount = float64(p.SellAmount)
+	item.Netvalue = item.Sellamount - item.Buyamount
+	switch {
+	case item.Netqty > 0:
+		item.Netprice = item.Buyavgprice
+	case item.Netqty < 0:
+		item.Netprice = item.Sellavgprice
+	}
+	item.Realised = realisedPnL(item.Buyqty, item.Sellqty, item.Buyavgprice, item.Sellavgprice)
+	item.Unrealised = unrealisedPnL(item.Netqty, item.Netprice, item.Ltp)
+	return item
+}
+
+func (p *AngelPositionItem) basePosition() *pb.PositionItem {
+	return &pb.PositionItem{
+		Exchange:       p.Exchange,
+		Symboltoken:    p.SymbolToken,
+		Tradingsymbol:  p.TradingSymbol,
+		Symbolname:     p.SymbolName,
+		Instrumenttype: p.InstrumentType,
+		Producttype:    p.ProductType,
+		Optiontype:     p.OptionType,
+		Strikeprice:    float64(p.StrikePrice),
+		Expirydate:     p.ExpiryDate,
+		Lotsize:        int32(p.LotSize),
+		Ltp:            float64(p.LTP),
+	}
+}
+
+// FetchPositions returns Angel One's raw positions, for callers that need the
+// unparsed contract details (e.g. position conversion). Transport and parse
+// failures are reported as a status:false response like the other client calls.
+func (c *Client) FetchPositions(authToken, clientLocalIP, clientPublicIP, macAddress string) *AngelPositionsRawResponse {
+	url := angelOneBaseURL + positionsURLPath
+	httpReq, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return &AngelPositionsRawResponse{Status: false, Message: "Failed to create positions request", ErrorCode: "REQUEST_ERROR"}
+	}
+
+	c.setCommonHeaders(httpReq, authToken, clientLocalIP, clientPublicIP, macAddress)
+
+	res, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return &AngelPositionsRawResponse{Status: false, Message: "Failed to execute request to Angel One: " + err.Error(), ErrorCode: "HTTP_EXECUTION_ERROR"}
+	}
+
+	var apiResponse AngelPositionsRawResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (GetPositions): Error unmarshalling Angel One response: %v. Body: %s", err, string(body))
+		msg := "Failed to parse Angel One positions response"
+		if res.StatusCode != http.StatusOK {
+			msg = fmt.Sprintf("Angel One API Error: %s (and failed to parse body)", res.Status)
+		}
+		return &AngelPositionsRawResponse{Status: false, Message: msg, ErrorCode: "UNMARSHAL_ERROR"}
+	}
+	return &apiResponse
+}
+
+func (c *Client) GetPositions(reqData *pb.GetPositionsRequest) (*pb.GetPositionsResponse, error) {
+	apiResponse := c.FetchPositions(reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+
+	if !apiResponse.Status {
+		log.Printf("AngelOne Client (GetPositions): Angel One API reported status:false. Message: %s, ErrorCode: %s", apiResponse.Message, apiResponse.ErrorCode)
+		return &pb.GetPositionsResponse{
+			Status:    false,
+			Message:   apiResponse.Message,
+			Errorcode: apiResponse.ErrorCode,
+		}, nil
+	}
+
+	// Angel One sends "data": null when there are no positions
+	data := &pb.PositionsData{Net: []*pb.PositionItem{}, Day: []*pb.PositionItem{}}
+	for _, raw := range apiResponse.Data {
+		if raw == nil {
+			continue
+		}
+		data.Net = append(data.Net, raw.netPosition())
+		if day := raw.dayPosition(); day != nil {
+			data.Day = append(data.Day, day)
+		}
+	}
+
+	return &pb.GetPositionsResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Data:      data,
+	}, nil
+}
+
+// --- Convert Position ---
+type AngelConvertPositionPayload struct {
+	Exchange        string `json:"exchange"`
+	SymbolToken     string `json:"symboltoken"`
+	OldProductType  string `json:"oldproducttype"`
+	NewProductType  string `json:"newproducttype"`
+	TradingSymbol   string `json:"tradingsymbol"`
+	SymbolName      string `json:"symbolname"`
+	InstrumentType  string `json:"instrumenttype"`
+	PriceDen        string `json:"priceden"`
+	PriceNum        string `json:"pricenum"`
+	GenDen          string `json:"genden"`
+	GenNum          string `json:"gennum"`
+	Precision       string `json:"precision"`
+	Multiplier      string `json:"multiplier"`
+	BoardLotSize    string `json:"boardlotsize"`
+	BuyQty          int64  `json:"buyqty"`
+	SellQty         int64  `json:"sellqty"`
+	BuyAmount       string `json:"buyamount"`
+	SellAmount      string `json:"sellamount"`
+	TransactionType string `json:"transactiontype"`
+	Quantity        int32  `json:"quantity"`
+	Type            string `json:"type"`
+}
+
+// ConvertPosition converts (part of) the given position to another product type.
+// The position must be the one returned by FetchPositions for the same instrument.
+func (c *Client) ConvertPosition(reqData *pb.ConvertPositionRequest, position *AngelPositionItem) (*pb.ConvertPositionResponse, error) {
+	url := angelOneBaseURL + convertPositionURLPath
+	payload := AngelConvertPositionPayload{
+		Exchange:        reqData.Exchange,
+		SymbolToken:     reqData.Symboltoken,
+		OldProductType:  reqData.Oldproducttype,
+		NewProductType:  reqData.Newproducttype,
+		TradingSymbol:   reqData.Tradingsymbol,
+		SymbolName:      position.SymbolName,
+		InstrumentType:  position.InstrumentType,
+		PriceDen:        position.PriceDen,
+		PriceNum:        position.PriceNum,
+		GenDen:          position.GenDen,
+		GenNum:          position.GenNum,
+		Precision:       position.Precision,
+		Multiplier:      position.Multiplier,
+		BoardLotSize:    position.BoardLotSize,
+		BuyQty:          int64(position.BuyQty),
+		SellQty:         int64(position.SellQty),
+		BuyAmount:       fmt.Sprintf("%.2f", float64(position.BuyAmount)),
+		SellAmount:      fmt.Sprintf("%.2f", float64(position.SellAmount)),
+		TransactionType: reqData.Transactiontype,
+		Quantity:        reqData.Quantity,
+		Type:            reqData.Type,
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling convert position payload: %w", err)
+	}
+
+	httpReq, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		return nil, fmt.Errorf("creating convert position request: %w", err)
+	}
+	c.setCommonHeaders(httpReq, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+
+	_, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResponse AngelOneGenericResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (ConvertPosition): Error unmarshalling response: %v. Body: %s", err, string(body))
+		return &pb.ConvertPositionResponse{Status: false, Message: "Failed to parse Angel One response", Errorcode: "UNMARSHAL_ERROR"}, nil
+	}
+	return &pb.ConvertPositionResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+	}, nil
+}
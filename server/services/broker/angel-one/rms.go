@@ -0,0 +1,96 @@
+package angelone
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// AngelRMSLimits matches the "data" object of Angel One's getRMS response.
+// Every amount is sent as a string (or null), so they are parsed with flexFloat.
+type AngelRMSLimits struct {
+	Net                    flexFloat `json:"net"`
+	AvailableCash          flexFloat `json:"availablecash"`
+	AvailableIntradayPayIn flexFloat `json:"availableintradaypayin"`
+	AvailableLimitMargin   flexFloat `json:"availablelimitmargin"`
+	Collateral             flexFloat `json:"collateral"`
+	M2MUnrealized          flexFloat `json:"m2munrealized"`
+	M2MRealized            flexFloat `json:"m2mrealized"`
+	UtilisedDebits         flexFloat `json:"utiliseddebits"`
+	UtilisedSpan           flexFloat `json:"utilisedspan"`
+	UtilisedOptionPremium  flexFloat `json:"utilisedoptionpremium"`
+	UtilisedHoldingSales   flexFloat `json:"utilisedholdingsales"`
+	UtilisedExposure       flexFloat `json:"utilisedexposure"`
+	UtilisedTurnover       flexFloat `json:"utilisedturnover"`
+	UtilisedPayout         flexFloat `json:"utilisedpayout"`
+}
+
+type AngelRMSRawResponse struct {
+	Status    bool            `json:"status"`
+	Message   string          `json:"message"`
+	ErrorCode string          `json:"errorcode"`
+	Data      *AngelRMSLimits `json:"data"`
+}
+
+func (r *AngelRMSLimits) toProto() *pb.RMSLimits {
+	return &pb.RMSLimits{
+		Net:                    float64(r.Net),
+		Availablecash:          float64(r.AvailableCash),
+		Availableintradaypayin: float64(r.AvailableIntradayPayIn),
+		Availablelimitmargin:   float64(r.AvailableLimitMargin),
+		Collateral:             float64(r.Collateral),
+		M2Munrealized:          float64(r.M2MUnrealized),
+		M2Mrealized:            float64(r.M2MRealized),
+		Utiliseddebits:         float64(r.UtilisedDebits),
+		Utilisedspan:           float64(r.UtilisedSpan),
+		Utilisedoptionpremium:  float64(r.UtilisedOptionPremium),
+		Utilisedholdingsales:   float64(r.UtilisedHoldingSales),
+		Utilisedexposure:       float64(r.UtilisedExposure),
+		Utilisedturnover:       float64(r.UtilisedTurnover),
+		Utilisedpayout:         float64(r.UtilisedPayout),
+	}
+}
+
+func (c *Client) GetRMSLimits(reqData *pb.GetRMSLimitsRequest) (*pb.GetRMSLimitsResponse, error) {
+	url := angelOneBaseURL + rmsURLPath
+	httpReq, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return &pb.GetRMSLimitsResponse{Status: false, Message: "Failed to create RMS request", Errorcode: "REQUEST_ERROR"}, nil
+	}
+
+	c.setCommonHeaders(httpReq, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+
+	res, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return &pb.GetRMSLimitsResponse{Status: false, Message: "Failed to execute request to Angel One: " + err.Error(), Errorcode: "HTTP_EXECUTION_ERROR"}, nil
+	}
+
+	var apiResponse AngelRMSRawResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (GetRMSLimits): Error unmarshalling Angel One response: %v. Body: %s", err, string(body))
+		msg := "Failed to parse Angel One RMS response"
+		if res.StatusCode != http.StatusOK {
+			msg = fmt.Sprintf("Angel One API Error: %s (and failed to parse body)", res.Status)
+		}
+		return &pb.GetRMSLimitsResponse{Status: false, Message: msg, Errorcode: "UNMARSHAL_ERROR"}, nil
+	}
+
+	if !apiResponse.Status || apiResponse.Data == nil {
+		log.Printf("AngelOne Client (GetRMSLimits): Angel One API reported status:%t with no data. Message: %s, ErrorCode: %s", apiResponse.Status, apiResponse.Message, apiResponse.ErrorCode)
+		return &pb.GetRMSLimitsResponse{
+			Status:    false,
+			Message:   apiResponse.Message,
+			Errorcode: apiResponse.ErrorCode,
+		}, nil
+	}
+
+	return &pb.GetRMSLimitsResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Data:      apiResponse.Data.toProto(),
+	}, nil
+}
@@ -0,0 +1,128 @@
+package angelone
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// AngelTradeBookItem matches one entry of Angel One's getTradeBook "data" array.
+// Like positions, the numbers arrive as strings.
+type AngelTradeBookItem struct {
+	OrderID         string    `json:"orderid"`
+	FillID          string    `json:"fillid"`
+	FillTime        string    `json:"filltime"`
+	FillPrice       flexFloat `json:"fillprice"`
+	FillSize        flexInt   `json:"fillsize"`
+	TradeValue      flexFloat `json:"tradevalue"`
+	TransactionType string    `json:"transactiontype"`
+	Exchange        string    `json:"exchange"`
+	ProductType     string    `json:"producttype"`
+	TradingSymbol   string    `json:"tradingsymbol"`
+	InstrumentType  string    `json:"instrumenttype"`
+	SymbolGroup     string    `json:"symbolgroup"`
+	StrikePrice     flexFloat `json:"strikeprice"`
+	OptionType      string    `json:"optiontype"`
+	ExpiryDate      string    `json:"expirydate"`
+	MarketLot       flexInt   `json:"marketlot"`
+	Precision       flexInt   `json:"precision"`
+	Multiplier      flexInt   `json:"multiplier"`
+}
+
+type AngelTradeBookRawResponse struct {
+	Status    bool                  `json:"status"`
+	Message   string                `json:"message"`
+	ErrorCode string                `json:"errorcode"`
+	Data      []*AngelTradeBookItem `json:"data"`
+}
+
+func (t *AngelTradeBookItem) toProto() *pb.TradeBookItem {
+	return &pb.TradeBookItem{
+		Orderid:         t.OrderID,
+		Fillid:          t.FillID,
+		Filltime:        t.FillTime,
+		Fillprice:       float64(t.FillPrice),
+		Fillsize:        int64(t.FillSize),
+		Tradevalue:      float64(t.TradeValue),
+		Transactiontype: t.TransactionType,
+		Exchange:        t.Exchange,
+		Producttype:     t.ProductType,
+		Tradingsymbol:   t.TradingSymbol,
+		Instrumenttype:  t.InstrumentType,
+		Symbolgroup:     t.SymbolGroup,
+		Strikeprice:     float64(t.StrikePrice),
+		Optiontype:      t.OptionType,
+		Expirydate:      t.ExpiryDate,
+		Marketlot:       int64(t.MarketLot),
+		Precision:       int32(t.Precision),
+		Multiplier:      int32(t.Multiplier),
+	}
+}
+
+func (c *Client) GetTradeBook(reqData *pb.GetTradeBookRequest) (*pb.GetTradeBookResponse, error) {
+	url := angelOneBaseURL + tradeBookURLPath
+	httpReq, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return &pb.GetTradeBookResponse{Status: false, Message: "Failed to create trade book request", Errorcode: "REQUEST_ERROR"}, nil
+	}
+
+	c.setCommonHeaders(httpReq, reqData.AngelOneJwt, reqData.ClientLocalIp, reqData.ClientPublicIp, reqData.MacAddress)
+
+	res, body, err := c.doRequest(httpReq)
+	if err != nil {
+		return &pb.GetTradeBookResponse{Status: false, Message: "Failed to execute request to Angel One: " + err.Error(), Errorcode: "HTTP_EXECUTION_ERROR"}, nil
+	}
+
+	var apiResponse AngelTradeBookRawResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		log.Printf("AngelOne Client (GetTradeBook): Error unmarshalling Angel One response: %v. Body: %s", err, string(body))
+		msg := "Failed to parse Angel One trade book response"
+		if res.StatusCode != http.StatusOK {
+			msg = fmt.Sprintf("Angel One API Error: %s (and failed to parse body)", res.Status)
+		}
+		return &pb.GetTradeBookResponse{Status: false, Message: msg, Errorcode: "UNMARSHAL_ERROR"}, nil
+	}
+
+	if !apiResponse.Status {
+		log.Printf("AngelOne Client (GetTradeBook): Angel One API reported status:false. Message: %s, ErrorCode: %s", apiResponse.Message, apiResponse.ErrorCode)
+		return &pb.GetTradeBookResponse{
+			Status:    false,
+			Message:   apiResponse.Message,
+			Errorcode: apiResponse.ErrorCode,
+		}, nil
+	}
+
+	// Angel One sends "data": null when nothing has been filled today
+	trades := make([]*pb.TradeBookItem, 0, len(apiResponse.Data))
+	for _, raw := range apiResponse.Data {
+		if raw != nil {
+			trades = append(trades, raw.toProto())
+		}
+	}
+
+	return &pb.GetTradeBookResponse{
+		Status:    apiResponse.Status,
+		Message:   apiResponse.Message,
+		Errorcode: apiResponse.ErrorCode,
+		Data:      trades,
+	}, nil
+}
+
+// JoinTrades attaches each trade to the order with the same orderid. Trades
+// for orders missing from the book are dropped.
+func JoinTrades(orders []*pb.OrderBookItem, trades []*pb.TradeBookItem) {
+	byOrderID := make(map[string]*pb.OrderBookItem, len(orders))
+	for _, order := range orders {
+		if order != nil {
+			byOrderID[order.Orderid] = order
+		}
+	}
+	for _, trade := range trades {
+		if order, ok := byOrderID[trade.Orderid]; ok {
+			order.Trades = append(order.Trades, trade)
+		}
+	}
+}
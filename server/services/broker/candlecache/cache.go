@@ -0,0 +1,360 @@
+// Package candlecache keeps historical candles in a local BoltDB file so
+// repeated chart loads only fetch the ranges Angel One has not served yet.
+//
+// Each series (exchange, symbol token and interval) records the candles it
+// holds and the time ranges that have been fetched, so ranges without candles
+// (holidays, non-trading hours) are not requested again.
+package candlecache
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	seriesBucket   = []byte("series")
+	candlesBucket  = []byte("candles")
+	coverageBucket = []byte("coverage")
+)
+
+// intervalDurations is the length of one candle per interval. A candle is only
+// final once its interval has passed, so newer ranges are never marked covered.
+var intervalDurations = map[pb.CandleInterval]time.Duration{
+	pb.CandleInterval_ONE_MINUTE:     time.Minute,
+	pb.CandleInterval_THREE_MINUTE:   3 * time.Minute,
+	pb.CandleInterval_FIVE_MINUTE:    5 * time.Minute,
+	pb.CandleInterval_TEN_MINUTE:     10 * time.Minute,
+	pb.CandleInterval_FIFTEEN_MINUTE: 15 * time.Minute,
+	pb.CandleInterval_THIRTY_MINUTE:  30 * time.Minute,
+	pb.CandleInterval_ONE_HOUR:       time.Hour,
+	pb.CandleInterval_ONE_DAY:        24 * time.Hour,
+}
+
+// SeriesKey identifies one cached candle series.
+type SeriesKey struct {
+	Exchange    string
+	Symboltoken string
+	Interval    pb.CandleInterval
+}
+
+func (k SeriesKey) bucketName() []byte {
+	return []byte(symbolPrefix(k.Exchange, k.Symboltoken) + k.Interval.String())
+}
+
+func symbolPrefix(exchange, symboltoken string) string {
+	return exchange + "|" + symboltoken + "|"
+}
+
+// Range is an inclusive time range.
+type Range struct {
+	From, To time.Time
+}
+
+// Cache is a BoltDB-backed candle store.
+type Cache struct {
+	db *bolt.DB
+
+	hits        atomic.Int64
+	partialHits atomic.Int64
+	misses      atomic.Int64
+}
+
+// Open opens (or creates) the cache file at path. BoltDB holds an exclusive
+// lock on the file, so only one broker process can use it at a time.
+func Open(path string) (*Cache, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening candle cache %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(seriesBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating candle cache bucket: %w", err)
+	}
+
+	return &Cache{db: db}, nil
+}
+
+func (c *Cache) Close() error {
+	return c.db.Close()
+}
+
+// Missing returns the parts of [from, to] that have not been fetched yet,
+// oldest first, and counts the lookup as a hit, partial hit or miss.
+func (c *Cache) Missing(key SeriesKey, from, to time.Time) ([]Range, error) {
+	var covered []Range
+	err := c.db.View(func(tx *bolt.Tx) error {
+		series := tx.Bucket(seriesBucket).Bucket(key.bucketName())
+		if series == nil {
+			return nil
+		}
+		var err error
+		covered, err = readCoverage(series.Bucket(coverageBucket))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading candle coverage: %w", err)
+	}
+
+	missing := gaps(covered, Range{From: from, To: to})
+	switch {
+	case len(missing) == 0:
+		c.hits.Add(1)
+	case len(missing) == 1 && missing[0] == (Range{From: from, To: to}):
+		c.misses.Add(1)
+	default:
+		c.partialHits.Add(1)
+	}
+	return missing, nil
+}
+
+// Put stores candles fetched for the fetched range and marks the range as
+// covered up to the last candle that can no longer change.
+func (c *Cache) Put(key SeriesKey, candles []*pb.Candle, fetched Range, now time.Time) error {
+	if d, ok := intervalDurations[key.Interval]; ok && fetched.To.After(now.Add(-d)) {
+		fetched.To = now.Add(-d)
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		series, err := tx.Bucket(seriesBucket).CreateBucketIfNotExists(key.bucketName())
+		if err != nil {
+			return err
+		}
+		candleBucket, err := series.CreateBucketIfNotExists(candlesBucket)
+		if err != nil {
+			return err
+		}
+		coverage, err := series.CreateBucketIfNotExists(coverageBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, candle := range candles {
+			t, err := time.Parse(time.RFC3339, candle.Timestamp)
+			if err != nil {
+				continue // MergeCandles already drops these
+			}
+			data, err := proto.Marshal(candle)
+			if err != nil {
+				return fmt.Errorf("marshalling candle: %w", err)
+			}
+			if err := candleBucket.Put(unixKey(t), data); err != nil {
+				return err
+			}
+		}
+
+		if fetched.To.Before(fetched.From) {
+			return nil // Nothing final yet
+		}
+		covered, err := readCoverage(coverage)
+		if err != nil {
+			return err
+		}
+		return writeCoverage(coverage, mergeRanges(append(covered, fetched)))
+	})
+}
+
+// Candles returns the cached candles in [from, to], oldest first.
+func (c *Cache) Candles(key SeriesKey, from, to time.Time) ([]*pb.Candle, error) {
+	candles := []*pb.Candle{}
+	err := c.db.View(func(tx *bolt.Tx) error {
+		series := tx.Bucket(seriesBucket).Bucket(key.bucketName())
+		if series == nil || series.Bucket(candlesBucket) == nil {
+			return nil
+		}
+		cursor := series.Bucket(candlesBucket).Cursor()
+		end := unixKey(to)
+		for k, v := cursor.Seek(unixKey(from)); k != nil && bytes.Compare(k, end) <= 0; k, v = cursor.Next() {
+			var candle pb.Candle
+			if err := proto.Unmarshal(v, &candle); err != nil {
+				return fmt.Errorf("unmarshalling candle: %w", err)
+			}
+			candles = append(candles, &candle)
+		}
+		return nil
+	})
+	return candles, err
+}
+
+// Stats summarises the cache contents and lookup counters.
+func (c *Cache) Stats() (*pb.GetCandleCacheStatsResponse, error) {
+	stats := &pb.GetCandleCacheStatsResponse{
+		Enabled:     true,
+		Hits:        c.hits.Load(),
+		PartialHits: c.partialHits.Load(),
+		Misses:      c.misses.Load(),
+	}
+	err := c.db.View(func(tx *bolt.Tx) error {
+		stats.SizeBytes = tx.Size()
+		return tx.Bucket(seriesBucket).ForEachBucket(func(name []byte) error {
+			series := tx.Bucket(seriesBucket).Bucket(name)
+			parts := strings.SplitN(string(name), "|", 3)
+			if len(parts) != 3 {
+				return nil
+			}
+			seriesStats := &pb.CandleSeriesStats{
+				Exchange:    parts[0],
+				Symboltoken: parts[1],
+				Interval:    pb.CandleInterval(pb.CandleInterval_value[parts[2]]),
+			}
+			if candleBucket := series.Bucket(candlesBucket); candleBucket != nil {
+				seriesStats.Candles = int64(candleBucket.Stats().KeyN)
+				cursor := candleBucket.Cursor()
+				if k, _ := cursor.First(); k != nil {
+					seriesStats.First = keyTime(k).Format(time.RFC3339)
+				}
+				if k, _ := cursor.Last(); k != nil {
+					seriesStats.Last = keyTime(k).Format(time.RFC3339)
+				}
+			}
+			covered, err := readCoverage(series.Bucket(coverageBucket))
+			if err != nil {
+				return err
+			}
+			seriesStats.CoveredRanges = int32(len(covered))
+
+			stats.Series++
+			stats.Candles += seriesStats.Candles
+			stats.SeriesStats = append(stats.SeriesStats, seriesStats)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading candle cache stats: %w", err)
+	}
+	return stats, nil
+}
+
+// Purge removes every interval cached for one symbol.
+func (c *Cache) Purge(exchange, symboltoken string) (seriesRemoved int32, candlesRemoved int64, err error) {
+	prefix := []byte(symbolPrefix(exchange, symboltoken))
+	err = c.db.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket(seriesBucket)
+		var names [][]byte
+		cursor := root.Cursor()
+		for k, v := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			if v == nil { // Nested buckets have nil values
+				names = append(names, append([]byte(nil), k...))
+			}
+		}
+		for _, name := range names {
+			if candleBucket := root.Bucket(name).Bucket(candlesBucket); candleBucket != nil {
+				candlesRemoved += int64(candleBucket.Stats().KeyN)
+			}
+			if err := root.DeleteBucket(name); err != nil {
+				return err
+			}
+			seriesRemoved++
+		}
+		return nil
+	})
+	return seriesRemoved, candlesRemoved, err
+}
+
+func unixKey(t time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t.Unix()))
+	return key
+}
+
+func keyTime(key []byte) time.Time {
+	return time.Unix(int64(binary.BigEndian.Uint64(key)), 0).In(angelone.IST)
+}
+
+// Coverage is stored as from -> to, both as big-endian unix seconds.
+func readCoverage(bucket *bolt.Bucket) ([]Range, error) {
+	if bucket == nil {
+		return nil, nil
+	}
+	var ranges []Range
+	err := bucket.ForEach(func(k, v []byte) error {
+		if len(k) != 8 || len(v) != 8 {
+			return fmt.Errorf("corrupt coverage entry")
+		}
+		ranges = append(ranges, Range{From: keyTime(k), To: keyTime(v)})
+		return nil
+	})
+	return ranges, err
+}
+
+func writeCoverage(bucket *bolt.Bucket, ranges []Range) error {
+	var keys [][]byte
+	if err := bucket.ForEach(func(k, _ []byte) error {
+		keys = append(keys, append([]byte(nil), k...))
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+	}
+	for _, r := range ranges {
+		if err := bucket.Put(unixKey(r.From), unixKey(r.To)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeRanges sorts ranges and joins those that overlap or are at most a
+// minute apart, the finest candle resolution.
+func mergeRanges(ranges []Range) []Range {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].From.Before(ranges[j].From) })
+
+	merged := []Range{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.From.After(last.To.Add(time.Minute)) {
+			merged = append(merged, r)
+			continue
+		}
+		if r.To.After(last.To) {
+			last.To = r.To
+		}
+	}
+	return merged
+}
+
+// gaps returns the parts of want that are not in covered.
+func gaps(covered []Range, want Range) []Range {
+	var missing []Range
+	cursor := want.From // Everything before cursor is covered or already reported
+	for _, r := range mergeRanges(covered) {
+		if r.To.Before(cursor) {
+			continue
+		}
+		if r.From.After(want.To) {
+			break
+		}
+		if r.From.After(cursor) {
+			missing = append(missing, Range{From: cursor, To: r.From})
+		}
+		if r.To.After(cursor) {
+			cursor = r.To
+		}
+	}
+	if cursor.Before(want.To) {
+		missing = append(missing, Range{From: cursor, To: want.To})
+	}
+	return missing
+}
@@ -2,6 +2,11 @@ package config
 
 import (
 	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/Sagar-v4/Angel-Two/services/broker/instruments"
 )
 
 type Config struct {
@@ -10,14 +15,55 @@ type Config struct {
 	// Default values for other Angel One headers if they are constant
 	AngelOneUserType string
 	AngelOneSourceID string
+
+	// BoltDB files are kept under BROKER_DATA_DIR (default "data") unless
+	// their own path is set.
+	CandleCachePath string // BoltDB file for cached candles; empty disables the cache
+
+	InstrumentMasterURL   string        // Angel One scrip master JSON; empty disables instrument search
+	InstrumentRefreshTime time.Duration // Time of day (IST) the master is downloaded again
+
+	TickBufferSize int // Ticks a slow SubscribeTicks client may fall behind before the oldest are dropped
+
+	RulesDBPath      string        // BoltDB file for conditional order rules; empty disables them
+	RulePollInterval time.Duration // How often rule prices are polled when ticks are unavailable
+
+	AlertsDBPath      string        // BoltDB file for price alerts and webhooks; empty disables them
+	AlertPollInterval time.Duration // How often price alerts are evaluated
+
+	BracketsDBPath      string        // BoltDB file for bracket orders; empty disables them
+	BracketPollInterval time.Duration // How often the order book is polled for bracket legs
 }
 
 func Load() *Config {
+	refreshTime, err := time.Parse("15:04", getEnv("INSTRUMENT_REFRESH_TIME", "08:30"))
+	if err != nil {
+		refreshTime, _ = time.Parse("15:04", "08:30")
+	}
+
+	dataDir := getEnv("BROKER_DATA_DIR", "data")
+
 	return &Config{
 		GRPCPort:         getEnv("GRPC_PORT", "50052"),
 		AngelOneAPIKey:   getEnv("ANGELONE_API_KEY", "YOUR_ANGELONE_PRIVATE_API_KEY"), // Store securely!
 		AngelOneUserType: getEnv("ANGELONE_USER_TYPE", "USER"),
 		AngelOneSourceID: getEnv("ANGELONE_SOURCE_ID", "WEB"),
+
+		CandleCachePath: getEnv("CANDLE_CACHE_PATH", filepath.Join(dataDir, "candles.db")),
+
+		InstrumentMasterURL:   getEnv("INSTRUMENT_MASTER_URL", instruments.DefaultMasterURL),
+		InstrumentRefreshTime: time.Duration(refreshTime.Hour())*time.Hour + time.Duration(refreshTime.Minute())*time.Minute,
+
+		TickBufferSize: getIntEnv("TICK_BUFFER_SIZE", 512),
+
+		RulesDBPath:      getEnv("RULES_DB_PATH", filepath.Join(dataDir, "rules.db")),
+		RulePollInterval: time.Duration(getIntEnv("RULE_POLL_SECONDS", 5)) * time.Second,
+
+		AlertsDBPath:      getEnv("ALERTS_DB_PATH", filepath.Join(dataDir, "alerts.db")),
+		AlertPollInterval: time.Duration(getIntEnv("ALERT_POLL_SECONDS", 10)) * time.Second,
+
+		BracketsDBPath:      getEnv("BRACKETS_DB_PATH", filepath.Join(dataDir, "brackets.db")),
+		BracketPollInterval: time.Duration(getIntEnv("BRACKET_POLL_SECONDS", 10)) * time.Second,
 	}
 }
 
@@ -27,3 +73,12 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getIntEnv(key string, fallback int) int {
+	if valueStr, exists := os.LookupEnv(key); exists {
+		if value, err := strconv.Atoi(valueStr); err == nil {
+			return value
+		}
+	}
+	return fallback
+}
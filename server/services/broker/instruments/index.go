@@ -0,0 +1,128 @@
+package instruments
+
+import (
+	"sort"
+	"strings"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// index is an immutable view of one instrument master download.
+type index struct {
+	all         []entry
+	byToken     map[string]*pb.Instrument   // exchange|token
+	byBareToken map[string][]*pb.Instrument // token on any exchange
+	bySymbol    map[string]*pb.Instrument   // exchange|TRADINGSYMBOL
+}
+
+// entry keeps upper-cased copies of the searchable fields.
+type entry struct {
+	instrument *pb.Instrument
+	symbol     string
+	name       string
+}
+
+func exchangeKey(exchange, value string) string {
+	return strings.ToUpper(exchange) + "|" + value
+}
+
+func newIndex(instruments []*pb.Instrument) *index {
+	idx := &index{
+		all:         make([]entry, 0, len(instruments)),
+		byToken:     make(map[string]*pb.Instrument, len(instruments)),
+		byBareToken: make(map[string][]*pb.Instrument, len(instruments)),
+		bySymbol:    make(map[string]*pb.Instrument, len(instruments)),
+	}
+	for _, inst := range instruments {
+		symbol := strings.ToUpper(inst.Tradingsymbol)
+		idx.all = append(idx.all, entry{instrument: inst, symbol: symbol, name: strings.ToUpper(inst.Name)})
+		idx.byToken[exchangeKey(inst.Exchange, inst.Symboltoken)] = inst
+		idx.byBareToken[inst.Symboltoken] = append(idx.byBareToken[inst.Symboltoken], inst)
+		idx.bySymbol[exchangeKey(inst.Exchange, symbol)] = inst
+	}
+	return idx
+}
+
+// Match quality, best first.
+const (
+	matchExact = iota
+	matchSymbolPrefix
+	matchNamePrefix
+	matchSubstring
+	matchFuzzy
+	noMatch
+)
+
+func matchScore(e *entry, query string) int {
+	switch {
+	case e.symbol == query || e.name == query:
+		return matchExact
+	case strings.HasPrefix(e.symbol, query):
+		return matchSymbolPrefix
+	case strings.HasPrefix(e.name, query):
+		return matchNamePrefix
+	case strings.Contains(e.symbol, query) || strings.Contains(e.name, query):
+		return matchSubstring
+	case isSubsequence(query, e.symbol) || isSubsequence(query, e.name):
+		return matchFuzzy
+	}
+	return noMatch
+}
+
+// isSubsequence reports whether the characters of query appear in s in order,
+// so e.g. "HDFCBK" finds "HDFCBANK-EQ".
+func isSubsequence(query, s string) bool {
+	i := 0
+	for j := 0; i < len(query) && j < len(s); j++ {
+		if query[i] == s[j] {
+			i++
+		}
+	}
+	return i == len(query)
+}
+
+func (idx *index) search(query, exchange, instrumentType string, limit int) []*pb.Instrument {
+	query = strings.ToUpper(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+
+	type scored struct {
+		e     *entry
+		score int
+	}
+	var matches []scored
+	for i := range idx.all {
+		e := &idx.all[i]
+		if exchange != "" && !strings.EqualFold(e.instrument.Exchange, exchange) {
+			continue
+		}
+		if instrumentType != "" && !strings.EqualFold(e.instrument.Instrumenttype, instrumentType) {
+			continue
+		}
+		if score := matchScore(e, query); score != noMatch {
+			matches = append(matches, scored{e, score})
+		}
+	}
+
+	// Better matches first, then shorter symbols (the underlying before its derivatives).
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.score != b.score {
+			return a.score < b.score
+		}
+		if len(a.e.symbol) != len(b.e.symbol) {
+			return len(a.e.symbol) < len(b.e.symbol)
+		}
+		return a.e.symbol < b.e.symbol
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	results := make([]*pb.Instrument, len(matches))
+	for i, m := range matches {
+		results[i] = m.e.instrument
+	}
+	return results
+}
@@ -0,0 +1,233 @@
+// Package instruments downloads Angel One's instrument master (the
+// OpenAPIScripMaster JSON) once a day and indexes it in memory, so symbols can
+// be searched and resolved without knowing their numeric tokens.
+package instruments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// DefaultMasterURL is where Angel One publishes the instrument master.
+const DefaultMasterURL = "https://margincalculator.angelbroking.com/OpenAPI_File/files/OpenAPIScripMaster.json"
+
+// retryInterval is how long to wait before retrying a failed download.
+const retryInterval = 15 * time.Minute
+
+// ErrNotLoaded is returned while the first download is still in progress or has failed.
+var ErrNotLoaded = errors.New("instrument master not loaded yet")
+
+var ist = time.FixedZone("IST", 5*60*60+30*60)
+
+// rawInstrument is one entry of the scrip master. Every value is a string;
+// strike and tick_size are in paise.
+type rawInstrument struct {
+	Token          string `json:"token"`
+	Symbol         string `json:"symbol"`
+	Name           string `json:"name"`
+	Expiry         string `json:"expiry"`
+	Strike         string `json:"strike"`
+	LotSize        string `json:"lotsize"`
+	InstrumentType string `json:"instrumenttype"`
+	ExchSeg        string `json:"exch_seg"`
+	TickSize       string `json:"tick_size"`
+}
+
+func (r *rawInstrument) toProto() *pb.Instrument {
+	strike, _ := strconv.ParseFloat(r.Strike, 64)
+	if strike < 0 {
+		strike = 0 // -1 for instruments without a strike
+	}
+	lotSize, _ := strconv.ParseFloat(r.LotSize, 64)
+	tickSize, _ := strconv.ParseFloat(r.TickSize, 64)
+	return &pb.Instrument{
+		Symboltoken:    r.Token,
+		Tradingsymbol:  r.Symbol,
+		Name:           r.Name,
+		Exchange:       r.ExchSeg,
+		Instrumenttype: r.InstrumentType,
+		Expiry:         r.Expiry,
+		Strike:         strike / 100,
+		Lotsize:        int32(lotSize),
+		Ticksize:       tickSize / 100,
+	}
+}
+
+// Master holds the current instrument index and refreshes it daily.
+type Master struct {
+	url        string
+	refreshAt  time.Duration // Time of day (IST) to download a fresh copy
+	httpClient *http.Client
+	mu         sync.RWMutex
+	index      *index
+	stopOnce   sync.Once
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+// NewMaster creates a master that downloads from url every day at refreshAt
+// (an offset from midnight IST). Nothing is downloaded until Start is called.
+func NewMaster(url string, refreshAt time.Duration) *Master {
+	return &Master{
+		url:        url,
+		refreshAt:  refreshAt,
+		httpClient: &http.Client{Timeout: 2 * time.Minute}, // The file is tens of megabytes
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start downloads the master in the background and then refreshes it daily
+// until Stop is called. Lookups fail with ErrNotLoaded until the first
+// download succeeds.
+func (m *Master) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-m.stop
+		cancel() // Abort a download in progress
+	}()
+
+	go func() {
+		defer close(m.done)
+
+		for {
+			wait := retryInterval
+			if err := m.Load(ctx); err != nil {
+				log.Printf("Instruments: Failed to load instrument master: %v", err)
+			} else {
+				wait = m.untilNextRefresh(time.Now())
+			}
+
+			select {
+			case <-time.After(wait):
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends the refresh loop and waits for it to exit.
+func (m *Master) Stop() {
+	m.stopOnce.Do(func() { close(m.stop) })
+	<-m.done
+}
+
+func (m *Master) untilNextRefresh(now time.Time) time.Duration {
+	now = now.In(ist)
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, ist)
+	next := midnight.Add(m.refreshAt)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next.Sub(now)
+}
+
+// Load downloads and indexes the instrument master, replacing the current index.
+func (m *Master) Load(ctx context.Context) error {
+	start := time.Now()
+	req, err := http.NewRequestWithContext(ctx, "GET", m.url, nil)
+	if err != nil {
+		return fmt.Errorf("creating instrument master request: %w", err)
+	}
+	res, err := m.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("downloading instrument master: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading instrument master: %s", res.Status)
+	}
+
+	// Decode entry by entry rather than holding the whole document in memory twice.
+	dec := json.NewDecoder(res.Body)
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("parsing instrument master: %w", err)
+	}
+	var all []*pb.Instrument
+	for dec.More() {
+		var raw rawInstrument
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("parsing instrument master: %w", err)
+		}
+		if raw.Token == "" || raw.ExchSeg == "" {
+			continue
+		}
+		all = append(all, raw.toProto())
+	}
+	if len(all) == 0 {
+		return errors.New("instrument master is empty")
+	}
+
+	idx := newIndex(all)
+	m.mu.Lock()
+	m.index = idx
+	m.mu.Unlock()
+
+	log.Printf("Instruments: Loaded %d instruments in %s", len(all), time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+func (m *Master) current() (*index, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.index == nil {
+		return nil, ErrNotLoaded
+	}
+	return m.index, nil
+}
+
+// ByToken returns the instrument with the token on the exchange. With an
+// empty exchange, every exchange is searched and all matches are returned.
+func (m *Master) ByToken(exchange, token string) ([]*pb.Instrument, error) {
+	idx, err := m.current()
+	if err != nil {
+		return nil, err
+	}
+	if exchange != "" {
+		if inst, ok := idx.byToken[exchangeKey(exchange, token)]; ok {
+			return []*pb.Instrument{inst}, nil
+		}
+		return nil, nil
+	}
+	return idx.byBareToken[token], nil
+}
+
+// BySymbol returns the instrument with the trading symbol (e.g. SBIN-EQ) on the exchange.
+func (m *Master) BySymbol(exchange, tradingsymbol string) (*pb.Instrument, bool, error) {
+	idx, err := m.current()
+	if err != nil {
+		return nil, false, err
+	}
+	inst, ok := idx.bySymbol[exchangeKey(exchange, strings.ToUpper(tradingsymbol))]
+	return inst, ok, nil
+}
+
+// Search finds instruments whose trading symbol or name matches query, best
+// matches first. exchange and instrumentType filter the results when set.
+func (m *Master) Search(query, exchange, instrumentType string, limit int) ([]*pb.Instrument, error) {
+	idx, err := m.current()
+	if err != nil {
+		return nil, err
+	}
+	return idx.search(query, exchange, instrumentType, limit), nil
+}
+
+// Len reports how many instruments are loaded.
+func (m *Master) Len() int {
+	idx, err := m.current()
+	if err != nil {
+		return 0
+	}
+	return len(idx.all)
+}
@@ -6,12 +6,21 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 
 	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/alerts"
 	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+	"github.com/Sagar-v4/Angel-Two/services/broker/candlecache"
 	"github.com/Sagar-v4/Angel-Two/services/broker/config"
+	"github.com/Sagar-v4/Angel-Two/services/broker/instruments"
+	"github.com/Sagar-v4/Angel-Two/services/broker/oco"
+	"github.com/Sagar-v4/Angel-Two/services/broker/rules"
 	brokerservice "github.com/Sagar-v4/Angel-Two/services/broker/service"
+	"github.com/Sagar-v4/Angel-Two/services/broker/sessions"
+	"github.com/Sagar-v4/Angel-Two/services/broker/stream"
+	"github.com/Sagar-v4/Angel-Two/services/broker/validation"
 
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
@@ -35,7 +44,87 @@ func main() {
 	}
 
 	angelClient := angelone.NewClient(cfg.AngelOneAPIKey, cfg.AngelOneUserType, cfg.AngelOneSourceID)
-	brokerServer := brokerservice.NewBrokerServer(angelClient)
+
+	var candleCache *candlecache.Cache
+	if cfg.CandleCachePath != "" {
+		makeDataDir(cfg.CandleCachePath)
+		candleCache, err = candlecache.Open(cfg.CandleCachePath)
+		if err != nil {
+			log.Fatalf("Failed to open candle cache: %v", err)
+		}
+		defer candleCache.Close()
+		log.Printf("Broker Service: Caching candles in %s", cfg.CandleCachePath)
+	}
+
+	var instrumentMaster *instruments.Master
+	if cfg.InstrumentMasterURL != "" {
+		instrumentMaster = instruments.NewMaster(cfg.InstrumentMasterURL, cfg.InstrumentRefreshTime)
+		instrumentMaster.Start() // Downloads in the background; search is unavailable until it finishes
+		defer instrumentMaster.Stop()
+	}
+
+	orderValidator := validation.New(nil) // Lot and tick sizes are not checked without an instrument master
+	if instrumentMaster != nil {
+		orderValidator = validation.New(func(exchange, symboltoken string) (validation.InstrumentInfo, bool) {
+			matches, err := instrumentMaster.ByToken(exchange, symboltoken)
+			if err != nil || len(matches) == 0 {
+				return validation.InstrumentInfo{}, false
+			}
+			return validation.InstrumentInfo{LotSize: matches[0].Lotsize, TickSize: matches[0].Ticksize}, true
+		})
+	}
+
+	tickHub := stream.NewHub(stream.NewDialer(cfg.AngelOneAPIKey), cfg.TickBufferSize)
+	defer tickHub.Close()
+
+	orderHub := stream.NewOrderHub()
+	defer orderHub.Close()
+
+	sessionRegistry := sessions.NewRegistry(angelClient)
+
+	var ruleEngine *rules.Engine
+	if cfg.RulesDBPath != "" {
+		makeDataDir(cfg.RulesDBPath)
+		ruleStore, err := rules.Open(cfg.RulesDBPath)
+		if err != nil {
+			log.Fatalf("Failed to open rule store: %v", err)
+		}
+		defer ruleStore.Close()
+		ruleEngine = rules.NewEngine(ruleStore, angelClient, sessionRegistry, tickHub, cfg.RulePollInterval)
+		ruleEngine.Start()
+		defer ruleEngine.Stop()
+		log.Printf("Broker Service: Keeping conditional order rules in %s", cfg.RulesDBPath)
+	}
+
+	var priceAlerts *alerts.Monitor
+	if cfg.AlertsDBPath != "" {
+		makeDataDir(cfg.AlertsDBPath)
+		alertStore, err := alerts.Open(cfg.AlertsDBPath)
+		if err != nil {
+			log.Fatalf("Failed to open alert store: %v", err)
+		}
+		defer alertStore.Close()
+		priceAlerts = alerts.NewMonitor(alertStore, sessionRegistry, alerts.NewNotifier(alertStore), cfg.AlertPollInterval)
+		priceAlerts.Start()
+		defer priceAlerts.Stop()
+		log.Printf("Broker Service: Keeping price alerts in %s", cfg.AlertsDBPath)
+	}
+
+	var brackets *oco.Manager
+	if cfg.BracketsDBPath != "" {
+		makeDataDir(cfg.BracketsDBPath)
+		bracketStore, err := oco.Open(cfg.BracketsDBPath)
+		if err != nil {
+			log.Fatalf("Failed to open bracket store: %v", err)
+		}
+		defer bracketStore.Close()
+		brackets = oco.NewManager(bracketStore, angelClient, sessionRegistry, orderHub, cfg.BracketPollInterval)
+		brackets.Start()
+		defer brackets.Stop()
+		log.Printf("Broker Service: Keeping bracket orders in %s", cfg.BracketsDBPath)
+	}
+
+	brokerServer := brokerservice.NewBrokerServer(angelClient, orderValidator, candleCache, instrumentMaster, tickHub, orderHub, ruleEngine, priceAlerts, brackets, sessionRegistry)
 
 	s := grpc.NewServer()
 	pb.RegisterBrokerServiceServer(s, brokerServer)
@@ -57,3 +146,10 @@ func main() {
 	s.GracefulStop()
 	log.Println("Broker gRPC Service stopped.")
 }
+
+// makeDataDir creates the directory a BoltDB file is kept in.
+func makeDataDir(path string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Fatalf("Failed to create the data directory of %s: %v", path, err)
+	}
+}
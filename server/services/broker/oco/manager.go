@@ -0,0 +1,470 @@
+package oco
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+	"github.com/Sagar-v4/Angel-Two/services/broker/sessions"
+	"github.com/Sagar-v4/Angel-Two/services/broker/stream"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultPollInterval = 10 * time.Second
+
+	// feedRetryDelay is how long the manager relies on polling alone after
+	// the order update feed failed before it dials again.
+	feedRetryDelay = time.Minute
+)
+
+// ErrFinished is returned by Cancel for a bracket that already completed,
+// was cancelled or failed.
+var ErrFinished = errors.New("bracket order is finished")
+
+// Manager drives every open bracket through its states. Order changes come
+// from Angel One's order update feed as they happen, and the order book is
+// polled every interval as well, to catch up on missed updates and to find
+// orders whose placement reply was lost (by their ordertag). The LTP of
+// brackets with an exit working is polled along with it, to swap the stop-loss
+// for the target order when the target is reached. Only one exit rests at a
+// time, as Angel One reserves the holdings for the first SELL, so the target
+// is emulated: it misses spikes shorter than the interval, and no exit rests
+// while the swap is in flight. A client's brackets are worked while the
+// session registry holds a session for the client.
+//
+// All state changes happen on one goroutine, so a bracket is never acted on
+// twice at once; PlaceBracketOrder only sends the entry order and hands the
+// bracket over.
+type Manager struct {
+	store    *Store
+	angel    *angelone.Client
+	sessions *sessions.Registry
+	orders   *stream.OrderHub
+	interval time.Duration
+
+	mu      sync.Mutex
+	clients map[string]*client // Keyed by client code
+	placing map[string]bool    // Brackets whose entry order is being sent
+
+	updates chan clientUpdate
+	wake    chan struct{}
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// client is the manager's state for one Angel One account.
+type client struct {
+	feed    *stream.OrderSubscriber
+	feedJWT string                        // JWT the feed was last dialled with
+	noFeed  time.Time                     // Poll only until then
+	prices  map[stream.Instrument]float64 // LTP of the last poll, keyed by entry instrument
+}
+
+// clientUpdate is an order update from a client's feed.
+type clientUpdate struct {
+	session sessions.Session
+	order   *pb.OrderBookItem
+}
+
+func NewManager(store *Store, angelClient *angelone.Client, registry *sessions.Registry, orderHub *stream.OrderHub, interval time.Duration) *Manager {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	m := &Manager{
+		store:    store,
+		angel:    angelClient,
+		sessions: registry,
+		orders:   orderHub,
+		interval: interval,
+		clients:  make(map[string]*client),
+		placing:  make(map[string]bool),
+		updates:  make(chan clientUpdate, 64),
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+	}
+	registry.Watch(m.notify)
+	return m
+}
+
+// Start begins managing brackets in the background.
+func (m *Manager) Start() {
+	m.wg.Add(1)
+	go m.run()
+}
+
+// Stop ends management and closes the order feeds. Brackets carry on from
+// their stored state after the next start.
+func (m *Manager) Stop() {
+	close(m.stop)
+	m.wg.Wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, c := range m.clients {
+		c.stopFeed()
+	}
+}
+
+// Place stores the bracket and sends its entry order, returning Angel One's
+// reply. The bracket is stored first, so an entry whose reply is lost is
+// still found in the order book; it is failed when Angel One rejects the
+// entry.
+func (m *Manager) Place(sess sessions.Session, bracket *pb.BracketOrder) (*pb.PlaceOrderResponse, error) {
+	bracket.EntryLeg = &pb.BracketLeg{Quantity: bracket.Entry.Quantity, Sent: true}
+	now := time.Now()
+	bracket.EntryLeg.PlacedAt = now.UnixMilli()
+	if err := m.store.Create(bracket, now); err != nil {
+		bracket.Id = "" // Not stored
+		return nil, err
+	}
+	m.mu.Lock()
+	m.placing[bracket.Id] = true
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		delete(m.placing, bracket.Id)
+		m.mu.Unlock()
+		m.notify()
+	}()
+
+	order := proto.Clone(bracket.Entry).(*pb.PlaceOrderRequest)
+	order.AngelOneJwt = sess.JWT
+	order.ClientLocalIp = sess.ClientLocalIP
+	order.ClientPublicIp = sess.ClientPublicIP
+	order.MacAddress = sess.MacAddress
+	order.Ordertag = bracket.EntryLeg.Ordertag
+
+	resp, err := m.angel.PlaceOrder(order)
+	if err != nil || resp.Errorcode == "UNMARSHAL_ERROR" {
+		// The order may have reached Angel One; the poll looks it up by ordertag.
+		return resp, err
+	}
+	stored, saveErr := m.store.Update(bracket.Id, func(b *pb.BracketOrder) error {
+		b.EntryLeg.Message = resp.Message
+		if resp.Status && resp.Data != nil && resp.Data.Orderid != "" {
+			b.EntryLeg.Orderid = resp.Data.Orderid
+			b.EntryLeg.Uniqueorderid = resp.Data.Uniqueorderid
+			b.EntryLeg.Orderstatus = "open pending"
+		} else {
+			b.State = pb.BracketState_BRACKET_FAILED
+			b.Message = "Entry order rejected: " + resp.Message
+		}
+		return nil
+	})
+	if saveErr != nil {
+		log.Printf("Broker Service: Failed to record the entry of bracket %s: %v", bracket.Id, saveErr)
+	} else {
+		proto.Reset(bracket)
+		proto.Merge(bracket, stored)
+	}
+	return resp, nil
+}
+
+// Get returns the client's bracket with the given id, or ErrNotFound.
+func (m *Manager) Get(clientCode, id string) (*pb.BracketOrder, error) {
+	bracket, err := m.store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if bracket.ClientCode != clientCode {
+		return nil, ErrNotFound
+	}
+	m.withLiveState(bracket)
+	return bracket, nil
+}
+
+// List returns the client's brackets, newest first, only unfinished ones
+// when activeOnly is set.
+func (m *Manager) List(clientCode string, activeOnly bool) ([]*pb.BracketOrder, error) {
+	brackets, err := m.store.List(func(bracket *pb.BracketOrder) bool {
+		return bracket.ClientCode == clientCode && (!activeOnly || !finished(bracket))
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, bracket := range brackets {
+		m.withLiveState(bracket)
+	}
+	return brackets, nil
+}
+
+// withLiveState marks an open bracket whose client has no session, as
+// nothing moves it on until the client is back.
+func (m *Manager) withLiveState(bracket *pb.BracketOrder) {
+	_, hasSession := m.sessions.Get(bracket.ClientCode)
+	bracket.WaitingForSession = !finished(bracket) && !hasSession
+}
+
+// Cancel asks for the client's bracket to be wound down: its working orders
+// are cancelled on the manager's next pass. A filled entry stays a position.
+func (m *Manager) Cancel(clientCode, id string) (*pb.BracketOrder, error) {
+	bracket, err := m.store.Update(id, func(b *pb.BracketOrder) error {
+		if b.ClientCode != clientCode {
+			return ErrNotFound
+		}
+		if finished(b) {
+			return ErrFinished
+		}
+		b.CancelRequested = true
+		return nil
+	})
+	if errors.Is(err, ErrFinished) {
+		current, getErr := m.store.Get(id)
+		if getErr != nil {
+			return nil, getErr
+		}
+		return current, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	m.notify()
+	return bracket, nil
+}
+
+func finished(bracket *pb.BracketOrder) bool {
+	switch bracket.State {
+	case pb.BracketState_BRACKET_COMPLETED, pb.BracketState_BRACKET_CANCELLED, pb.BracketState_BRACKET_FAILED:
+		return true
+	}
+	return false
+}
+
+func (m *Manager) notify() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) run() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	m.poll()
+	for {
+		select {
+		case <-ticker.C:
+			m.poll()
+		case <-m.wake:
+			m.poll()
+		case update := <-m.updates:
+			m.apply(update)
+		case <-m.stop:
+			return
+		}
+	}
+}
+
+// poll keeps an order feed open for every client with open brackets and
+// steps each bracket against the client's order book.
+func (m *Manager) poll() {
+	open, err := m.store.List(func(bracket *pb.BracketOrder) bool { return !finished(bracket) })
+	if err != nil {
+		log.Printf("Broker Service: Failed to load bracket orders: %v", err)
+		return
+	}
+	byClient := make(map[string][]*pb.BracketOrder)
+	for _, bracket := range open {
+		byClient[bracket.ClientCode] = append(byClient[bracket.ClientCode], bracket)
+	}
+
+	m.mu.Lock()
+	for code := range byClient {
+		if _, ok := m.clients[code]; !ok {
+			m.clients[code] = &client{}
+		}
+	}
+	var ready []sessions.Session
+	for code, c := range m.clients {
+		sess, ok := m.sessions.Get(code)
+		if !ok || len(byClient[code]) == 0 {
+			c.stopFeed()
+			continue
+		}
+		ready = append(ready, sess)
+	}
+	m.mu.Unlock()
+
+	for _, sess := range ready {
+		m.watchFeed(sess)
+		book, ok := m.orderBook(sess)
+		if !ok {
+			continue
+		}
+		m.pollPrices(sess, byClient[sess.ClientCode])
+		for _, bracket := range byClient[sess.ClientCode] {
+			if !m.isPlacing(bracket.Id) {
+				m.step(sess, bracket, book, time.Now())
+			}
+		}
+	}
+}
+
+// apply steps the client's brackets that own the updated order.
+func (m *Manager) apply(update clientUpdate) {
+	open, err := m.store.List(func(bracket *pb.BracketOrder) bool {
+		return bracket.ClientCode == update.session.ClientCode && !finished(bracket)
+	})
+	if err != nil {
+		log.Printf("Broker Service: Failed to load bracket orders: %v", err)
+		return
+	}
+	book := newOrderBook([]*pb.OrderBookItem{update.order}, false)
+	for _, bracket := range open {
+		if m.isPlacing(bracket.Id) {
+			continue
+		}
+		for _, leg := range legs(bracket) {
+			if book.find(leg) != nil {
+				m.step(update.session, bracket, book, time.Now())
+				break
+			}
+		}
+	}
+}
+
+func (m *Manager) isPlacing(id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.placing[id]
+}
+
+// watchFeed dials the client's order update feed unless it is open or
+// recently failed.
+func (m *Manager) watchFeed(sess sessions.Session) {
+	m.mu.Lock()
+	c := m.clients[sess.ClientCode]
+	if c != nil && c.feedJWT != sess.JWT {
+		c.stopFeed() // Redial with the new JWT
+		c.noFeed = time.Time{}
+		c.feedJWT = sess.JWT
+	}
+	if c == nil || c.feed != nil || time.Now().Before(c.noFeed) {
+		m.mu.Unlock()
+		return
+	}
+	m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	feed, err := m.orders.Subscribe(ctx, sess.JWT)
+	cancel()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		log.Printf("Broker Service: Bracket orders of client %s rely on order book polling: %v", sess.ClientCode, err)
+		c.noFeed = time.Now().Add(feedRetryDelay)
+		return
+	}
+	if c.feedJWT != sess.JWT || c.feed != nil {
+		feed.Close() // The session changed while dialling
+		return
+	}
+	c.feed = feed
+	go m.readFeed(c, feed, sess)
+}
+
+// readFeed hands the feed's updates to the manager's goroutine until the
+// feed closes.
+func (m *Manager) readFeed(c *client, feed *stream.OrderSubscriber, sess sessions.Session) {
+	for update := range feed.Updates() {
+		select {
+		case m.updates <- clientUpdate{session: sess, order: update.Order}:
+		case <-m.stop:
+			return
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c.feed == feed {
+		log.Printf("Broker Service: Order update feed of client %s closed: %v", sess.ClientCode, feed.Err())
+		c.feed = nil
+		c.noFeed = time.Now().Add(feedRetryDelay)
+	}
+}
+
+// stopFeed closes the client's order feed, if any. m.mu must be held.
+func (c *client) stopFeed() {
+	if c.feed == nil {
+		return
+	}
+	feed := c.feed
+	c.feed = nil
+	feed.Close()
+}
+
+// orderBook fetches the client's order book for a poll.
+func (m *Manager) orderBook(sess sessions.Session) (*orderBook, bool) {
+	resp, err := m.angel.GetOrderBook(&pb.GetOrderBookRequest{
+		AngelOneJwt:    sess.JWT,
+		ClientLocalIp:  sess.ClientLocalIP,
+		ClientPublicIp: sess.ClientPublicIP,
+		MacAddress:     sess.MacAddress,
+	})
+	if err != nil || !resp.Status {
+		if err == nil {
+			m.sessions.Check(sess, resp.Errorcode)
+			err = errors.New(resp.Message)
+		}
+		log.Printf("Broker Service: Bracket orders of client %s are waiting for the order book: %v", sess.ClientCode, err)
+		return nil, false
+	}
+	return newOrderBook(resp.Data, true), true
+}
+
+// pollPrices fetches the LTP of the client's brackets with an exit working.
+// Prices that could not be fetched are left out, so no exit is swapped on a
+// stale price.
+func (m *Manager) pollPrices(sess sessions.Session, brackets []*pb.BracketOrder) {
+	var instruments []stream.Instrument
+	seen := make(map[stream.Instrument]bool)
+	for _, bracket := range brackets {
+		if bracket.State != pb.BracketState_BRACKET_ACTIVE && bracket.State != pb.BracketState_BRACKET_PLACING_EXITS {
+			continue
+		}
+		inst := entryInstrument(bracket)
+		if !seen[inst] {
+			seen[inst] = true
+			instruments = append(instruments, inst)
+		}
+	}
+
+	var prices map[stream.Instrument]float64
+	if len(instruments) > 0 {
+		var err error
+		prices, err = m.sessions.LTP(sess, instruments)
+		if err != nil {
+			log.Printf("Broker Service: Bracket orders of client %s are waiting for prices: %v", sess.ClientCode, err)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c := m.clients[sess.ClientCode]; c != nil {
+		c.prices = prices
+	}
+}
+
+// lastPrice returns the LTP of the bracket's instrument from the last poll.
+func (m *Manager) lastPrice(bracket *pb.BracketOrder) (float64, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c := m.clients[bracket.ClientCode]
+	if c == nil {
+		return 0, false
+	}
+	ltp, ok := c.prices[entryInstrument(bracket)]
+	return ltp, ok && ltp > 0
+}
+
+func entryInstrument(bracket *pb.BracketOrder) stream.Instrument {
+	return stream.Instrument{Exchange: bracket.Entry.Exchange, Symboltoken: bracket.Entry.Symboltoken}
+}
@@ -0,0 +1,569 @@
+package oco
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+	"github.com/Sagar-v4/Angel-Two/services/broker/sessions"
+	"github.com/Sagar-v4/Angel-Two/services/broker/stream"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// lostOrderGrace is how long an order whose placement reply was lost may
+	// be missing from the order book before it is taken as never placed.
+	lostOrderGrace = 2 * time.Minute
+	// actionRetryDelay paces repeated cancels of a leg and exits placed again.
+	actionRetryDelay = 30 * time.Second
+	// maxExitRetries is how often an exit rejected for insufficient holdings
+	// is placed again before the bracket fails.
+	maxExitRetries = 3
+)
+
+// Exit orders are plain delivery orders: a SELL stop-loss at the trigger and,
+// once the LTP reached the target, a SELL LIMIT at the target instead.
+const (
+	varietyNormal   = "NORMAL"
+	varietyStopLoss = "STOPLOSS"
+)
+
+// orderBook indexes orders by id and by ordertag. A complete book is a full
+// order book, where a missing order is meaningful; otherwise it holds a single
+// feed update.
+type orderBook struct {
+	byID     map[string]*pb.OrderBookItem
+	byTag    map[string]*pb.OrderBookItem
+	complete bool
+}
+
+func newOrderBook(orders []*pb.OrderBookItem, complete bool) *orderBook {
+	book := &orderBook{byID: make(map[string]*pb.OrderBookItem), byTag: make(map[string]*pb.OrderBookItem), complete: complete}
+	for _, order := range orders {
+		if order == nil {
+			continue
+		}
+		if order.Orderid != "" {
+			book.byID[order.Orderid] = order
+		}
+		if order.Ordertag != "" {
+			book.byTag[order.Ordertag] = order
+		}
+	}
+	return book
+}
+
+// find returns the order of a leg, by id once it is known and by tag before.
+func (b *orderBook) find(leg *pb.BracketLeg) *pb.OrderBookItem {
+	if leg == nil {
+		return nil
+	}
+	if leg.Orderid != "" {
+		return b.byID[leg.Orderid]
+	}
+	if leg.Sent && leg.Ordertag != "" {
+		return b.byTag[leg.Ordertag]
+	}
+	return nil
+}
+
+func legs(bracket *pb.BracketOrder) []*pb.BracketLeg {
+	var all []*pb.BracketLeg
+	for _, leg := range []*pb.BracketLeg{bracket.EntryLeg, bracket.TargetLeg, bracket.StoplossLeg} {
+		if leg != nil {
+			all = append(all, leg)
+		}
+	}
+	return all
+}
+
+// legEvent classifies a leg by its last seen Angel One status.
+func legEvent(leg *pb.BracketLeg) pb.OrderEventType {
+	if leg == nil || leg.Orderid == "" {
+		return pb.OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED
+	}
+	return stream.OrderEventType(&pb.OrderBookItem{Orderstatus: leg.Orderstatus, Filledshares: strconv.Itoa(int(leg.Filledshares))})
+}
+
+// working reports whether the leg's order may still execute.
+func working(leg *pb.BracketLeg) bool {
+	switch legEvent(leg) {
+	case pb.OrderEventType_PLACED, pb.OrderEventType_OPEN, pb.OrderEventType_PARTIALLY_FILLED:
+		return true
+	}
+	return false
+}
+
+// done reports whether the leg's order is complete, cancelled or rejected.
+func done(leg *pb.BracketLeg) bool {
+	switch legEvent(leg) {
+	case pb.OrderEventType_COMPLETE, pb.OrderEventType_CANCELLED, pb.OrderEventType_REJECTED:
+		return true
+	}
+	return false
+}
+
+// refresh copies what the order book knows about the leg's order.
+func refresh(leg *pb.BracketLeg, order *pb.OrderBookItem) {
+	leg.Orderid = order.Orderid
+	if order.Uniqueorderid != "" {
+		leg.Uniqueorderid = order.Uniqueorderid
+	}
+	leg.Orderstatus = order.Orderstatus
+	if leg.Orderstatus == "" {
+		leg.Orderstatus = order.Status
+	}
+	if qty, err := strconv.Atoi(order.Quantity); err == nil && qty > 0 {
+		leg.Quantity = int32(qty)
+	}
+	if filled, err := strconv.Atoi(order.Filledshares); err == nil {
+		leg.Filledshares = int32(filled)
+	}
+	if order.Averageprice > 0 {
+		leg.Averageprice = order.Averageprice
+	}
+	if order.Text != "" {
+		leg.Message = order.Text
+	}
+}
+
+// reconcile updates the legs from the book. Orders of a previous trading day
+// are no longer in the order book; their DAY validity has ended, so they are
+// taken as cancelled.
+func reconcile(bracket *pb.BracketOrder, book *orderBook, now time.Time) {
+	today := startOfDay(now)
+	for _, leg := range legs(bracket) {
+		if order := book.find(leg); order != nil {
+			refresh(leg, order)
+			continue
+		}
+		if book.complete && leg.Orderid != "" && working(leg) && time.UnixMilli(leg.PlacedAt).Before(today) {
+			leg.Orderstatus = "cancelled"
+			leg.Message = "Expired at the end of the trading day"
+		}
+	}
+}
+
+// step moves the bracket on from what the book says about its orders and
+// stores the result.
+func (m *Manager) step(sess sessions.Session, bracket *pb.BracketOrder, book *orderBook, now time.Time) {
+	before := proto.Clone(bracket).(*pb.BracketOrder)
+	reconcile(bracket, book, now)
+
+	switch bracket.State {
+	case pb.BracketState_BRACKET_ENTRY_PENDING:
+		m.stepEntry(sess, bracket, book, now)
+	case pb.BracketState_BRACKET_PLACING_EXITS:
+		m.placeExits(sess, bracket, book, now)
+	case pb.BracketState_BRACKET_ACTIVE:
+		ltp, priced := m.lastPrice(bracket)
+		m.stepActive(sess, bracket, ltp, priced, now)
+	case pb.BracketState_BRACKET_CLOSING:
+		m.stepClosing(sess, bracket, now)
+	}
+
+	if !proto.Equal(before, bracket) {
+		m.save(bracket)
+	}
+}
+
+// save stores the bracket as the manager sees it. A cancel requested since
+// the bracket was loaded is kept.
+func (m *Manager) save(bracket *pb.BracketOrder) {
+	var previous pb.BracketState
+	stored, err := m.store.Update(bracket.Id, func(current *pb.BracketOrder) error {
+		previous = current.State
+		cancelRequested := current.CancelRequested || bracket.CancelRequested
+		proto.Reset(current)
+		proto.Merge(current, bracket)
+		current.CancelRequested = cancelRequested
+		return nil
+	})
+	if err != nil {
+		log.Printf("Broker Service: Failed to store bracket order %s: %v", bracket.Id, err)
+		return
+	}
+	if stored.State != previous {
+		log.Printf("Broker Service: Bracket order %s is now %s: %s", stored.Id, stored.State, stored.Message)
+	}
+	bracket.CancelRequested = stored.CancelRequested
+	bracket.UpdatedAt = stored.UpdatedAt
+}
+
+func (m *Manager) stepEntry(sess sessions.Session, bracket *pb.BracketOrder, book *orderBook, now time.Time) {
+	entry := bracket.EntryLeg
+	if entry.Orderid == "" {
+		if book.complete && now.Sub(time.UnixMilli(entry.PlacedAt)) > lostOrderGrace {
+			bracket.State = pb.BracketState_BRACKET_FAILED
+			bracket.Message = "Entry order placement could not be confirmed and the order is not in the order book"
+		}
+		return
+	}
+
+	switch legEvent(entry) {
+	case pb.OrderEventType_COMPLETE:
+		m.startExits(sess, bracket, entry.Filledshares, book, now)
+	case pb.OrderEventType_REJECTED:
+		bracket.State = pb.BracketState_BRACKET_FAILED
+		bracket.Message = "Entry order rejected: " + entry.Message
+	case pb.OrderEventType_CANCELLED:
+		switch {
+		case entry.Filledshares == 0:
+			bracket.State = pb.BracketState_BRACKET_CANCELLED
+			bracket.Message = "Entry order cancelled before it filled"
+		case bracket.CancelRequested:
+			bracket.State = pb.BracketState_BRACKET_CANCELLED
+			bracket.Message = fmt.Sprintf("Entry order cancelled with %d shares filled; no exits were placed", entry.Filledshares)
+		default:
+			m.startExits(sess, bracket, entry.Filledshares, book, now) // Protect what did fill
+		}
+	default:
+		if bracket.CancelRequested {
+			m.cancelLeg(sess, entry, varietyNormal, now)
+		}
+	}
+}
+
+// startExits moves a filled entry on to placing the exits for qty shares.
+func (m *Manager) startExits(sess sessions.Session, bracket *pb.BracketOrder, qty int32, book *orderBook, now time.Time) {
+	if qty <= 0 {
+		qty = bracket.EntryLeg.Quantity
+	}
+	bracket.State = pb.BracketState_BRACKET_PLACING_EXITS
+	m.newExitRound(bracket, qty)
+	m.placeExits(sess, bracket, book, now)
+}
+
+// newExitRound sets up fresh target and stop-loss legs for qty shares.
+func (m *Manager) newExitRound(bracket *pb.BracketOrder, qty int32) {
+	bracket.SoldQuantity += bracket.TargetLeg.GetFilledshares() + bracket.StoplossLeg.GetFilledshares()
+	bracket.ExitRound++
+	bracket.ExitQuantity = qty
+	bracket.TargetReached = false
+	bracket.TargetLeg = &pb.BracketLeg{Ordertag: legTag(bracket.Id, "t", bracket.ExitRound), Quantity: qty}
+	bracket.StoplossLeg = &pb.BracketLeg{Ordertag: legTag(bracket.Id, "s", bracket.ExitRound), Quantity: qty}
+}
+
+// exits returns the exit the bracket works now, the stop-loss or the target
+// once the LTP reached it, and the other one.
+func exits(bracket *pb.BracketOrder) (exit, other *pb.BracketLeg) {
+	if bracket.TargetReached {
+		return bracket.TargetLeg, bracket.StoplossLeg
+	}
+	return bracket.StoplossLeg, bracket.TargetLeg
+}
+
+// placeExits sends the exit the bracket works now. Only one exit is ever
+// working: Angel One reserves the holdings for the first SELL and rejects a
+// second one for the same shares. An exit whose reply was lost is looked up
+// in the next full order book before anything else.
+func (m *Manager) placeExits(sess sessions.Session, bracket *pb.BracketOrder, book *orderBook, now time.Time) {
+	if bracket.CancelRequested {
+		bracket.State = pb.BracketState_BRACKET_CLOSING
+		m.stepClosing(sess, bracket, now)
+		return
+	}
+
+	exit, _ := exits(bracket)
+	if exit.Orderid == "" {
+		if exit.Sent {
+			if !book.complete || now.Sub(time.UnixMilli(exit.PlacedAt)) <= lostOrderGrace {
+				return // Wait for the order to show up in the order book
+			}
+			bracket.State = pb.BracketState_BRACKET_FAILED
+			bracket.Message = "Exit order placement could not be confirmed and the order is not in the order book"
+			return
+		}
+		if now.Sub(time.UnixMilli(exit.LastActionAt)) < actionRetryDelay {
+			return // Placed again after a rejection, once the delay is over
+		}
+		if !m.placeExit(sess, bracket, exit, now) {
+			return
+		}
+	}
+
+	bracket.State = pb.BracketState_BRACKET_ACTIVE
+	bracket.Message = ""
+}
+
+// placeExit sends one exit order and reports whether it was placed.
+func (m *Manager) placeExit(sess sessions.Session, bracket *pb.BracketOrder, leg *pb.BracketLeg, now time.Time) bool {
+	leg.Sent = true
+	leg.PlacedAt = now.UnixMilli()
+	m.save(bracket) // Before sending, so a lost reply is looked up instead of sent again
+
+	order := exitOrder(bracket, leg)
+	order.AngelOneJwt = sess.JWT
+	order.ClientLocalIp = sess.ClientLocalIP
+	order.ClientPublicIp = sess.ClientPublicIP
+	order.MacAddress = sess.MacAddress
+
+	resp, err := m.angel.PlaceOrder(order)
+	switch {
+	case err != nil || resp.Errorcode == "UNMARSHAL_ERROR":
+		log.Printf("Broker Service: Placement of the %s of bracket order %s is unconfirmed: %v", legName(bracket, leg), bracket.Id, err)
+		return false
+	case resp.Status && resp.Data != nil && resp.Data.Orderid != "":
+		leg.Orderid = resp.Data.Orderid
+		leg.Uniqueorderid = resp.Data.Uniqueorderid
+		leg.Orderstatus = "open pending"
+		leg.Message = resp.Message
+		return true
+	case m.sessions.Check(sess, resp.Errorcode):
+		// Rejected before placing anything; send it again once the client is back.
+		leg.Sent = false
+		leg.Message = resp.Message
+		return false
+	default:
+		leg.Message = resp.Message
+		m.rejectedExit(bracket, leg, now)
+		return false
+	}
+}
+
+// rejectedExit fails the bracket over a rejected exit, unless Angel One
+// rejected it for insufficient holdings: the shares may still be reserved by
+// the exit just cancelled, so the exit is placed again in a new round after
+// actionRetryDelay, at most maxExitRetries times.
+func (m *Manager) rejectedExit(bracket *pb.BracketOrder, leg *pb.BracketLeg, now time.Time) {
+	name := legName(bracket, leg)
+	if !holdingsRejection(leg.Message) {
+		bracket.State = pb.BracketState_BRACKET_FAILED
+		bracket.Message = fmt.Sprintf("The %s order was rejected: %s", name, leg.Message)
+		return
+	}
+	if bracket.ExitRetries >= maxExitRetries {
+		bracket.State = pb.BracketState_BRACKET_FAILED
+		bracket.Message = fmt.Sprintf("The %s order was rejected for insufficient holdings %d times, e.g. because of a SELL placed outside the bracket: %s", name, bracket.ExitRetries+1, leg.Message)
+		return
+	}
+
+	bracket.ExitRetries++
+	targetReached := bracket.TargetReached
+	m.newExitRound(bracket, bracket.ExitQuantity-bracket.TargetLeg.Filledshares-bracket.StoplossLeg.Filledshares)
+	bracket.TargetReached = targetReached
+	exit, _ := exits(bracket)
+	exit.LastActionAt = now.UnixMilli()
+	bracket.State = pb.BracketState_BRACKET_PLACING_EXITS
+	bracket.Message = fmt.Sprintf("The %s order was rejected for insufficient holdings (%s); placing it again", name, leg.Message)
+	log.Printf("Broker Service: Bracket order %s: %s", bracket.Id, bracket.Message)
+}
+
+// holdingsRejection reports whether Angel One rejected a SELL because the
+// shares are not free to sell.
+func holdingsRejection(message string) bool {
+	return strings.Contains(strings.ToLower(message), "holding")
+}
+
+func exitOrder(bracket *pb.BracketOrder, leg *pb.BracketLeg) *pb.PlaceOrderRequest {
+	entry := bracket.Entry
+	order := &pb.PlaceOrderRequest{
+		Tradingsymbol:   entry.Tradingsymbol,
+		Symboltoken:     entry.Symboltoken,
+		Exchange:        entry.Exchange,
+		Transactiontype: "SELL",
+		Producttype:     entry.Producttype,
+		Duration:        "DAY",
+		Quantity:        leg.Quantity - leg.Filledshares,
+		Ordertag:        leg.Ordertag,
+	}
+	if leg == bracket.TargetLeg {
+		order.Variety = varietyNormal
+		order.Ordertype = "LIMIT"
+		order.Price = bracket.TargetPrice
+		return order
+	}
+	order.Variety = varietyStopLoss
+	order.Triggerprice = bracket.StoplossTriggerprice
+	if bracket.StoplossPrice > 0 {
+		order.Ordertype = "STOPLOSS_LIMIT"
+		order.Price = bracket.StoplossPrice
+	} else {
+		order.Ordertype = "STOPLOSS_MARKET"
+	}
+	return order
+}
+
+func legName(bracket *pb.BracketOrder, leg *pb.BracketLeg) string {
+	switch leg {
+	case bracket.TargetLeg:
+		return "target"
+	case bracket.StoplossLeg:
+		return "stop-loss"
+	}
+	return "entry"
+}
+
+// stepActive watches the working exit and swaps it when the LTP crosses a
+// level: the stop-loss is cancelled for the target order once the LTP reaches
+// the target, and the target order for a new stop-loss when the LTP falls
+// back to the trigger. The exit being replaced is cancelled before the other
+// is placed. Exits that expired at the end of the day are placed again while
+// the market is open.
+func (m *Manager) stepActive(sess sessions.Session, bracket *pb.BracketOrder, ltp float64, priced bool, now time.Time) {
+	target, stoploss := bracket.TargetLeg, bracket.StoplossLeg
+	remaining := bracket.ExitQuantity - target.Filledshares - stoploss.Filledshares
+	exit, other := exits(bracket)
+
+	switch {
+	case bracket.CancelRequested:
+		bracket.State = pb.BracketState_BRACKET_CLOSING
+		bracket.Message = "Cancel requested"
+	case remaining <= 0 || legEvent(exit) == pb.OrderEventType_COMPLETE:
+		bracket.State = pb.BracketState_BRACKET_CLOSING
+		bracket.Message = "An exit executed"
+	case legEvent(exit) == pb.OrderEventType_REJECTED:
+		m.rejectedExit(bracket, exit, now)
+		return
+	case working(other):
+		m.cancelLeg(sess, other, legVariety(bracket, other), now) // Still swapping
+		return
+	case !exit.Sent:
+		exit.Quantity = remaining
+		bracket.State = pb.BracketState_BRACKET_PLACING_EXITS
+		m.placeExits(sess, bracket, newOrderBook(nil, false), now)
+		return
+	case done(exit) && exit.CancelSent:
+		// The target order was cancelled to swap back to a stop-loss.
+		bracket.State = pb.BracketState_BRACKET_PLACING_EXITS
+		m.newExitRound(bracket, remaining)
+		m.placeExits(sess, bracket, newOrderBook(nil, false), now)
+		return
+	case done(exit):
+		// An exit placed today was cancelled outside the bracket; one of an
+		// earlier day expired and is placed again once the market opens.
+		if !time.UnixMilli(exit.PlacedAt).Before(startOfDay(now)) {
+			bracket.State = pb.BracketState_BRACKET_CLOSING
+			bracket.Message = "The exit order was cancelled outside the bracket"
+			break
+		}
+		if !marketOpen(now) {
+			return
+		}
+		log.Printf("Broker Service: Exit of bracket order %s ended unfilled; placing it again for %d shares", bracket.Id, remaining)
+		bracket.State = pb.BracketState_BRACKET_PLACING_EXITS
+		m.newExitRound(bracket, remaining)
+		m.placeExits(sess, bracket, newOrderBook(nil, false), now)
+		return
+	case priced && !bracket.TargetReached && ltp >= bracket.TargetPrice:
+		bracket.TargetReached = true
+		bracket.Message = fmt.Sprintf("LTP %.2f reached the target; swapping the stop-loss for the target order", ltp)
+		m.cancelLeg(sess, stoploss, varietyStopLoss, now)
+		return
+	case priced && bracket.TargetReached && ltp <= bracket.StoplossTriggerprice:
+		bracket.Message = fmt.Sprintf("LTP %.2f fell back to the stop-loss trigger; swapping the target order for a stop-loss", ltp)
+		m.cancelLeg(sess, target, varietyNormal, now)
+		return
+	default:
+		return
+	}
+	m.stepClosing(sess, bracket, now)
+}
+
+func legVariety(bracket *pb.BracketOrder, leg *pb.BracketLeg) string {
+	if leg == bracket.StoplossLeg {
+		return varietyStopLoss
+	}
+	return varietyNormal
+}
+
+// stepClosing cancels every order of the bracket that is still working and
+// settles the bracket once none is.
+func (m *Manager) stepClosing(sess sessions.Session, bracket *pb.BracketOrder, now time.Time) {
+	pendingLegs := false
+	for _, leg := range legs(bracket) {
+		if leg.Sent && leg.Orderid == "" {
+			// Unconfirmed placement; wait for the order book until it is taken as never placed.
+			pendingLegs = pendingLegs || now.Sub(time.UnixMilli(leg.PlacedAt)) <= lostOrderGrace
+			continue
+		}
+		if working(leg) {
+			pendingLegs = true
+			m.cancelLeg(sess, leg, legVariety(bracket, leg), now)
+		}
+	}
+	if pendingLegs {
+		return
+	}
+
+	target, stoploss := bracket.TargetLeg, bracket.StoplossLeg
+	sold := bracket.SoldQuantity + target.GetFilledshares() + stoploss.GetFilledshares()
+	bought := bracket.EntryLeg.Filledshares
+	switch {
+	case sold == 0:
+		bracket.State = pb.BracketState_BRACKET_CANCELLED
+		if bought > 0 {
+			bracket.Message = fmt.Sprintf("Cancelled; the %d shares bought are still held", bought)
+		} else {
+			bracket.Message = "Cancelled"
+		}
+	case sold > bought && bought > 0:
+		bracket.State = pb.BracketState_BRACKET_FAILED
+		bracket.Message = fmt.Sprintf("Both exits executed: %d shares sold against %d bought", sold, bought)
+	default:
+		bracket.State = pb.BracketState_BRACKET_COMPLETED
+		switch {
+		case bracket.ExitRound > 1:
+			bracket.Message = fmt.Sprintf("Exited: %d shares sold over %d rounds of exit orders", sold, bracket.ExitRound)
+		case stoploss.GetFilledshares() == 0:
+			bracket.Message = fmt.Sprintf("Target hit: %d shares sold at %.2f", sold, target.GetAverageprice())
+		case target.GetFilledshares() == 0:
+			bracket.Message = fmt.Sprintf("Stop-loss hit: %d shares sold at %.2f", sold, stoploss.GetAverageprice())
+		default:
+			bracket.Message = fmt.Sprintf("Exited in parts: %d shares at the target and %d at the stop-loss", target.Filledshares, stoploss.Filledshares)
+		}
+		if sold < bought && !bracket.CancelRequested {
+			bracket.Message += fmt.Sprintf("; %d shares are still held", bought-sold)
+		}
+	}
+}
+
+// cancelLeg asks Angel One to cancel the leg's order, at most every
+// actionRetryDelay.
+func (m *Manager) cancelLeg(sess sessions.Session, leg *pb.BracketLeg, variety string, now time.Time) {
+	if now.Sub(time.UnixMilli(leg.LastActionAt)) < actionRetryDelay {
+		return
+	}
+	leg.LastActionAt = now.UnixMilli()
+	resp, err := m.angel.CancelOrder(&pb.CancelOrderRequest{
+		AngelOneJwt:    sess.JWT,
+		Variety:        variety,
+		Orderid:        leg.Orderid,
+		ClientLocalIp:  sess.ClientLocalIP,
+		ClientPublicIp: sess.ClientPublicIP,
+		MacAddress:     sess.MacAddress,
+	})
+	if err != nil || !resp.Status {
+		if err == nil {
+			m.sessions.Check(sess, resp.Errorcode)
+			err = errors.New(resp.Message)
+		}
+		log.Printf("Broker Service: Failed to cancel bracket leg %s: %v", leg.Orderid, err)
+		return
+	}
+	leg.CancelSent = true
+	leg.Message = "Cancel sent"
+}
+
+// marketOpen reports whether NSE and BSE equity are in their normal session.
+// Exchange holidays are not known; exits placed on one are rejected and the
+// bracket fails.
+func marketOpen(now time.Time) bool {
+	ist := now.In(angelone.IST)
+	if ist.Weekday() == time.Saturday || ist.Weekday() == time.Sunday {
+		return false
+	}
+	minutes := ist.Hour()*60 + ist.Minute()
+	return minutes >= 9*60+15 && minutes < 15*60+30
+}
+
+func startOfDay(now time.Time) time.Time {
+	ist := now.In(angelone.IST)
+	return time.Date(ist.Year(), ist.Month(), ist.Day(), 0, 0, 0, 0, angelone.IST)
+}
@@ -0,0 +1,170 @@
+// Package oco emulates bracket orders for equity delivery, which Angel One's
+// ROBO variety does not cover: once the entry order fills, a stop-loss order
+// is placed, and swapped for a target order while the LTP is at the target.
+// Brackets live in a BoltDB file, so they are picked up again after a restart.
+package oco
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var bracketsBucket = []byte("brackets")
+
+var ErrNotFound = errors.New("bracket order not found")
+
+// Store is a BoltDB-backed bracket store. Brackets are keyed by a sequence
+// number, which is also their id.
+type Store struct {
+	db *bolt.DB
+}
+
+// Open opens (or creates) the bracket file at path. BoltDB holds an exclusive
+// lock on the file, so only one broker process can use it at a time.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening bracket store %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bracketsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating bracket bucket: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Create assigns the bracket its id and the order tag of its entry, and
+// stores it as pending entry.
+func (s *Store) Create(bracket *pb.BracketOrder, now time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bracketsBucket)
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		bracket.Id = strconv.FormatUint(seq, 10)
+		if bracket.EntryLeg == nil {
+			bracket.EntryLeg = &pb.BracketLeg{}
+		}
+		bracket.EntryLeg.Ordertag = legTag(bracket.Id, "e", 0)
+		bracket.State = pb.BracketState_BRACKET_ENTRY_PENDING
+		bracket.CreatedAt = now.UnixMilli()
+		bracket.UpdatedAt = bracket.CreatedAt
+		return put(bucket, seq, bracket)
+	})
+}
+
+// Get returns the bracket with the given id, or ErrNotFound.
+func (s *Store) Get(id string) (*pb.BracketOrder, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	var bracket *pb.BracketOrder
+	err = s.db.View(func(tx *bolt.Tx) error {
+		bracket, err = get(tx.Bucket(bracketsBucket), seq)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bracket, nil
+}
+
+// List returns the brackets accepted by keep, newest first.
+func (s *Store) List(keep func(*pb.BracketOrder) bool) ([]*pb.BracketOrder, error) {
+	brackets := []*pb.BracketOrder{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(bracketsBucket).Cursor()
+		for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+			var bracket pb.BracketOrder
+			if err := proto.Unmarshal(v, &bracket); err != nil {
+				return fmt.Errorf("unmarshalling bracket %x: %w", k, err)
+			}
+			if keep(&bracket) {
+				brackets = append(brackets, &bracket)
+			}
+		}
+		return nil
+	})
+	return brackets, err
+}
+
+// Update applies change to the stored bracket and returns the result. change
+// may veto the update by returning an error.
+func (s *Store) Update(id string, change func(*pb.BracketOrder) error) (*pb.BracketOrder, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	var bracket *pb.BracketOrder
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bracketsBucket)
+		if bracket, err = get(bucket, seq); err != nil {
+			return err
+		}
+		if err := change(bracket); err != nil {
+			return err
+		}
+		bracket.UpdatedAt = time.Now().UnixMilli()
+		return put(bucket, seq, bracket)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bracket, nil
+}
+
+// legTag is the ordertag of one of the bracket's orders: e for the entry, t
+// and s for the target and stop-loss of an exit round.
+func legTag(id, leg string, round int32) string {
+	if round > 0 {
+		return fmt.Sprintf("oco-%s-%s%d", id, leg, round)
+	}
+	return fmt.Sprintf("oco-%s-%s", id, leg)
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func get(bucket *bolt.Bucket, seq uint64) (*pb.BracketOrder, error) {
+	data := bucket.Get(seqKey(seq))
+	if data == nil {
+		return nil, ErrNotFound
+	}
+	var bracket pb.BracketOrder
+	if err := proto.Unmarshal(data, &bracket); err != nil {
+		return nil, fmt.Errorf("unmarshalling bracket %d: %w", seq, err)
+	}
+	return &bracket, nil
+}
+
+func put(bucket *bolt.Bucket, seq uint64, bracket *pb.BracketOrder) error {
+	bracket.WaitingForSession = false // Only meaningful in memory
+	data, err := proto.Marshal(bracket)
+	if err != nil {
+		return fmt.Errorf("marshalling bracket %d: %w", seq, err)
+	}
+	return bucket.Put(seqKey(seq), data)
+}
@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+	"github.com/Sagar-v4/Angel-Two/services/broker/validation"
+)
+
+// NeedsPrice reports whether any of the rule's conditions depends on the LTP.
+func NeedsPrice(rule *pb.AlertRule) bool {
+	for _, cond := range rule.Conditions {
+		switch cond.Type {
+		case pb.AlertConditionType_TIME_AFTER, pb.AlertConditionType_TIME_BEFORE:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Met reports whether every condition of the rule holds at ltp and now.
+// Price conditions never hold while no LTP is known (havePrice false).
+func Met(rule *pb.AlertRule, ltp float64, havePrice bool, now time.Time) bool {
+	if len(rule.Conditions) == 0 {
+		return false
+	}
+	for _, cond := range rule.Conditions {
+		if !conditionMet(cond, rule.ReferencePrice, ltp, havePrice, now) {
+			return false
+		}
+	}
+	return true
+}
+
+func conditionMet(cond *pb.AlertCondition, reference, ltp float64, havePrice bool, now time.Time) bool {
+	switch cond.Type {
+	case pb.AlertConditionType_PRICE_ABOVE:
+		return havePrice && ltp >= cond.Value
+	case pb.AlertConditionType_PRICE_BELOW:
+		return havePrice && ltp <= cond.Value
+	case pb.AlertConditionType_PERCENT_CHANGE_ABOVE:
+		return havePrice && reference > 0 && PercentChange(reference, ltp) >= cond.Value
+	case pb.AlertConditionType_PERCENT_CHANGE_BELOW:
+		return havePrice && reference > 0 && PercentChange(reference, ltp) <= cond.Value
+	case pb.AlertConditionType_TIME_AFTER, pb.AlertConditionType_TIME_BEFORE:
+		at, err := time.Parse(validation.TimeLayout, cond.Time)
+		if err != nil {
+			return false
+		}
+		ist := now.In(angelone.IST)
+		after := ist.Hour()*60+ist.Minute() >= at.Hour()*60+at.Minute()
+		return after == (cond.Type == pb.AlertConditionType_TIME_AFTER)
+	}
+	return false
+}
+
+// PercentChange is the change from reference to ltp, in percent.
+func PercentChange(reference, ltp float64) float64 {
+	return (ltp - reference) / reference * 100
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+)
+
+func TestMet(t *testing.T) {
+	above := func(v float64) *pb.AlertCondition {
+		return &pb.AlertCondition{Type: pb.AlertConditionType_PRICE_ABOVE, Value: v}
+	}
+	below := func(v float64) *pb.AlertCondition {
+		return &pb.AlertCondition{Type: pb.AlertConditionType_PRICE_BELOW, Value: v}
+	}
+	pctAbove := func(v float64) *pb.AlertCondition {
+		return &pb.AlertCondition{Type: pb.AlertConditionType_PERCENT_CHANGE_ABOVE, Value: v}
+	}
+	pctBelow := func(v float64) *pb.AlertCondition {
+		return &pb.AlertCondition{Type: pb.AlertConditionType_PERCENT_CHANGE_BELOW, Value: v}
+	}
+	after := func(at string) *pb.AlertCondition {
+		return &pb.AlertCondition{Type: pb.AlertConditionType_TIME_AFTER, Time: at}
+	}
+	before := func(at string) *pb.AlertCondition {
+		return &pb.AlertCondition{Type: pb.AlertConditionType_TIME_BEFORE, Time: at}
+	}
+	// 10:30 IST, given in UTC to check the conversion.
+	now := time.Date(2026, 3, 2, 10, 30, 0, 0, angelone.IST).UTC()
+
+	tests := []struct {
+		name       string
+		conditions []*pb.AlertCondition
+		reference  float64
+		ltp        float64
+		havePrice  bool
+		want       bool
+	}{
+		{name: "no conditions", ltp: 100, havePrice: true, want: false},
+		{name: "above", conditions: []*pb.AlertCondition{above(100)}, ltp: 100.05, havePrice: true, want: true},
+		{name: "above at the level", conditions: []*pb.AlertCondition{above(100)}, ltp: 100, havePrice: true, want: true},
+		{name: "not above", conditions: []*pb.AlertCondition{above(100)}, ltp: 99.95, havePrice: true, want: false},
+		{name: "above without a price", conditions: []*pb.AlertCondition{above(0)}, want: false},
+		{name: "below", conditions: []*pb.AlertCondition{below(100)}, ltp: 100, havePrice: true, want: true},
+		{name: "not below", conditions: []*pb.AlertCondition{below(100)}, ltp: 100.05, havePrice: true, want: false},
+		{name: "below without a price", conditions: []*pb.AlertCondition{below(100)}, want: false},
+		{name: "percent above", conditions: []*pb.AlertCondition{pctAbove(2)}, reference: 200, ltp: 204, havePrice: true, want: true},
+		{name: "percent not above", conditions: []*pb.AlertCondition{pctAbove(2)}, reference: 200, ltp: 203.9, havePrice: true, want: false},
+		{name: "percent below", conditions: []*pb.AlertCondition{pctBelow(-5)}, reference: 200, ltp: 190, havePrice: true, want: true},
+		{name: "percent not below", conditions: []*pb.AlertCondition{pctBelow(-5)}, reference: 200, ltp: 191, havePrice: true, want: false},
+		{name: "percent without a reference", conditions: []*pb.AlertCondition{pctBelow(5)}, ltp: 190, havePrice: true, want: false},
+		{name: "after", conditions: []*pb.AlertCondition{after("10:30")}, want: true},
+		{name: "not yet after", conditions: []*pb.AlertCondition{after("10:31")}, want: false},
+		{name: "before", conditions: []*pb.AlertCondition{before("10:31")}, want: true},
+		{name: "no longer before", conditions: []*pb.AlertCondition{before("10:30")}, want: false},
+		{name: "malformed time", conditions: []*pb.AlertCondition{after("10.30")}, want: false},
+		{name: "all hold", conditions: []*pb.AlertCondition{above(100), before("15:00"), after("09:15")}, ltp: 101, havePrice: true, want: true},
+		{name: "one does not hold", conditions: []*pb.AlertCondition{above(100), after("11:00")}, ltp: 101, havePrice: true, want: false},
+		{name: "unspecified type", conditions: []*pb.AlertCondition{{Value: 1}}, ltp: 101, havePrice: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &pb.AlertRule{Conditions: tt.conditions, ReferencePrice: tt.reference}
+			if got := Met(rule, tt.ltp, tt.havePrice, now); got != tt.want {
+				t.Errorf("Met() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
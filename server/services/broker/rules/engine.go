@@ -0,0 +1,528 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+	"github.com/Sagar-v4/Angel-Two/services/broker/sessions"
+	"github.com/Sagar-v4/Angel-Two/services/broker/stream"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DefaultPollInterval = 5 * time.Second
+
+	// tickRetryDelay is how long the engine falls back to polling after the
+	// tick stream failed before it tries streaming again.
+	tickRetryDelay = time.Minute
+)
+
+// Engine evaluates active rules against live ticks, when the client's feed
+// token allows it, and against GetLTP polled every interval otherwise. Rules
+// with only time conditions are checked on every poll. A client's rules are
+// evaluated while the session registry holds a session for the client.
+//
+// Firing is idempotent: a rule moves from RULE_ACTIVE to RULE_FIRING in the
+// store before its order is sent, so it fires at most once even across
+// restarts, and the order carries the rule's ordertag so a firing whose reply
+// was lost can be settled from the order book instead of placing it again.
+type Engine struct {
+	store    *Store
+	angel    *angelone.Client
+	sessions *sessions.Registry
+	hub      *stream.Hub // nil disables the tick stream
+	interval time.Duration
+
+	mu      sync.Mutex
+	clients map[string]*client // Keyed by client code
+	firing  map[string]bool    // Rules whose order is being sent right now
+
+	wake chan struct{}
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// client is the engine's state for one Angel One account.
+type client struct {
+	code      string
+	rules     []*pb.AlertRule               // Active rules as of the last poll
+	prices    map[stream.Instrument]float64 // Latest LTP of the instruments the rules watch
+	watching  string                        // Instruments the tick subscription covers
+	feedToken string                        // Feed token the tick subscription was last tried with
+	sub       *stream.Subscriber
+	cancel    context.CancelFunc
+	noTicks   time.Time // Poll instead of streaming until then
+}
+
+func NewEngine(store *Store, angelClient *angelone.Client, registry *sessions.Registry, hub *stream.Hub, interval time.Duration) *Engine {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	e := &Engine{
+		store:    store,
+		angel:    angelClient,
+		sessions: registry,
+		hub:      hub,
+		interval: interval,
+		clients:  make(map[string]*client),
+		firing:   make(map[string]bool),
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+	}
+	registry.Watch(e.notify)
+	return e
+}
+
+// Start begins evaluating rules in the background.
+func (e *Engine) Start() {
+	e.wg.Add(1)
+	go e.run()
+}
+
+// Stop ends evaluation and closes the tick subscriptions. A rule caught in
+// the middle of firing is settled from the order book after the next start.
+func (e *Engine) Stop() {
+	close(e.stop)
+	e.wg.Wait()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, c := range e.clients {
+		c.stopTicks()
+	}
+}
+
+// Create stores a new active rule and evaluates it straight away.
+func (e *Engine) Create(rule *pb.AlertRule) error {
+	if err := e.store.Create(rule, time.Now()); err != nil {
+		return err
+	}
+	log.Printf("Broker Service: Created rule %s for client %s", rule.Id, rule.ClientCode)
+	e.notify()
+	return nil
+}
+
+// Get returns one of the client's rules, or ErrNotFound.
+func (e *Engine) Get(clientCode, id string) (*pb.AlertRule, error) {
+	rule, err := e.store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if rule.ClientCode != clientCode {
+		return nil, ErrNotFound
+	}
+	e.withLiveState(rule)
+	return rule, nil
+}
+
+// List returns the client's rules, newest first.
+func (e *Engine) List(clientCode string, activeOnly bool) ([]*pb.AlertRule, error) {
+	rules, err := e.store.List(func(rule *pb.AlertRule) bool {
+		return rule.ClientCode == clientCode && (!activeOnly || pending(rule))
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		e.withLiveState(rule)
+	}
+	return rules, nil
+}
+
+// Cancel stops an active rule. It returns ErrStateChanged, with the rule,
+// when the rule already fired or was cancelled.
+func (e *Engine) Cancel(clientCode, id string) (*pb.AlertRule, error) {
+	if _, err := e.Get(clientCode, id); err != nil {
+		return nil, err
+	}
+	rule, err := e.store.Update(id, []pb.AlertRuleState{pb.AlertRuleState_RULE_ACTIVE}, func(rule *pb.AlertRule) {
+		rule.State = pb.AlertRuleState_RULE_CANCELLED
+		rule.Message = "Cancelled"
+	})
+	if err == nil {
+		log.Printf("Broker Service: Cancelled rule %s for client %s", id, clientCode)
+		e.notify()
+	}
+	return rule, err
+}
+
+// withLiveState fills in the fields of a rule that are not persisted: the
+// latest price and whether the rule waits for a session.
+func (e *Engine) withLiveState(rule *pb.AlertRule) {
+	_, hasSession := e.sessions.Get(rule.ClientCode)
+	rule.WaitingForSession = pending(rule) && !hasSession
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if c, ok := e.clients[rule.ClientCode]; ok {
+		rule.LastPrice = c.prices[stream.Instrument{Exchange: rule.Exchange, Symboltoken: rule.Symboltoken}]
+	}
+}
+
+func pending(rule *pb.AlertRule) bool {
+	return rule.State == pb.AlertRuleState_RULE_ACTIVE || rule.State == pb.AlertRuleState_RULE_FIRING
+}
+
+func (e *Engine) notify() {
+	select {
+	case e.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (e *Engine) run() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		e.poll(time.Now())
+		select {
+		case <-ticker.C:
+		case <-e.wake:
+		case <-e.stop:
+			return
+		}
+	}
+}
+
+// poll refreshes every client's active rules, keeps their tick subscriptions
+// in step, polls the prices ticks have not covered and evaluates the rules.
+func (e *Engine) poll(now time.Time) {
+	rules, err := e.store.List(pending)
+	if err != nil {
+		log.Printf("Broker Service: Failed to load rules: %v", err)
+		return
+	}
+	byClient := make(map[string][]*pb.AlertRule)
+	for _, rule := range rules {
+		byClient[rule.ClientCode] = append(byClient[rule.ClientCode], rule)
+	}
+
+	e.mu.Lock()
+	for code := range byClient {
+		if _, ok := e.clients[code]; !ok {
+			e.clients[code] = &client{code: code, prices: make(map[stream.Instrument]float64)}
+		}
+	}
+	var ready []*client
+	for code, c := range e.clients {
+		c.rules = c.rules[:0]
+		for _, rule := range byClient[code] {
+			if rule.State == pb.AlertRuleState_RULE_ACTIVE {
+				c.rules = append(c.rules, rule)
+			}
+		}
+		if _, ok := e.sessions.Get(code); ok && len(byClient[code]) > 0 {
+			ready = append(ready, c)
+		} else {
+			c.stopTicks()
+			clear(c.prices)
+		}
+	}
+	e.mu.Unlock()
+
+	for _, c := range ready {
+		sess, ok := e.sessions.Get(c.code)
+		if !ok {
+			continue // Expired since
+		}
+		for _, rule := range byClient[c.code] {
+			if rule.State == pb.AlertRuleState_RULE_FIRING && !e.isFiring(rule.Id) {
+				e.settle(sess, rule) // Left over from a restart or a lost reply
+			}
+		}
+
+		unpriced := e.watch(c, sess, now)
+		e.pollPrices(c, sess, unpriced)
+		e.evaluate(c, nil, now)
+	}
+}
+
+func (e *Engine) isFiring(id string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.firing[id]
+}
+
+// watch keeps the client's tick subscription on the instruments its price
+// rules watch and returns those that still need polling: all of them while
+// streaming is unavailable, otherwise the ones that have not ticked yet.
+func (e *Engine) watch(c *client, sess sessions.Session, now time.Time) []stream.Instrument {
+	e.mu.Lock()
+	seen := make(map[stream.Instrument]bool)
+	var instruments []stream.Instrument
+	for _, rule := range c.rules {
+		inst := stream.Instrument{Exchange: rule.Exchange, Symboltoken: rule.Symboltoken}
+		if NeedsPrice(rule) && !seen[inst] {
+			seen[inst] = true
+			instruments = append(instruments, inst)
+		}
+	}
+	sort.Slice(instruments, func(i, j int) bool {
+		return instruments[i].Exchange+instruments[i].Symboltoken < instruments[j].Exchange+instruments[j].Symboltoken
+	})
+	for inst := range c.prices {
+		if !seen[inst] {
+			delete(c.prices, inst)
+		}
+	}
+	keys := make([]string, len(instruments))
+	for i, inst := range instruments {
+		keys[i] = inst.Exchange + ":" + inst.Symboltoken
+	}
+	watching := strings.Join(keys, ",")
+
+	if c.feedToken != sess.FeedToken {
+		c.stopTicks() // Resubscribe with the new feed token
+		c.noTicks = time.Time{}
+		c.feedToken = sess.FeedToken
+	}
+	streaming := e.hub != nil && sess.FeedToken != "" && len(instruments) > 0 && now.After(c.noTicks)
+	if !streaming {
+		c.stopTicks()
+		e.mu.Unlock()
+		return instruments
+	}
+	if c.sub != nil && c.watching == watching {
+		unpriced := c.unpriced(instruments)
+		e.mu.Unlock()
+		return unpriced
+	}
+	c.stopTicks()
+	e.mu.Unlock()
+
+	subscribed := make([]*pb.TickInstrument, len(instruments))
+	for i, inst := range instruments {
+		subscribed[i] = &pb.TickInstrument{Exchange: inst.Exchange, Symboltoken: inst.Symboltoken}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	creds := stream.Credentials{JWT: sess.JWT, FeedToken: sess.FeedToken, ClientCode: sess.ClientCode}
+	sub, err := e.hub.Subscribe(ctx, creds, pb.TickMode_LTP, subscribed)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		cancel()
+		log.Printf("Broker Service: Rules for client %s fall back to polling: %v", sess.ClientCode, err)
+		c.noTicks = now.Add(tickRetryDelay)
+		return instruments
+	}
+	c.sub, c.cancel, c.watching = sub, cancel, watching
+	go e.readTicks(c, sub, ctx)
+	return c.unpriced(instruments)
+}
+
+// unpriced returns the instruments without a price yet. e.mu must be held.
+func (c *client) unpriced(instruments []stream.Instrument) []stream.Instrument {
+	var unpriced []stream.Instrument
+	for _, inst := range instruments {
+		if _, ok := c.prices[inst]; !ok {
+			unpriced = append(unpriced, inst)
+		}
+	}
+	return unpriced
+}
+
+// stopTicks closes the client's tick subscription, if any. e.mu must be held.
+func (c *client) stopTicks() {
+	if c.sub == nil {
+		return
+	}
+	c.cancel()
+	c.sub.Close()
+	c.sub, c.cancel, c.watching = nil, nil, ""
+}
+
+// readTicks records every tick and evaluates the rules watching its
+// instrument, until the subscription is replaced or the feed fails.
+func (e *Engine) readTicks(c *client, sub *stream.Subscriber, ctx context.Context) {
+	for {
+		tick, err := sub.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("Broker Service: Tick stream for rules ended: %v", err)
+			e.mu.Lock()
+			if c.sub == sub {
+				c.stopTicks()
+				c.noTicks = time.Now().Add(tickRetryDelay)
+			}
+			e.mu.Unlock()
+			return
+		}
+
+		inst := stream.Instrument{Exchange: tick.Exchange, Symboltoken: tick.Symboltoken}
+		e.mu.Lock()
+		if c.sub == sub {
+			c.prices[inst] = tick.Ltp
+		}
+		e.mu.Unlock()
+		e.evaluate(c, &inst, time.Now())
+	}
+}
+
+// pollPrices fetches the LTP of the instruments through GetLTP.
+func (e *Engine) pollPrices(c *client, sess sessions.Session, instruments []stream.Instrument) {
+	if len(instruments) == 0 {
+		return
+	}
+	prices, err := e.sessions.LTP(sess, instruments)
+	if err != nil {
+		log.Printf("Broker Service: Failed to poll prices for rules of client %s: %v", sess.ClientCode, err)
+	}
+	e.mu.Lock()
+	for inst, ltp := range prices {
+		c.prices[inst] = ltp
+	}
+	e.mu.Unlock()
+}
+
+// evaluate fires the client's active rules whose conditions hold, only
+// those watching inst when it is given.
+func (e *Engine) evaluate(c *client, inst *stream.Instrument, now time.Time) {
+	type candidate struct {
+		rule *pb.AlertRule
+		ltp  float64
+	}
+	var due []candidate
+
+	sess, ok := e.sessions.Get(c.code)
+	if !ok {
+		return // Waiting for a new session
+	}
+	e.mu.Lock()
+	for _, rule := range c.rules {
+		key := stream.Instrument{Exchange: rule.Exchange, Symboltoken: rule.Symboltoken}
+		if (inst != nil && key != *inst) || e.firing[rule.Id] {
+			continue
+		}
+		ltp, ok := c.prices[key]
+		if Met(rule, ltp, ok, now) {
+			e.firing[rule.Id] = true
+			due = append(due, candidate{rule, ltp})
+		}
+	}
+	e.mu.Unlock()
+
+	for _, d := range due {
+		e.fire(sess, d.rule, d.ltp, now)
+		e.mu.Lock()
+		delete(e.firing, d.rule.Id)
+		for i, rule := range c.rules {
+			if rule.Id == d.rule.Id { // Back in the next poll if it is still active
+				c.rules = append(c.rules[:i:i], c.rules[i+1:]...)
+				break
+			}
+		}
+		e.mu.Unlock()
+	}
+}
+
+// fire claims the rule and places its order.
+func (e *Engine) fire(sess sessions.Session, rule *pb.AlertRule, ltp float64, now time.Time) {
+	claimed, err := e.store.Update(rule.Id, []pb.AlertRuleState{pb.AlertRuleState_RULE_ACTIVE}, func(rule *pb.AlertRule) {
+		rule.State = pb.AlertRuleState_RULE_FIRING
+		rule.TriggeredAt = now.UnixMilli()
+		if NeedsPrice(rule) {
+			rule.TriggerPrice = ltp
+		}
+	})
+	if err != nil {
+		if !errors.Is(err, ErrStateChanged) { // Otherwise cancelled or fired meanwhile
+			log.Printf("Broker Service: Failed to claim rule %s: %v", rule.Id, err)
+		}
+		return
+	}
+	rule = claimed
+	log.Printf("Broker Service: Rule %s triggered at %.2f; placing its order", rule.Id, ltp)
+
+	order := proto.Clone(rule.Order).(*pb.PlaceOrderRequest)
+	order.AngelOneJwt = sess.JWT
+	order.ClientLocalIp = sess.ClientLocalIP
+	order.ClientPublicIp = sess.ClientPublicIP
+	order.MacAddress = sess.MacAddress
+	order.Ordertag = rule.Ordertag
+	order.CheckMargin = false
+
+	resp, err := e.angel.PlaceOrder(order)
+	switch {
+	case err != nil || resp.Errorcode == "UNMARSHAL_ERROR":
+		// The order may have reached Angel One; never send it twice.
+		e.settle(sess, rule)
+	case resp.Status && resp.Data != nil && resp.Data.Orderid != "":
+		e.finish(rule.Id, func(rule *pb.AlertRule) {
+			rule.State = pb.AlertRuleState_RULE_FIRED
+			rule.Orderid = resp.Data.Orderid
+			rule.Uniqueorderid = resp.Data.Uniqueorderid
+			rule.Message = resp.Message
+		})
+	case e.sessions.Check(sess, resp.Errorcode):
+		// Rejected before placing anything; fire again once the client is back.
+		e.finish(rule.Id, func(rule *pb.AlertRule) {
+			rule.State = pb.AlertRuleState_RULE_ACTIVE
+			rule.TriggeredAt, rule.TriggerPrice = 0, 0
+			rule.Message = resp.Message
+		})
+	default:
+		e.finish(rule.Id, func(rule *pb.AlertRule) {
+			rule.State = pb.AlertRuleState_RULE_FAILED
+			rule.Message = resp.Message
+		})
+	}
+}
+
+// settle resolves a rule stuck in RULE_FIRING by looking for its ordertag in
+// the order book. It stays RULE_FIRING while the order book is unavailable.
+func (e *Engine) settle(sess sessions.Session, rule *pb.AlertRule) {
+	book, err := e.angel.GetOrderBook(&pb.GetOrderBookRequest{
+		AngelOneJwt:    sess.JWT,
+		ClientLocalIp:  sess.ClientLocalIP,
+		ClientPublicIp: sess.ClientPublicIP,
+		MacAddress:     sess.MacAddress,
+	})
+	if err != nil || !book.Status {
+		if err == nil {
+			e.sessions.Check(sess, book.Errorcode)
+			err = errors.New(book.Message)
+		}
+		log.Printf("Broker Service: Rule %s is waiting for the order book: %v", rule.Id, err)
+		return
+	}
+
+	for _, order := range book.Data {
+		if order.Ordertag == rule.Ordertag {
+			e.finish(rule.Id, func(rule *pb.AlertRule) {
+				rule.State = pb.AlertRuleState_RULE_FIRED
+				rule.Orderid = order.Orderid
+				rule.Uniqueorderid = order.Uniqueorderid
+				rule.Message = "Order found in the order book"
+			})
+			return
+		}
+	}
+	e.finish(rule.Id, func(rule *pb.AlertRule) {
+		rule.State = pb.AlertRuleState_RULE_FAILED
+		rule.Message = "Order placement could not be confirmed and the order is not in the order book"
+	})
+}
+
+func (e *Engine) finish(id string, change func(*pb.AlertRule)) {
+	rule, err := e.store.Update(id, []pb.AlertRuleState{pb.AlertRuleState_RULE_FIRING}, change)
+	if errors.Is(err, ErrStateChanged) {
+		return // Settled from a stale copy after the firing finished
+	}
+	if err != nil {
+		log.Printf("Broker Service: Failed to record the outcome of rule %s: %v", id, err)
+		return
+	}
+	log.Printf("Broker Service: Rule %s is now %s: %s", id, rule.State, rule.Message)
+}
@@ -0,0 +1,171 @@
+// Package rules runs conditional orders locally: a rule holds a stored
+// PlaceOrderRequest and the price and time conditions that release it. Rules
+// live in a BoltDB file, so they survive restarts, and fire at most once.
+package rules
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var rulesBucket = []byte("rules")
+
+var (
+	ErrNotFound = errors.New("rule not found")
+	// ErrStateChanged is returned by Update when the rule is no longer in one
+	// of the expected states, e.g. it fired before it could be cancelled.
+	ErrStateChanged = errors.New("rule state changed")
+)
+
+// Store is a BoltDB-backed rule store. Rules are keyed by a sequence number,
+// which is also their id.
+type Store struct {
+	db *bolt.DB
+}
+
+// Open opens (or creates) the rule file at path. BoltDB holds an exclusive
+// lock on the file, so only one broker process can use it at a time.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening rule store %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(rulesBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating rule bucket: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Create assigns the rule its id and order tag and stores it as active.
+func (s *Store) Create(rule *pb.AlertRule, now time.Time) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rulesBucket)
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		rule.Id = strconv.FormatUint(seq, 10)
+		rule.Ordertag = "rule-" + rule.Id
+		rule.State = pb.AlertRuleState_RULE_ACTIVE
+		rule.CreatedAt = now.UnixMilli()
+		return put(bucket, seq, rule)
+	})
+}
+
+// Get returns the rule with the given id, or ErrNotFound.
+func (s *Store) Get(id string) (*pb.AlertRule, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	var rule *pb.AlertRule
+	err = s.db.View(func(tx *bolt.Tx) error {
+		rule, err = get(tx.Bucket(rulesBucket), seq)
+		return err
+	})
+	return rule, err
+}
+
+// List returns the rules accepted by keep, newest first.
+func (s *Store) List(keep func(*pb.AlertRule) bool) ([]*pb.AlertRule, error) {
+	rules := []*pb.AlertRule{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(rulesBucket).Cursor()
+		for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+			var rule pb.AlertRule
+			if err := proto.Unmarshal(v, &rule); err != nil {
+				return fmt.Errorf("unmarshalling rule %x: %w", k, err)
+			}
+			if keep(&rule) {
+				rules = append(rules, &rule)
+			}
+		}
+		return nil
+	})
+	return rules, err
+}
+
+// Update applies change to the rule if it is in one of the from states and
+// returns the stored result. The check and the write share one transaction,
+// which is what makes firing and cancelling mutually exclusive.
+func (s *Store) Update(id string, from []pb.AlertRuleState, change func(*pb.AlertRule)) (*pb.AlertRule, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	var rule *pb.AlertRule
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rulesBucket)
+		rule, err = get(bucket, seq)
+		if err != nil {
+			return err
+		}
+		if !inStates(rule.State, from) {
+			return ErrStateChanged
+		}
+		change(rule)
+		return put(bucket, seq, rule)
+	})
+	if errors.Is(err, ErrStateChanged) {
+		return rule, err // Callers report the state it moved to
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func inStates(state pb.AlertRuleState, states []pb.AlertRuleState) bool {
+	for _, st := range states {
+		if state == st {
+			return true
+		}
+	}
+	return false
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func get(bucket *bolt.Bucket, seq uint64) (*pb.AlertRule, error) {
+	data := bucket.Get(seqKey(seq))
+	if data == nil {
+		return nil, ErrNotFound
+	}
+	var rule pb.AlertRule
+	if err := proto.Unmarshal(data, &rule); err != nil {
+		return nil, fmt.Errorf("unmarshalling rule %d: %w", seq, err)
+	}
+	return &rule, nil
+}
+
+func put(bucket *bolt.Bucket, seq uint64, rule *pb.AlertRule) error {
+	rule.LastPrice, rule.WaitingForSession = 0, false // Only meaningful in memory
+	data, err := proto.Marshal(rule)
+	if err != nil {
+		return fmt.Errorf("marshalling rule: %w", err)
+	}
+	return bucket.Put(seqKey(seq), data)
+}
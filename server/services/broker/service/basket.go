@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultBasketParallel = 4
+	maxBasketParallel     = 10 // Stays well under Angel One's order rate limit
+)
+
+// PlaceBasket places the legs concurrently, at most max_parallel at a time.
+// With rollback_on_failure, legs that have not started when one fails are
+// skipped and the placed ones are cancelled; legs that already filled cannot
+// be undone, so the rollback is best effort. Every leg is tagged
+// bkt-<basket>-<index>, so legs whose placement reply was lost are looked up
+// in the order book before deciding what to roll back.
+func (s *BrokerServer) PlaceBasket(ctx context.Context, req *pb.PlaceBasketRequest) (*pb.PlaceBasketResponse, error) {
+	log.Printf("Broker Service: PlaceBasket called with %d legs (rollback: %t)", len(req.Legs), req.RollbackOnFailure)
+	if req.AngelOneJwt == "" {
+		return &pb.PlaceBasketResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	for i, leg := range req.Legs {
+		if leg == nil {
+			continue // Rejected by the validator
+		}
+		leg.AngelOneJwt = req.AngelOneJwt
+		leg.ClientLocalIp = req.ClientLocalIp
+		leg.ClientPublicIp = req.ClientPublicIp
+		leg.MacAddress = req.MacAddress
+		leg.CheckMargin = false
+		if err := s.resolveSymbol("basket", fmt.Sprintf("legs[%d].", i), leg.Exchange, &leg.Tradingsymbol, &leg.Symboltoken); err != nil {
+			log.Printf("Broker Service: PlaceBasket rejected: %v", err)
+			return nil, err
+		}
+	}
+	if err := s.validator.PlaceBasket(req); err != nil {
+		log.Printf("Broker Service: PlaceBasket rejected: %v", err)
+		return nil, err
+	}
+
+	basketID, err := newBasketID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "tagging basket legs: %v", err)
+	}
+	for i, leg := range req.Legs {
+		leg.Ordertag = fmt.Sprintf("bkt-%s-%d", basketID, i)
+	}
+
+	parallel := int(req.MaxParallel)
+	if parallel == 0 {
+		parallel = defaultBasketParallel
+	} else if parallel > maxBasketParallel {
+		parallel = maxBasketParallel
+	}
+
+	results := make([]*pb.BasketLegResult, len(req.Legs))
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallel)
+	for i, leg := range req.Legs {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, leg *pb.PlaceOrderRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if req.RollbackOnFailure && failed.Load() {
+				results[i] = &pb.BasketLegResult{Index: int32(i), Skipped: true, Message: "Skipped because another leg failed"}
+				return
+			}
+			results[i] = s.placeBasketLeg(i, leg)
+			if !results[i].Placed {
+				failed.Store(true)
+			}
+		}(i, leg)
+	}
+	wg.Wait()
+	s.settleBasket(req, results)
+
+	resp := &pb.PlaceBasketResponse{Status: true, Legs: results}
+	placed := 0
+	for _, result := range results {
+		if result.Placed {
+			placed++
+		} else if resp.Errorcode == "" && result.Errorcode != "" {
+			resp.Errorcode = result.Errorcode // e.g. a session error the gateway can refresh on
+		}
+	}
+	if placed == len(results) {
+		resp.Message = fmt.Sprintf("All %d legs placed", placed)
+		return resp, nil
+	}
+
+	resp.Status = false
+	resp.Message = fmt.Sprintf("%d of %d legs placed", placed, len(results))
+	if unconfirmed := countUnconfirmed(results); unconfirmed > 0 {
+		resp.Message += fmt.Sprintf("; %d could not be confirmed", unconfirmed)
+	}
+	if req.RollbackOnFailure && placed > 0 {
+		resp.RolledBack = true
+		cancelled := s.rollbackBasket(req, results, sem)
+		resp.Message += fmt.Sprintf("; rolled back %d of %d", cancelled, placed)
+	}
+	log.Printf("Broker Service: PlaceBasket failed: %s", resp.Message)
+	return resp, nil
+}
+
+func (s *BrokerServer) placeBasketLeg(i int, leg *pb.PlaceOrderRequest) *pb.BasketLegResult {
+	result := &pb.BasketLegResult{Index: int32(i), Ordertag: leg.Ordertag}
+	resp, err := s.angelClient.PlaceOrder(leg)
+	switch {
+	case err != nil:
+		// The request may still have reached Angel One
+		result.Unconfirmed = true
+		result.Message = err.Error()
+	case resp.Errorcode == "UNMARSHAL_ERROR":
+		result.Unconfirmed = true
+		result.Message = resp.Message
+		result.Errorcode = resp.Errorcode
+	case !resp.Status || resp.Data == nil || resp.Data.Orderid == "":
+		result.Message = resp.Message
+		result.Errorcode = resp.Errorcode
+	default:
+		result.Placed = true
+		result.Message = resp.Message
+		result.Orderid = resp.Data.Orderid
+		result.Uniqueorderid = resp.Data.Uniqueorderid
+	}
+	return result
+}
+
+// settleBasket looks the legs whose placement could not be confirmed up in
+// the order book by their ordertag. Legs that are still unconfirmed afterwards
+// keep Unconfirmed set; the rollback cannot cancel them.
+func (s *BrokerServer) settleBasket(req *pb.PlaceBasketRequest, results []*pb.BasketLegResult) {
+	if countUnconfirmed(results) == 0 {
+		return
+	}
+
+	book, err := s.angelClient.GetOrderBook(&pb.GetOrderBookRequest{
+		AngelOneJwt:    req.AngelOneJwt,
+		ClientLocalIp:  req.ClientLocalIp,
+		ClientPublicIp: req.ClientPublicIp,
+		MacAddress:     req.MacAddress,
+	})
+	if err == nil && !book.Status {
+		err = errors.New(book.Message)
+	}
+	if err != nil {
+		log.Printf("Broker Service: PlaceBasket could not read the order book to confirm its legs: %v", err)
+		for _, result := range results {
+			if result.Unconfirmed {
+				result.Message = fmt.Sprintf("Placement could not be confirmed (%s) and the order book is unavailable (%v); look for ordertag %s", result.Message, err, result.Ordertag)
+			}
+		}
+		return
+	}
+
+	byTag := make(map[string]*pb.OrderBookItem, len(book.Data))
+	for _, order := range book.Data {
+		byTag[order.Ordertag] = order
+	}
+	for _, result := range results {
+		if !result.Unconfirmed {
+			continue
+		}
+		result.Unconfirmed = false
+		order, ok := byTag[result.Ordertag]
+		switch {
+		case !ok:
+			result.Message = "Placement could not be confirmed and the order is not in the order book"
+		case strings.EqualFold(order.Status, "rejected"):
+			result.Message = "Order found in the order book as rejected: " + order.Text
+		default:
+			result.Placed = true
+			result.Orderid = order.Orderid
+			result.Uniqueorderid = order.Uniqueorderid
+			result.Errorcode = ""
+			result.Message = "Order found in the order book"
+		}
+	}
+}
+
+func countUnconfirmed(results []*pb.BasketLegResult) int {
+	n := 0
+	for _, result := range results {
+		if result.Unconfirmed {
+			n++
+		}
+	}
+	return n
+}
+
+// newBasketID returns a short random id, so basket ordertags stay within
+// Angel One's 20 characters.
+func newBasketID() (string, error) {
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// rollbackBasket cancels every placed leg, reusing the basket's parallelism,
+// and returns how many were cancelled.
+func (s *BrokerServer) rollbackBasket(req *pb.PlaceBasketRequest, results []*pb.BasketLegResult, sem chan struct{}) int {
+	var cancelled atomic.Int32
+	var wg sync.WaitGroup
+	for i, result := range results {
+		if !result.Placed {
+			continue
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(leg *pb.PlaceOrderRequest, result *pb.BasketLegResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := s.angelClient.CancelOrder(&pb.CancelOrderRequest{
+				AngelOneJwt:    req.AngelOneJwt,
+				Variety:        leg.Variety,
+				Orderid:        result.Orderid,
+				ClientLocalIp:  req.ClientLocalIp,
+				ClientPublicIp: req.ClientPublicIp,
+				MacAddress:     req.MacAddress,
+			})
+			switch {
+			case err != nil:
+				result.CancelError = err.Error()
+			case !resp.Status:
+				result.CancelError = resp.Message
+			default:
+				result.Cancelled = true
+				cancelled.Add(1)
+			}
+		}(req.Legs[i], result)
+	}
+	wg.Wait()
+	return int(cancelled.Load())
+}
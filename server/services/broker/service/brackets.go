@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/oco"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *BrokerServer) PlaceBracketOrder(ctx context.Context, req *pb.PlaceBracketOrderRequest) (*pb.BracketOrderResponse, error) {
+	log.Printf("Broker Service: PlaceBracketOrder called for %s", req.GetEntry().GetTradingsymbol())
+	if req.AngelOneJwt == "" {
+		return &pb.BracketOrderResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.brackets == nil {
+		return nil, status.Error(codes.Unavailable, "bracket orders are disabled")
+	}
+
+	entry := req.Entry
+	if entry != nil {
+		if err := s.resolveSymbol("bracket order", "entry.", entry.Exchange, &entry.Tradingsymbol, &entry.Symboltoken); err != nil {
+			log.Printf("Broker Service: PlaceBracketOrder rejected: %v", err)
+			return nil, err
+		}
+	}
+	if err := s.validator.PlaceBracketOrder(req); err != nil {
+		log.Printf("Broker Service: PlaceBracketOrder rejected: %v", err)
+		return nil, err
+	}
+
+	sess, profile, err := s.session(req.AngelOneJwt, "", req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.BracketOrderResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	if entry.CheckMargin {
+		order := proto.Clone(entry).(*pb.PlaceOrderRequest)
+		order.AngelOneJwt = req.AngelOneJwt
+		order.ClientLocalIp = req.ClientLocalIp
+		order.ClientPublicIp = req.ClientPublicIp
+		order.MacAddress = req.MacAddress
+		if resp, err := s.checkMargin(ctx, order); err != nil {
+			return nil, err
+		} else if resp != nil {
+			return &pb.BracketOrderResponse{Status: false, Message: resp.Message, Errorcode: resp.Errorcode}, nil
+		}
+	}
+
+	// The stored entry carries no credentials; the manager adds the client's
+	// current session to every order it sends.
+	stored := proto.Clone(entry).(*pb.PlaceOrderRequest)
+	stored.AngelOneJwt = ""
+	stored.ClientLocalIp = ""
+	stored.ClientPublicIp = ""
+	stored.MacAddress = ""
+	stored.CheckMargin = false
+	stored.Ordertag = ""
+	bracket := &pb.BracketOrder{
+		ClientCode:           sess.ClientCode,
+		Entry:                stored,
+		TargetPrice:          req.TargetPrice,
+		StoplossTriggerprice: req.StoplossTriggerprice,
+		StoplossPrice:        req.StoplossPrice,
+	}
+
+	resp, err := s.brackets.Place(sess, bracket)
+	if bracket.Id == "" {
+		log.Printf("Broker Service: PlaceBracketOrder failed to store the bracket: %v", err)
+		return nil, bracketError(err)
+	}
+	if err != nil || resp.Errorcode == "UNMARSHAL_ERROR" {
+		log.Printf("Broker Service: PlaceBracketOrder could not confirm the entry of bracket %s: %v", bracket.Id, err)
+		return &pb.BracketOrderResponse{
+			Status:  true,
+			Message: "Bracket created; the entry order could not be confirmed and will be looked up in the order book",
+			Data:    bracket,
+		}, nil
+	}
+	return &pb.BracketOrderResponse{Status: resp.Status, Message: resp.Message, Errorcode: resp.Errorcode, Data: bracket}, nil
+}
+
+func (s *BrokerServer) ListBracketOrders(ctx context.Context, req *pb.ListBracketOrdersRequest) (*pb.ListBracketOrdersResponse, error) {
+	log.Printf("Broker Service: ListBracketOrders called (active only: %t)", req.ActiveOnly)
+	if req.AngelOneJwt == "" {
+		return &pb.ListBracketOrdersResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.brackets == nil {
+		return nil, status.Error(codes.Unavailable, "bracket orders are disabled")
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.ListBracketOrdersResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	list, err := s.brackets.List(clientCode, req.ActiveOnly)
+	if err != nil {
+		return nil, bracketError(err)
+	}
+	return &pb.ListBracketOrdersResponse{Status: true, Message: "SUCCESS", Data: list}, nil
+}
+
+func (s *BrokerServer) GetBracketOrder(ctx context.Context, req *pb.BracketOrderIDRequest) (*pb.BracketOrderResponse, error) {
+	log.Printf("Broker Service: GetBracketOrder called for bracket ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.BracketOrderResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.brackets == nil {
+		return nil, status.Error(codes.Unavailable, "bracket orders are disabled")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.BracketOrderResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	bracket, err := s.brackets.Get(clientCode, req.Id)
+	if err != nil {
+		return nil, bracketError(err)
+	}
+	return &pb.BracketOrderResponse{Status: true, Message: "SUCCESS", Data: bracket}, nil
+}
+
+func (s *BrokerServer) CancelBracketOrder(ctx context.Context, req *pb.BracketOrderIDRequest) (*pb.BracketOrderResponse, error) {
+	log.Printf("Broker Service: CancelBracketOrder called for bracket ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.BracketOrderResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.brackets == nil {
+		return nil, status.Error(codes.Unavailable, "bracket orders are disabled")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	sess, profile, err := s.session(req.AngelOneJwt, "", req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.BracketOrderResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	bracket, err := s.brackets.Cancel(sess.ClientCode, req.Id)
+	if err != nil {
+		return nil, bracketError(err)
+	}
+	return &pb.BracketOrderResponse{Status: true, Message: "Cancellation requested", Data: bracket}, nil
+}
+
+func bracketError(err error) error {
+	switch {
+	case errors.Is(err, oco.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, oco.ErrFinished):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "reading bracket orders: %v", err)
+}
@@ -2,22 +2,54 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 
 	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/alerts"
 	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
-	// "google.golang.org/grpc/codes"
-	// "google.golang.org/grpc/status"
+	"github.com/Sagar-v4/Angel-Two/services/broker/candlecache"
+	"github.com/Sagar-v4/Angel-Two/services/broker/instruments"
+	"github.com/Sagar-v4/Angel-Two/services/broker/oco"
+	"github.com/Sagar-v4/Angel-Two/services/broker/rules"
+	"github.com/Sagar-v4/Angel-Two/services/broker/sessions"
+	"github.com/Sagar-v4/Angel-Two/services/broker/stream"
+	"github.com/Sagar-v4/Angel-Two/services/broker/validation"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type BrokerServer struct {
 	pb.UnimplementedBrokerServiceServer
 	angelClient *angelone.Client
+	validator   *validation.Validator
+	candleCache *candlecache.Cache  // nil when caching is disabled
+	instruments *instruments.Master // nil when the instrument master is disabled
+	tickHub     *stream.Hub
+	orderHub    *stream.OrderHub
+	rules       *rules.Engine   // nil when conditional order rules are disabled
+	priceAlerts *alerts.Monitor // nil when price alerts are disabled
+	brackets    *oco.Manager    // nil when bracket orders are disabled
+	sessions    *sessions.Registry
+	clientCodes *clientCodeCache
 }
 
-func NewBrokerServer(angelClient *angelone.Client) *BrokerServer {
+func NewBrokerServer(angelClient *angelone.Client, validator *validation.Validator, candleCache *candlecache.Cache, instrumentMaster *instruments.Master, tickHub *stream.Hub, orderHub *stream.OrderHub, ruleEngine *rules.Engine, priceAlerts *alerts.Monitor, brackets *oco.Manager, registry *sessions.Registry) *BrokerServer {
 	return &BrokerServer{
 		angelClient: angelClient,
+		validator:   validator,
+		candleCache: candleCache,
+		instruments: instrumentMaster,
+		tickHub:     tickHub,
+		orderHub:    orderHub,
+		rules:       ruleEngine,
+		priceAlerts: priceAlerts,
+		brackets:    brackets,
+		sessions:    registry,
+		clientCodes: newClientCodeCache(),
 	}
 }
 
@@ -83,10 +115,71 @@ func (s *BrokerServer) PlaceOrder(ctx context.Context, req *pb.PlaceOrderRequest
 	if req.AngelOneJwt == "" { // Basic validation
 		return &pb.PlaceOrderResponse{Status: false, Message: "Missing Angel One JWT"}, nil
 	}
-	// Further validation of order parameters can be added here
+	if err := s.resolveSymbol("order", "", req.Exchange, &req.Tradingsymbol, &req.Symboltoken); err != nil {
+		log.Printf("Broker Service: PlaceOrder rejected: %v", err)
+		return nil, err
+	}
+	if err := s.validator.PlaceOrder(req); err != nil {
+		log.Printf("Broker Service: PlaceOrder rejected: %v", err)
+		return nil, err // InvalidArgument with field-level details
+	}
+	if req.CheckMargin {
+		if resp, err := s.checkMargin(ctx, req); resp != nil || err != nil {
+			return resp, err
+		}
+	}
 	return s.angelClient.PlaceOrder(req)
 }
 
+// checkMargin compares the margin the order needs with the funds available.
+// It returns a non-nil response or error when the order must not be placed.
+func (s *BrokerServer) checkMargin(ctx context.Context, req *pb.PlaceOrderRequest) (*pb.PlaceOrderResponse, error) {
+	margin, err := s.angelClient.CalculateMargin(ctx, &pb.CalculateMarginRequest{
+		AngelOneJwt:    req.AngelOneJwt,
+		Legs:           []*pb.PlaceOrderRequest{req},
+		ClientLocalIp:  req.ClientLocalIp,
+		ClientPublicIp: req.ClientPublicIp,
+		MacAddress:     req.MacAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !margin.Status {
+		return &pb.PlaceOrderResponse{Status: false, Message: "Failed to calculate margin: " + margin.Message, Errorcode: margin.Errorcode}, nil
+	}
+
+	funds, err := s.angelClient.GetRMSLimits(&pb.GetRMSLimitsRequest{
+		AngelOneJwt:    req.AngelOneJwt,
+		ClientLocalIp:  req.ClientLocalIp,
+		ClientPublicIp: req.ClientPublicIp,
+		MacAddress:     req.MacAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !funds.Status {
+		return &pb.PlaceOrderResponse{Status: false, Message: "Failed to fetch funds: " + funds.Message, Errorcode: funds.Errorcode}, nil
+	}
+
+	required, available := margin.Data.Totalmarginrequired, funds.Data.Net
+	if required <= available {
+		return nil, nil
+	}
+	log.Printf("Broker Service: PlaceOrder rejected: margin %.2f exceeds available funds %.2f", required, available)
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("insufficient funds: order requires margin of %.2f but only %.2f is available", required, available))
+	detailed, detailErr := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{{
+			Type:        "MARGIN",
+			Subject:     "funds",
+			Description: fmt.Sprintf("required %.2f, available %.2f", required, available),
+		}},
+	})
+	if detailErr != nil {
+		return nil, st.Err()
+	}
+	return nil, detailed.Err()
+}
+
 func (s *BrokerServer) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
 	log.Printf("Broker Service: CancelOrder called for order ID: %s", req.Orderid)
 	if req.AngelOneJwt == "" {
@@ -95,12 +188,70 @@ func (s *BrokerServer) CancelOrder(ctx context.Context, req *pb.CancelOrderReque
 	return s.angelClient.CancelOrder(req)
 }
 
+func (s *BrokerServer) ModifyOrder(ctx context.Context, req *pb.ModifyOrderRequest) (*pb.ModifyOrderResponse, error) {
+	log.Printf("Broker Service: ModifyOrder called for order ID: %s", req.Orderid)
+	if req.AngelOneJwt == "" {
+		return &pb.ModifyOrderResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if err := s.resolveSymbol("order modification", "", req.Exchange, &req.Tradingsymbol, &req.Symboltoken); err != nil {
+		log.Printf("Broker Service: ModifyOrder rejected: %v", err)
+		return nil, err
+	}
+	if err := s.validator.ModifyOrder(req); err != nil {
+		log.Printf("Broker Service: ModifyOrder rejected: %v", err)
+		return nil, err
+	}
+	return s.angelClient.ModifyOrder(req)
+}
+
 func (s *BrokerServer) GetOrderBook(ctx context.Context, req *pb.GetOrderBookRequest) (*pb.GetOrderBookResponse, error) {
 	log.Printf("Broker Service: GetOrderBook called with AngelOneJWT: %.10s...", req.AngelOneJwt)
 	if req.AngelOneJwt == "" {
 		return &pb.GetOrderBookResponse{Status: false, Message: "Missing Angel One JWT"}, nil
 	}
-	return s.angelClient.GetOrderBook(req)
+	resp, err := s.angelClient.GetOrderBook(req)
+	if err != nil || !resp.Status || !req.IncludeTrades {
+		return resp, err
+	}
+
+	trades, err := s.angelClient.GetTradeBook(&pb.GetTradeBookRequest{
+		AngelOneJwt:    req.AngelOneJwt,
+		ClientLocalIp:  req.ClientLocalIp,
+		ClientPublicIp: req.ClientPublicIp,
+		MacAddress:     req.MacAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !trades.Status {
+		// Returning orders without their fills would look like nothing was filled.
+		return &pb.GetOrderBookResponse{Status: false, Message: "Failed to fetch trade book: " + trades.Message, Errorcode: trades.Errorcode}, nil
+	}
+	angelone.JoinTrades(resp.Data, trades.Data)
+	return resp, nil
+}
+
+func (s *BrokerServer) GetOrderDetails(ctx context.Context, req *pb.GetOrderDetailsRequest) (*pb.GetOrderDetailsResponse, error) {
+	log.Printf("Broker Service: GetOrderDetails called for unique order ID: %s", req.Uniqueorderid)
+	if req.AngelOneJwt == "" {
+		return &pb.GetOrderDetailsResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if req.Uniqueorderid == "" {
+		return nil, status.Error(codes.InvalidArgument, "uniqueorderid is required")
+	}
+	resp, err := s.angelClient.GetOrderDetails(req)
+	if errors.Is(err, angelone.ErrOrderNotFound) {
+		return nil, status.Errorf(codes.NotFound, "order %s not found", req.Uniqueorderid)
+	}
+	return resp, err
+}
+
+func (s *BrokerServer) GetTradeBook(ctx context.Context, req *pb.GetTradeBookRequest) (*pb.GetTradeBookResponse, error) {
+	log.Printf("Broker Service: GetTradeBook called with AngelOneJWT: %.10s...", req.AngelOneJwt)
+	if req.AngelOneJwt == "" {
+		return &pb.GetTradeBookResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	return s.angelClient.GetTradeBook(req)
 }
 
 func (s *BrokerServer) GetHoldings(ctx context.Context, req *pb.GetHoldingsRequest) (*pb.GetHoldingsResponse, error) {
@@ -111,6 +262,109 @@ func (s *BrokerServer) GetHoldings(ctx context.Context, req *pb.GetHoldingsReque
 	return s.angelClient.GetHoldings(req)
 }
 
+func (s *BrokerServer) GetPositions(ctx context.Context, req *pb.GetPositionsRequest) (*pb.GetPositionsResponse, error) {
+	log.Printf("Broker Service: GetPositions called with AngelOneJWT: %.10s...", req.AngelOneJwt)
+	if req.AngelOneJwt == "" {
+		return &pb.GetPositionsResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	return s.angelClient.GetPositions(req)
+}
+
+// ConvertPosition converts an open position to another product type, e.g.
+// INTRADAY to DELIVERY before the intraday square-off. The quantity is checked
+// against the live position, which also supplies the contract details Angel One
+// expects in the conversion payload.
+func (s *BrokerServer) ConvertPosition(ctx context.Context, req *pb.ConvertPositionRequest) (*pb.ConvertPositionResponse, error) {
+	log.Printf("Broker Service: ConvertPosition called for %s:%s %s -> %s, qty %d", req.Exchange, req.Symboltoken, req.Oldproducttype, req.Newproducttype, req.Quantity)
+	if req.AngelOneJwt == "" {
+		return &pb.ConvertPositionResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if req.Type == "" {
+		req.Type = validation.PositionTypeDay
+	}
+	if err := s.validator.ConvertPosition(req); err != nil {
+		log.Printf("Broker Service: ConvertPosition rejected: %v", err)
+		return nil, err
+	}
+
+	positions := s.angelClient.FetchPositions(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if !positions.Status {
+		return &pb.ConvertPositionResponse{Status: false, Message: positions.Message, Errorcode: positions.ErrorCode}, nil
+	}
+
+	var position *angelone.AngelPositionItem
+	var openQty int64
+	for _, p := range positions.Data {
+		if p == nil || p.Exchange != req.Exchange || p.SymbolToken != req.Symboltoken || p.ProductType != req.Oldproducttype {
+			continue
+		}
+		position = p
+		if req.Type == validation.PositionTypeCarryForward {
+			openQty = p.CarryForwardNetQty()
+		} else {
+			openQty = p.DayNetQty()
+		}
+		break
+	}
+
+	if req.Transactiontype == "" {
+		// Angel One identifies the side being converted: BUY for a long position, SELL for a short one.
+		req.Transactiontype = validation.TransactionTypeBuy
+		if openQty < 0 {
+			req.Transactiontype = validation.TransactionTypeSell
+		}
+	}
+	if err := s.validator.ConvertPositionQuantity(req, openQty); err != nil {
+		log.Printf("Broker Service: ConvertPosition rejected: %v", err)
+		return nil, err
+	}
+	if req.Tradingsymbol == "" {
+		req.Tradingsymbol = position.TradingSymbol
+	}
+	return s.angelClient.ConvertPosition(req, position)
+}
+
+func (s *BrokerServer) GetRMSLimits(ctx context.Context, req *pb.GetRMSLimitsRequest) (*pb.GetRMSLimitsResponse, error) {
+	log.Printf("Broker Service: GetRMSLimits called with AngelOneJWT: %.10s...", req.AngelOneJwt)
+	if req.AngelOneJwt == "" {
+		return &pb.GetRMSLimitsResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	return s.angelClient.GetRMSLimits(req)
+}
+
+func (s *BrokerServer) CalculateMargin(ctx context.Context, req *pb.CalculateMarginRequest) (*pb.CalculateMarginResponse, error) {
+	log.Printf("Broker Service: CalculateMargin called for %d legs", len(req.Legs))
+	if req.AngelOneJwt == "" {
+		return &pb.CalculateMarginResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	for i, leg := range req.Legs {
+		if err := s.resolveSymbol("margin request", fmt.Sprintf("legs[%d].", i), leg.Exchange, &leg.Tradingsymbol, &leg.Symboltoken); err != nil {
+			log.Printf("Broker Service: CalculateMargin rejected: %v", err)
+			return nil, err
+		}
+	}
+	if err := s.validator.CalculateMargin(req); err != nil {
+		log.Printf("Broker Service: CalculateMargin rejected: %v", err)
+		return nil, err
+	}
+	return s.angelClient.CalculateMargin(ctx, req)
+}
+
+func (s *BrokerServer) GetCandleData(ctx context.Context, req *pb.GetCandleDataRequest) (*pb.GetCandleDataResponse, error) {
+	log.Printf("Broker Service: GetCandleData called for %s:%s %s from %s to %s", req.Exchange, req.Symboltoken, req.Interval, req.Fromdate, req.Todate)
+	if req.AngelOneJwt == "" {
+		return &pb.GetCandleDataResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if err := s.validator.CandleData(req); err != nil {
+		log.Printf("Broker Service: GetCandleData rejected: %v", err)
+		return nil, err
+	}
+	if s.candleCache == nil {
+		return s.angelClient.GetCandleData(ctx, req)
+	}
+	return s.cachedCandleData(ctx, req)
+}
+
 func (s *BrokerServer) GetLTP(ctx context.Context, req *pb.GetLTPRequest) (*pb.GetLTPResponse, error) {
 	log.Printf("Broker Service: GetLTP called for %d exchange groups", len(req.ExchangeTokens))
 	if req.AngelOneJwt == "" {
@@ -119,6 +373,9 @@ func (s *BrokerServer) GetLTP(ctx context.Context, req *pb.GetLTPRequest) (*pb.G
 	if len(req.ExchangeTokens) == 0 {
 		return &pb.GetLTPResponse{Status: false, Message: "No exchange tokens provided for LTP"}, nil
 	}
+	if err := s.resolveExchangeTokens("LTP request", req.ExchangeTokens); err != nil {
+		return nil, err
+	}
 	return s.angelClient.GetLTP(req)
 }
 
@@ -130,5 +387,16 @@ func (s *BrokerServer) GetFullQuote(ctx context.Context, req *pb.GetFullQuoteReq
 	if len(req.ExchangeTokens) == 0 {
 		return &pb.GetFullQuoteResponse{Status: false, Message: "No exchange tokens provided for Full Quote"}, nil
 	}
+	if err := s.resolveExchangeTokens("quote request", req.ExchangeTokens); err != nil {
+		return nil, err
+	}
 	return s.angelClient.GetFullQuote(req)
 }
+
+func (s *BrokerServer) GenerateTokens(ctx context.Context, req *pb.GenerateTokensRequest) (*pb.GenerateTokensResponse, error) {
+	log.Printf("Broker Service: GenerateTokens called with AngelOneJWT: %.10s...", req.AngelOneJwt)
+	if req.RefreshToken == "" {
+		return &pb.GenerateTokensResponse{Status: false, Message: "Missing refresh token"}, nil
+	}
+	return s.angelClient.GenerateTokens(req)
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+	"github.com/Sagar-v4/Angel-Two/services/broker/candlecache"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// cachedCandleData serves a validated candle request from the cache, fetching
+// only the ranges that have not been fetched before.
+func (s *BrokerServer) cachedCandleData(ctx context.Context, req *pb.GetCandleDataRequest) (*pb.GetCandleDataResponse, error) {
+	from, _ := angelone.ParseCandleDate(req.Fromdate) // Already validated
+	to, _ := angelone.ParseCandleDate(req.Todate)
+	key := candlecache.SeriesKey{Exchange: req.Exchange, Symboltoken: req.Symboltoken, Interval: req.Interval}
+
+	missing, err := s.candleCache.Missing(key, from, to)
+	if err != nil {
+		log.Printf("Broker Service: Candle cache unavailable, fetching from Angel One: %v", err)
+		return s.angelClient.GetCandleData(ctx, req)
+	}
+
+	chunks := 0
+	for _, gap := range missing {
+		chunks += angelone.CandleChunks(req.Interval, gap.From, gap.To)
+	}
+	if chunks > angelone.MaxCandleChunks {
+		// Many small gaps; one pass over the whole range takes fewer calls.
+		log.Printf("Broker Service: %d missing candle ranges need %d calls, fetching the whole range instead", len(missing), chunks)
+		missing = []candlecache.Range{{From: from, To: to}}
+	}
+
+	for _, gap := range missing {
+		gapReq := proto.Clone(req).(*pb.GetCandleDataRequest)
+		gapReq.Fromdate = angelone.FormatCandleDate(gap.From)
+		gapReq.Todate = angelone.FormatCandleDate(gap.To)
+
+		resp, err := s.angelClient.GetCandleData(ctx, gapReq)
+		if err != nil || !resp.Status {
+			return resp, err
+		}
+		if err := s.candleCache.Put(key, resp.Data, gap, time.Now()); err != nil {
+			log.Printf("Broker Service: Failed to cache candles, fetching from Angel One: %v", err)
+			return s.angelClient.GetCandleData(ctx, req)
+		}
+	}
+	if len(missing) > 0 {
+		log.Printf("Broker Service: Fetched %d missing candle ranges for %s:%s %s", len(missing), req.Exchange, req.Symboltoken, req.Interval)
+	}
+
+	candles, err := s.candleCache.Candles(key, from, to)
+	if err != nil {
+		log.Printf("Broker Service: Failed to read cached candles, fetching from Angel One: %v", err)
+		return s.angelClient.GetCandleData(ctx, req)
+	}
+	return &pb.GetCandleDataResponse{Status: true, Message: "SUCCESS", Data: candles}, nil
+}
+
+func (s *BrokerServer) GetCandleCacheStats(ctx context.Context, req *pb.GetCandleCacheStatsRequest) (*pb.GetCandleCacheStatsResponse, error) {
+	if s.candleCache == nil {
+		return &pb.GetCandleCacheStatsResponse{Enabled: false}, nil
+	}
+	stats, err := s.candleCache.Stats()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return stats, nil
+}
+
+func (s *BrokerServer) PurgeCandleCache(ctx context.Context, req *pb.PurgeCandleCacheRequest) (*pb.PurgeCandleCacheResponse, error) {
+	log.Printf("Broker Service: PurgeCandleCache called for %s:%s", req.Exchange, req.Symboltoken)
+	if s.candleCache == nil {
+		return nil, status.Error(codes.FailedPrecondition, "candle cache is disabled")
+	}
+	if req.Exchange == "" || req.Symboltoken == "" {
+		return nil, status.Error(codes.InvalidArgument, "exchange and symboltoken are required")
+	}
+	series, candles, err := s.candleCache.Purge(req.Exchange, req.Symboltoken)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.PurgeCandleCacheResponse{SeriesRemoved: series, CandlesRemoved: candles}, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// clientCodeTTL is used when the JWT carries no expiry; Angel One's are
+	// valid for a trading day.
+	clientCodeTTL = 24 * time.Hour
+	// maxClientCodes bounds the cache; expired entries are dropped first.
+	maxClientCodes = 1024
+)
+
+// clientCodeCache remembers the client code behind each Angel One JWT, so
+// repeated calls on one session (tick stream restarts, rule, alert and
+// bracket RPCs) cost a single profile lookup.
+type clientCodeCache struct {
+	mu    sync.Mutex
+	byJWT map[string]clientCodeEntry
+}
+
+type clientCodeEntry struct {
+	clientCode string
+	expires    time.Time
+}
+
+func newClientCodeCache() *clientCodeCache {
+	return &clientCodeCache{byJWT: make(map[string]clientCodeEntry)}
+}
+
+func (c *clientCodeCache) get(jwt string, now time.Time) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.byJWT[jwt]
+	if !ok || now.After(entry.expires) {
+		return "", false
+	}
+	return entry.clientCode, true
+}
+
+func (c *clientCodeCache) put(jwt, clientCode string, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.byJWT) >= maxClientCodes {
+		for key, entry := range c.byJWT {
+			if now.After(entry.expires) {
+				delete(c.byJWT, key)
+			}
+		}
+	}
+	if len(c.byJWT) >= maxClientCodes {
+		for key := range c.byJWT { // Still full: drop an arbitrary entry
+			delete(c.byJWT, key)
+			break
+		}
+	}
+	c.byJWT[jwt] = clientCodeEntry{clientCode: clientCode, expires: jwtExpiry(jwt, now)}
+}
+
+// forget drops the JWT, e.g. once Angel One rejected it.
+func (c *clientCodeCache) forget(jwt string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.byJWT, jwt)
+}
+
+// jwtExpiry reads the exp claim of an Angel One JWT, so a cached client code
+// is never used with a JWT past its expiry. The signature is not checked;
+// Angel One does that on every call made with the JWT.
+func jwtExpiry(token string, now time.Time) time.Time {
+	fallback := now.Add(clientCodeTTL)
+	var claims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil || claims.ExpiresAt == nil {
+		return fallback
+	}
+	if exp := claims.ExpiresAt.Time; exp.Before(fallback) {
+		return exp
+	}
+	return fallback
+}
+
+// clientCode looks up the Angel One client code the JWT belongs to, from the
+// cache when the JWT was seen before. It returns the profile response when
+// Angel One rejected the JWT.
+func (s *BrokerServer) clientCode(jwt, clientLocalIP, clientPublicIP, macAddress string) (string, *pb.GetProfileResponse, error) {
+	now := time.Now()
+	if clientCode, ok := s.clientCodes.get(jwt, now); ok {
+		return clientCode, nil, nil
+	}
+
+	profile, err := s.angelClient.GetUserProfile(jwt, clientLocalIP, clientPublicIP, macAddress)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Unavailable, "Error fetching profile: %v", err)
+	}
+	if !profile.Status {
+		s.clientCodes.forget(jwt)
+		return "", profile, nil
+	}
+	if profile.Data == nil || profile.Data.Clientcode == "" {
+		return "", nil, status.Error(codes.Unauthenticated, "could not look up the client code")
+	}
+	s.clientCodes.put(jwt, profile.Data.Clientcode, now)
+	return profile.Data.Clientcode, nil, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+	"github.com/Sagar-v4/Angel-Two/services/broker/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultGTTPage  = 1
+	defaultGTTCount = 10
+)
+
+// allGTTStatuses is sent when ListGTT has no status filter, as in Angel One's own example.
+var allGTTStatuses = []string{
+	validation.GTTStatusNew,
+	validation.GTTStatusCancelled,
+	validation.GTTStatusActive,
+	validation.GTTStatusSentToExchange,
+	validation.GTTStatusForAll,
+}
+
+func (s *BrokerServer) CreateGTT(ctx context.Context, req *pb.CreateGTTRequest) (*pb.CreateGTTResponse, error) {
+	log.Printf("Broker Service: CreateGTT called for %s %s on %s", req.Transactiontype, req.Tradingsymbol, req.Exchange)
+	if req.AngelOneJwt == "" {
+		return &pb.CreateGTTResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if err := s.resolveSymbol("GTT rule", "", req.Exchange, &req.Tradingsymbol, &req.Symboltoken); err != nil {
+		log.Printf("Broker Service: CreateGTT rejected: %v", err)
+		return nil, err
+	}
+	if err := s.validator.CreateGTT(req); err != nil {
+		log.Printf("Broker Service: CreateGTT rejected: %v", err)
+		return nil, err
+	}
+	return s.angelClient.CreateGTT(req)
+}
+
+func (s *BrokerServer) ModifyGTT(ctx context.Context, req *pb.ModifyGTTRequest) (*pb.ModifyGTTResponse, error) {
+	log.Printf("Broker Service: ModifyGTT called for rule ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.ModifyGTTResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if req.Id != "" && (req.Exchange == "" || req.Symboltoken == "") {
+		details, err := s.gttDetails(&pb.GetGTTDetailsRequest{
+			AngelOneJwt:    req.AngelOneJwt,
+			Id:             req.Id,
+			ClientLocalIp:  req.ClientLocalIp,
+			ClientPublicIp: req.ClientPublicIp,
+			MacAddress:     req.MacAddress,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !details.Status {
+			return &pb.ModifyGTTResponse{Status: false, Message: details.Message, Errorcode: details.Errorcode}, nil
+		}
+		req.Exchange = details.Data.Exchange
+		req.Symboltoken = details.Data.Symboltoken
+	}
+	if err := s.validator.ModifyGTT(req); err != nil {
+		log.Printf("Broker Service: ModifyGTT rejected: %v", err)
+		return nil, err
+	}
+	return s.angelClient.ModifyGTT(req)
+}
+
+func (s *BrokerServer) CancelGTT(ctx context.Context, req *pb.CancelGTTRequest) (*pb.CancelGTTResponse, error) {
+	log.Printf("Broker Service: CancelGTT called for rule ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.CancelGTTResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if req.Id == "" {
+		return nil, validation.FieldError("GTT cancellation", "id", "id is required")
+	}
+	if req.Exchange == "" || req.Symboltoken == "" {
+		details, err := s.gttDetails(&pb.GetGTTDetailsRequest{
+			AngelOneJwt:    req.AngelOneJwt,
+			Id:             req.Id,
+			ClientLocalIp:  req.ClientLocalIp,
+			ClientPublicIp: req.ClientPublicIp,
+			MacAddress:     req.MacAddress,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !details.Status {
+			return &pb.CancelGTTResponse{Status: false, Message: details.Message, Errorcode: details.Errorcode}, nil
+		}
+		req.Exchange = details.Data.Exchange
+		req.Symboltoken = details.Data.Symboltoken
+	}
+	return s.angelClient.CancelGTT(req)
+}
+
+func (s *BrokerServer) GetGTTDetails(ctx context.Context, req *pb.GetGTTDetailsRequest) (*pb.GetGTTDetailsResponse, error) {
+	log.Printf("Broker Service: GetGTTDetails called for rule ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.GetGTTDetailsResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if req.Id == "" {
+		return nil, validation.FieldError("GTT details request", "id", "id is required")
+	}
+	return s.gttDetails(req)
+}
+
+// gttDetails fetches one rule, mapping an unknown id to NOT_FOUND.
+func (s *BrokerServer) gttDetails(req *pb.GetGTTDetailsRequest) (*pb.GetGTTDetailsResponse, error) {
+	resp, err := s.angelClient.GetGTTDetails(req)
+	if errors.Is(err, angelone.ErrGTTRuleNotFound) {
+		return nil, status.Errorf(codes.NotFound, "GTT rule %s not found", req.Id)
+	}
+	return resp, err
+}
+
+func (s *BrokerServer) ListGTT(ctx context.Context, req *pb.ListGTTRequest) (*pb.ListGTTResponse, error) {
+	log.Printf("Broker Service: ListGTT called for statuses %v (page %d, count %d)", req.Status, req.Page, req.Count)
+	if req.AngelOneJwt == "" {
+		return &pb.ListGTTResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if err := s.validator.ListGTT(req); err != nil {
+		log.Printf("Broker Service: ListGTT rejected: %v", err)
+		return nil, err
+	}
+	if len(req.Status) == 0 {
+		req.Status = allGTTStatuses
+	}
+	if req.Page == 0 {
+		req.Page = defaultGTTPage
+	}
+	if req.Count == 0 {
+		req.Count = defaultGTTCount
+	}
+	return s.angelClient.ListGTT(req)
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/instruments"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// instrumentError maps instrument master lookup failures to gRPC statuses.
+func instrumentError(err error) error {
+	if errors.Is(err, instruments.ErrNotLoaded) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (s *BrokerServer) SearchInstruments(ctx context.Context, req *pb.SearchInstrumentsRequest) (*pb.SearchInstrumentsResponse, error) {
+	log.Printf("Broker Service: SearchInstruments called with query %q (exchange %q, type %q)", req.Query, req.Exchange, req.Instrumenttype)
+	if s.instruments == nil {
+		return nil, status.Error(codes.Unavailable, "instrument master is disabled")
+	}
+	if req.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	} else if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	results, err := s.instruments.Search(req.Query, req.Exchange, req.Instrumenttype, limit)
+	if err != nil {
+		return nil, instrumentError(err)
+	}
+	return &pb.SearchInstrumentsResponse{Status: true, Message: "SUCCESS", Data: results}, nil
+}
+
+func (s *BrokerServer) GetInstrument(ctx context.Context, req *pb.GetInstrumentRequest) (*pb.GetInstrumentResponse, error) {
+	log.Printf("Broker Service: GetInstrument called for %s:%s", req.Exchange, req.Symboltoken)
+	if s.instruments == nil {
+		return nil, status.Error(codes.Unavailable, "instrument master is disabled")
+	}
+	if req.Symboltoken == "" {
+		return nil, status.Error(codes.InvalidArgument, "symboltoken is required")
+	}
+
+	matches, err := s.instruments.ByToken(req.Exchange, req.Symboltoken)
+	if err != nil {
+		return nil, instrumentError(err)
+	}
+	switch len(matches) {
+	case 0:
+		return nil, status.Errorf(codes.NotFound, "instrument %s not found", req.Symboltoken)
+	case 1:
+		return &pb.GetInstrumentResponse{Status: true, Message: "SUCCESS", Data: matches[0]}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "symboltoken %s exists on %d exchanges, set exchange", req.Symboltoken, len(matches))
+	}
+}
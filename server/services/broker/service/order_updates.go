@@ -0,0 +1,43 @@
+package service
+
+import (
+	"log"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamOrderUpdates relays Angel One's order-status WebSocket as typed events
+// until the caller cancels or the upstream connection fails. Callers with the
+// same JWT share one upstream connection, with the bracket manager too.
+func (s *BrokerServer) StreamOrderUpdates(req *pb.StreamOrderUpdatesRequest, srv grpc.ServerStreamingServer[pb.OrderUpdate]) error {
+	log.Printf("Broker Service: StreamOrderUpdates called with AngelOneJWT: %.10s...", req.AngelOneJwt)
+	if req.AngelOneJwt == "" {
+		return status.Error(codes.Unauthenticated, "Missing Angel One JWT")
+	}
+
+	feed, err := s.orderHub.Subscribe(srv.Context(), req.AngelOneJwt)
+	if err != nil {
+		log.Printf("Broker Service: StreamOrderUpdates could not connect: %v", err)
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer feed.Close()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case update, ok := <-feed.Updates():
+			if !ok {
+				log.Printf("Broker Service: StreamOrderUpdates upstream ended: %v", feed.Err())
+				return status.Errorf(codes.Unavailable, "order update feed closed: %v", feed.Err())
+			}
+			if err := srv.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/alerts"
+	"github.com/Sagar-v4/Angel-Two/services/broker/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 500
+)
+
+func (s *BrokerServer) CreatePriceAlert(ctx context.Context, req *pb.CreatePriceAlertRequest) (*pb.PriceAlertResponse, error) {
+	log.Printf("Broker Service: CreatePriceAlert called for %s", req.GetAlert().GetTradingsymbol())
+	if req.AngelOneJwt == "" {
+		return &pb.PriceAlertResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.priceAlerts == nil {
+		return nil, status.Error(codes.Unavailable, "price alerts are disabled")
+	}
+
+	alert := req.Alert
+	if alert != nil {
+		if err := s.resolveSymbol("price alert", "alert.", alert.Exchange, &alert.Tradingsymbol, &alert.Symboltoken); err != nil {
+			log.Printf("Broker Service: CreatePriceAlert rejected: %v", err)
+			return nil, err
+		}
+	}
+	if err := s.validator.CreatePriceAlert(req); err != nil {
+		log.Printf("Broker Service: CreatePriceAlert rejected: %v", err)
+		return nil, err
+	}
+
+	sess, profile, err := s.session(req.AngelOneJwt, "", req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.PriceAlertResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	stored := &pb.PriceAlert{
+		ClientCode:    sess.ClientCode,
+		Exchange:      alert.Exchange,
+		Tradingsymbol: alert.Tradingsymbol,
+		Symboltoken:   alert.Symboltoken,
+		Condition:     alert.Condition,
+		Note:          alert.Note,
+		Repeat:        alert.Repeat,
+	}
+	if err := s.priceAlerts.Create(stored); err != nil {
+		log.Printf("Broker Service: CreatePriceAlert failed to store the alert: %v", err)
+		return nil, alertError(err)
+	}
+	return &pb.PriceAlertResponse{Status: true, Message: "Alert created", Data: stored}, nil
+}
+
+func (s *BrokerServer) ListPriceAlerts(ctx context.Context, req *pb.ListPriceAlertsRequest) (*pb.ListPriceAlertsResponse, error) {
+	log.Printf("Broker Service: ListPriceAlerts called")
+	if req.AngelOneJwt == "" {
+		return &pb.ListPriceAlertsResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.priceAlerts == nil {
+		return nil, status.Error(codes.Unavailable, "price alerts are disabled")
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.ListPriceAlertsResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	list, err := s.priceAlerts.List(clientCode)
+	if err != nil {
+		return nil, alertError(err)
+	}
+	return &pb.ListPriceAlertsResponse{Status: true, Message: "SUCCESS", Data: list}, nil
+}
+
+func (s *BrokerServer) GetPriceAlert(ctx context.Context, req *pb.AlertIDRequest) (*pb.PriceAlertResponse, error) {
+	log.Printf("Broker Service: GetPriceAlert called for alert ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.PriceAlertResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.priceAlerts == nil {
+		return nil, status.Error(codes.Unavailable, "price alerts are disabled")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.PriceAlertResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	alert, err := s.priceAlerts.Get(clientCode, req.Id)
+	if err != nil {
+		return nil, alertError(err)
+	}
+	return &pb.PriceAlertResponse{Status: true, Message: "SUCCESS", Data: alert}, nil
+}
+
+func (s *BrokerServer) UpdatePriceAlert(ctx context.Context, req *pb.UpdatePriceAlertRequest) (*pb.PriceAlertResponse, error) {
+	log.Printf("Broker Service: UpdatePriceAlert called for alert ID: %s (paused: %t)", req.Id, req.Paused)
+	if req.AngelOneJwt == "" {
+		return &pb.PriceAlertResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.priceAlerts == nil {
+		return nil, status.Error(codes.Unavailable, "price alerts are disabled")
+	}
+	if err := s.validator.UpdatePriceAlert(req); err != nil {
+		log.Printf("Broker Service: UpdatePriceAlert rejected: %v", err)
+		return nil, err
+	}
+
+	sess, profile, err := s.session(req.AngelOneJwt, "", req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.PriceAlertResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	alert, err := s.priceAlerts.Update(sess.ClientCode, req.Id, func(alert *pb.PriceAlert) {
+		if req.Condition != nil {
+			alert.Condition = req.Condition
+		}
+		alert.Note = req.Note
+		alert.Repeat = req.Repeat
+		if req.Paused {
+			alert.State = pb.PriceAlertState_PRICE_ALERT_PAUSED
+		} else {
+			alert.State = pb.PriceAlertState_PRICE_ALERT_ACTIVE
+		}
+	})
+	if err != nil {
+		return nil, alertError(err)
+	}
+	return &pb.PriceAlertResponse{Status: true, Message: "Alert updated", Data: alert}, nil
+}
+
+func (s *BrokerServer) DeletePriceAlert(ctx context.Context, req *pb.AlertIDRequest) (*pb.DeleteAlertResponse, error) {
+	log.Printf("Broker Service: DeletePriceAlert called for alert ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.DeleteAlertResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.priceAlerts == nil {
+		return nil, status.Error(codes.Unavailable, "price alerts are disabled")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	sess, profile, err := s.session(req.AngelOneJwt, "", req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.DeleteAlertResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	if err := s.priceAlerts.Delete(sess.ClientCode, req.Id); err != nil {
+		return nil, alertError(err)
+	}
+	return &pb.DeleteAlertResponse{Status: true, Message: "Alert deleted"}, nil
+}
+
+func (s *BrokerServer) CreateAlertWebhook(ctx context.Context, req *pb.CreateAlertWebhookRequest) (*pb.AlertWebhookResponse, error) {
+	log.Printf("Broker Service: CreateAlertWebhook called")
+	if req.AngelOneJwt == "" {
+		return &pb.AlertWebhookResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.priceAlerts == nil {
+		return nil, status.Error(codes.Unavailable, "price alerts are disabled")
+	}
+	if err := s.validator.CreateAlertWebhook(req); err != nil {
+		log.Printf("Broker Service: CreateAlertWebhook rejected: %v", err)
+		return nil, err
+	}
+
+	sess, profile, err := s.session(req.AngelOneJwt, "", req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.AlertWebhookResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	webhook, err := s.priceAlerts.CreateWebhook(ctx, sess.ClientCode, req.Url)
+	if errors.Is(err, alerts.ErrNonPublicHost) {
+		log.Printf("Broker Service: CreateAlertWebhook rejected: %v", err)
+		return nil, validation.FieldError("webhook", "url", "url must point to a public address: %v", err)
+	}
+	if err != nil {
+		log.Printf("Broker Service: CreateAlertWebhook failed to store the webhook: %v", err)
+		return nil, alertError(err)
+	}
+	return &pb.AlertWebhookResponse{Status: true, Message: "Webhook created; keep the secret, it is not shown again", Data: webhook}, nil
+}
+
+func (s *BrokerServer) ListAlertWebhooks(ctx context.Context, req *pb.ListAlertWebhooksRequest) (*pb.ListAlertWebhooksResponse, error) {
+	log.Printf("Broker Service: ListAlertWebhooks called")
+	if req.AngelOneJwt == "" {
+		return &pb.ListAlertWebhooksResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.priceAlerts == nil {
+		return nil, status.Error(codes.Unavailable, "price alerts are disabled")
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.ListAlertWebhooksResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	webhooks, err := s.priceAlerts.ListWebhooks(clientCode)
+	if err != nil {
+		return nil, alertError(err)
+	}
+	return &pb.ListAlertWebhooksResponse{Status: true, Message: "SUCCESS", Data: webhooks}, nil
+}
+
+func (s *BrokerServer) DeleteAlertWebhook(ctx context.Context, req *pb.AlertIDRequest) (*pb.DeleteAlertResponse, error) {
+	log.Printf("Broker Service: DeleteAlertWebhook called for webhook ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.DeleteAlertResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.priceAlerts == nil {
+		return nil, status.Error(codes.Unavailable, "price alerts are disabled")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	sess, profile, err := s.session(req.AngelOneJwt, "", req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.DeleteAlertResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	if err := s.priceAlerts.DeleteWebhook(sess.ClientCode, req.Id); err != nil {
+		return nil, alertError(err)
+	}
+	return &pb.DeleteAlertResponse{Status: true, Message: "Webhook deleted"}, nil
+}
+
+func (s *BrokerServer) ListAlertDeliveries(ctx context.Context, req *pb.ListAlertDeliveriesRequest) (*pb.ListAlertDeliveriesResponse, error) {
+	log.Printf("Broker Service: ListAlertDeliveries called for alert ID: %q (limit %d)", req.AlertId, req.Limit)
+	if req.AngelOneJwt == "" {
+		return &pb.ListAlertDeliveriesResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.priceAlerts == nil {
+		return nil, status.Error(codes.Unavailable, "price alerts are disabled")
+	}
+	limit := int(req.Limit)
+	if limit < 0 || limit > maxDeliveryLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxDeliveryLimit)
+	}
+	if limit == 0 {
+		limit = defaultDeliveryLimit
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.ListAlertDeliveriesResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	deliveries, err := s.priceAlerts.Deliveries(clientCode, req.AlertId, limit)
+	if err != nil {
+		return nil, alertError(err)
+	}
+	return &pb.ListAlertDeliveriesResponse{Status: true, Message: "SUCCESS", Data: deliveries}, nil
+}
+
+func alertError(err error) error {
+	switch {
+	case errors.Is(err, alerts.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, alerts.ErrLimitReached):
+		return status.Errorf(codes.FailedPrecondition, "at most %d alerts and %d webhooks are allowed per client", alerts.MaxAlertsPerClient, alerts.MaxWebhooksPerClient)
+	}
+	return status.Errorf(codes.Internal, "reading price alerts: %v", err)
+}
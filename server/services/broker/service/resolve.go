@@ -0,0 +1,87 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/instruments"
+	"github.com/Sagar-v4/Angel-Two/services/broker/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resolveSymbol fills in whichever of tradingsymbol and symboltoken is missing
+// from the instrument master and rejects pairs that belong to different
+// instruments. fieldPrefix qualifies field names in errors (e.g. "legs[0].").
+// Without an instrument master the request is left to the validator.
+func (s *BrokerServer) resolveSymbol(what, fieldPrefix, exchange string, tradingsymbol, symboltoken *string) error {
+	if s.instruments == nil || exchange == "" || (*tradingsymbol == "" && *symboltoken == "") {
+		return nil
+	}
+
+	if *symboltoken != "" {
+		matches, err := s.instruments.ByToken(exchange, *symboltoken)
+		if err != nil {
+			return s.resolveError(err, *tradingsymbol != "")
+		}
+		if len(matches) == 0 {
+			return validation.FieldError(what, fieldPrefix+"symboltoken", "unknown symboltoken %s on %s", *symboltoken, exchange)
+		}
+		inst := matches[0]
+		if *tradingsymbol != "" && !strings.EqualFold(*tradingsymbol, inst.Tradingsymbol) {
+			return validation.FieldError(what, fieldPrefix+"tradingsymbol", "symboltoken %s on %s is %s, not %s", *symboltoken, exchange, inst.Tradingsymbol, *tradingsymbol)
+		}
+		*tradingsymbol = inst.Tradingsymbol
+		return nil
+	}
+
+	inst, ok, err := s.instruments.BySymbol(exchange, *tradingsymbol)
+	if err != nil {
+		return s.resolveError(err, false)
+	}
+	if !ok {
+		return validation.FieldError(what, fieldPrefix+"tradingsymbol", "unknown tradingsymbol %s on %s", *tradingsymbol, exchange)
+	}
+	*tradingsymbol = inst.Tradingsymbol
+	*symboltoken = inst.Symboltoken
+	return nil
+}
+
+// resolveError handles a lookup failure. While the master is still loading,
+// requests that already carry both fields go through unchecked.
+func (s *BrokerServer) resolveError(err error, haveBoth bool) error {
+	if errors.Is(err, instruments.ErrNotLoaded) {
+		if haveBoth {
+			return nil
+		}
+		return status.Error(codes.Unavailable, "instrument master is not loaded yet; send both tradingsymbol and symboltoken")
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// resolveExchangeTokens converts the tradingsymbols of each pair into tokens.
+func (s *BrokerServer) resolveExchangeTokens(what string, pairs []*pb.ExchangeTokenPair) error {
+	for i, pair := range pairs {
+		if len(pair.Tradingsymbols) == 0 {
+			continue
+		}
+		if s.instruments == nil {
+			return status.Error(codes.Unavailable, "instrument master is disabled; send tokens instead of tradingsymbols")
+		}
+		for _, symbol := range pair.Tradingsymbols {
+			inst, ok, err := s.instruments.BySymbol(pair.Exchange, symbol)
+			if err != nil {
+				return s.resolveError(err, false)
+			}
+			if !ok {
+				return validation.FieldError(what, fmt.Sprintf("exchange_tokens[%d].tradingsymbols", i), "unknown tradingsymbol %s on %s", symbol, pair.Exchange)
+			}
+			pair.Tokens = append(pair.Tokens, inst.Symboltoken)
+		}
+		pair.Tradingsymbols = nil
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/rules"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *BrokerServer) CreateAlertRule(ctx context.Context, req *pb.CreateAlertRuleRequest) (*pb.CreateAlertRuleResponse, error) {
+	log.Printf("Broker Service: CreateAlertRule called with %d conditions", len(req.GetRule().GetConditions()))
+	if req.AngelOneJwt == "" {
+		return &pb.CreateAlertRuleResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.rules == nil {
+		return nil, status.Error(codes.Unavailable, "conditional order rules are disabled")
+	}
+
+	rule := req.Rule
+	if rule != nil && rule.Order != nil {
+		order := rule.Order
+		if err := s.resolveSymbol("rule", "rule.order.", order.Exchange, &order.Tradingsymbol, &order.Symboltoken); err != nil {
+			log.Printf("Broker Service: CreateAlertRule rejected: %v", err)
+			return nil, err
+		}
+		if rule.Exchange == "" && rule.Tradingsymbol == "" && rule.Symboltoken == "" {
+			rule.Exchange, rule.Tradingsymbol, rule.Symboltoken = order.Exchange, order.Tradingsymbol, order.Symboltoken
+		}
+	}
+	if rule != nil {
+		if err := s.resolveSymbol("rule", "rule.", rule.Exchange, &rule.Tradingsymbol, &rule.Symboltoken); err != nil {
+			log.Printf("Broker Service: CreateAlertRule rejected: %v", err)
+			return nil, err
+		}
+		if rule.ReferencePrice == 0 && rule.Symboltoken != "" && hasPercentCondition(rule) {
+			ltp, err := s.angelClient.GetLTP(&pb.GetLTPRequest{
+				AngelOneJwt:    req.AngelOneJwt,
+				ExchangeTokens: []*pb.ExchangeTokenPair{{Exchange: rule.Exchange, Tokens: []string{rule.Symboltoken}}},
+				ClientLocalIp:  req.ClientLocalIp,
+				ClientPublicIp: req.ClientPublicIp,
+				MacAddress:     req.MacAddress,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "Error fetching the reference price: %v", err)
+			}
+			if !ltp.Status || ltp.Data == nil || len(ltp.Data.Fetched) == 0 {
+				return &pb.CreateAlertRuleResponse{Status: false, Message: "Could not look up the reference price: " + ltp.Message, Errorcode: ltp.Errorcode}, nil
+			}
+			rule.ReferencePrice = ltp.Data.Fetched[0].Ltp
+		}
+	}
+	if err := s.validator.CreateAlertRule(req); err != nil {
+		log.Printf("Broker Service: CreateAlertRule rejected: %v", err)
+		return nil, err
+	}
+
+	sess, profile, err := s.session(req.AngelOneJwt, req.FeedToken, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.CreateAlertRuleResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	// Only the parts of the request that define the rule are kept; the session
+	// used to fire it is whichever is current at the time.
+	order := rule.Order
+	order.AngelOneJwt, order.ClientLocalIp, order.ClientPublicIp, order.MacAddress = "", "", "", ""
+	order.CheckMargin = false
+	order.Ordertag = ""
+	stored := &pb.AlertRule{
+		ClientCode:     sess.ClientCode,
+		Name:           rule.Name,
+		Exchange:       rule.Exchange,
+		Tradingsymbol:  rule.Tradingsymbol,
+		Symboltoken:    rule.Symboltoken,
+		Conditions:     rule.Conditions,
+		Order:          order,
+		ReferencePrice: rule.ReferencePrice,
+	}
+	if err := s.rules.Create(stored); err != nil {
+		log.Printf("Broker Service: CreateAlertRule failed to store the rule: %v", err)
+		return nil, status.Errorf(codes.Internal, "storing rule: %v", err)
+	}
+	return &pb.CreateAlertRuleResponse{Status: true, Message: "Rule created", Data: stored}, nil
+}
+
+func (s *BrokerServer) ListAlertRules(ctx context.Context, req *pb.ListAlertRulesRequest) (*pb.ListAlertRulesResponse, error) {
+	log.Printf("Broker Service: ListAlertRules called (active only: %t)", req.ActiveOnly)
+	if req.AngelOneJwt == "" {
+		return &pb.ListAlertRulesResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.rules == nil {
+		return nil, status.Error(codes.Unavailable, "conditional order rules are disabled")
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.ListAlertRulesResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	list, err := s.rules.List(clientCode, req.ActiveOnly)
+	if err != nil {
+		return nil, ruleError(err)
+	}
+	return &pb.ListAlertRulesResponse{Status: true, Message: "SUCCESS", Data: list}, nil
+}
+
+func (s *BrokerServer) GetAlertRule(ctx context.Context, req *pb.GetAlertRuleRequest) (*pb.GetAlertRuleResponse, error) {
+	log.Printf("Broker Service: GetAlertRule called for rule ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.GetAlertRuleResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.rules == nil {
+		return nil, status.Error(codes.Unavailable, "conditional order rules are disabled")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.GetAlertRuleResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	rule, err := s.rules.Get(clientCode, req.Id)
+	if err != nil {
+		return nil, ruleError(err)
+	}
+	return &pb.GetAlertRuleResponse{Status: true, Message: "SUCCESS", Data: rule}, nil
+}
+
+func (s *BrokerServer) CancelAlertRule(ctx context.Context, req *pb.CancelAlertRuleRequest) (*pb.CancelAlertRuleResponse, error) {
+	log.Printf("Broker Service: CancelAlertRule called for rule ID: %s", req.Id)
+	if req.AngelOneJwt == "" {
+		return &pb.CancelAlertRuleResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+	if s.rules == nil {
+		return nil, status.Error(codes.Unavailable, "conditional order rules are disabled")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	sess, profile, err := s.session(req.AngelOneJwt, req.FeedToken, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		return &pb.CancelAlertRuleResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+
+	rule, err := s.rules.Cancel(sess.ClientCode, req.Id)
+	if errors.Is(err, rules.ErrStateChanged) {
+		return nil, status.Errorf(codes.FailedPrecondition, "rule %s is %s and can no longer be cancelled", req.Id, rule.State)
+	} else if err != nil {
+		return nil, ruleError(err)
+	}
+	return &pb.CancelAlertRuleResponse{Status: true, Message: "Rule cancelled", Data: rule}, nil
+}
+
+func ruleError(err error) error {
+	if errors.Is(err, rules.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Errorf(codes.Internal, "reading rules: %v", err)
+}
+
+func hasPercentCondition(rule *pb.AlertRule) bool {
+	for _, cond := range rule.Conditions {
+		if cond.Type == pb.AlertConditionType_PERCENT_CHANGE_ABOVE || cond.Type == pb.AlertConditionType_PERCENT_CHANGE_BELOW {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/sessions"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// session looks up the caller's client code and records the session in the
+// registry, so the client's rules, price alerts and bracket orders keep
+// running in the background. A profile response is returned when Angel One
+// rejected the session, for the caller to pass on (the gateway refreshes
+// expired JWTs by its errorcode).
+func (s *BrokerServer) session(jwt, feedToken, clientLocalIP, clientPublicIP, macAddress string) (sessions.Session, *pb.GetProfileResponse, error) {
+	clientCode, profile, err := s.clientCode(jwt, clientLocalIP, clientPublicIP, macAddress)
+	if err != nil || profile != nil {
+		return sessions.Session{}, profile, err
+	}
+
+	sess := sessions.Session{
+		ClientCode:     clientCode,
+		JWT:            jwt,
+		FeedToken:      feedToken,
+		ClientLocalIP:  clientLocalIP,
+		ClientPublicIP: clientPublicIP,
+		MacAddress:     macAddress,
+	}
+	s.sessions.Set(sess)
+	return sess, nil, nil
+}
+
+// SetSession records the caller's session for background work. The gateway
+// calls it for authenticated requests, so a client's rules, price alerts and
+// bracket orders resume after a broker restart or a refreshed JWT without
+// waiting for one of their own RPCs. The session is only kept for clients
+// with background work; the response says whether it was.
+func (s *BrokerServer) SetSession(ctx context.Context, req *pb.SetSessionRequest) (*pb.SetSessionResponse, error) {
+	if req.AngelOneJwt == "" {
+		return &pb.SetSessionResponse{Status: false, Message: "Missing Angel One JWT"}, nil
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		log.Printf("Broker Service: SetSession rejected by Angel One: %s (%s)", profile.Message, profile.Errorcode)
+		return &pb.SetSessionResponse{Status: false, Message: profile.Message, Errorcode: profile.Errorcode}, nil
+	}
+	busy, err := s.backgroundWork(clientCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "looking up background work: %v", err)
+	}
+	if !busy {
+		return &pb.SetSessionResponse{Status: true, Message: "No background work; session not kept"}, nil
+	}
+
+	s.sessions.Set(sessions.Session{
+		ClientCode:     clientCode,
+		JWT:            req.AngelOneJwt,
+		FeedToken:      req.FeedToken,
+		ClientLocalIP:  req.ClientLocalIp,
+		ClientPublicIP: req.ClientPublicIp,
+		MacAddress:     req.MacAddress,
+	})
+	log.Printf("Broker Service: SetSession recorded the session of client %s", clientCode)
+	return &pb.SetSessionResponse{Status: true, Message: "SUCCESS", Kept: true}, nil
+}
+
+// backgroundWork reports whether the client has an active rule, a watched
+// price alert or an unfinished bracket order.
+func (s *BrokerServer) backgroundWork(clientCode string) (bool, error) {
+	if s.rules != nil {
+		rules, err := s.rules.List(clientCode, true)
+		if err != nil || len(rules) > 0 {
+			return len(rules) > 0, err
+		}
+	}
+	if s.priceAlerts != nil {
+		watching, err := s.priceAlerts.Watching(clientCode)
+		if err != nil || watching {
+			return watching, err
+		}
+	}
+	if s.brackets != nil {
+		brackets, err := s.brackets.List(clientCode, true)
+		if err != nil || len(brackets) > 0 {
+			return len(brackets) > 0, err
+		}
+	}
+	return false, nil
+}
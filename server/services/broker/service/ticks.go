@@ -0,0 +1,85 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/stream"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubscribeTicks streams live ticks from Angel One's SmartWebSocket V2 until
+// the caller cancels or the upstream connection fails. Callers on the same
+// Angel One session share one upstream connection through the tick hub.
+func (s *BrokerServer) SubscribeTicks(req *pb.SubscribeTicksRequest, srv grpc.ServerStreamingServer[pb.Tick]) error {
+	log.Printf("Broker Service: SubscribeTicks called for %d instruments in mode %s", len(req.Instruments), req.Mode)
+	if req.AngelOneJwt == "" || req.FeedToken == "" {
+		return status.Error(codes.Unauthenticated, "Missing Angel One JWT or feed token")
+	}
+	for i, inst := range req.Instruments {
+		if err := s.resolveSymbol("tick subscription", fmt.Sprintf("instruments[%d].", i), inst.Exchange, &inst.Tradingsymbol, &inst.Symboltoken); err != nil {
+			log.Printf("Broker Service: SubscribeTicks rejected: %v", err)
+			return err
+		}
+	}
+	if err := s.validator.TickSubscription(req); err != nil {
+		log.Printf("Broker Service: SubscribeTicks rejected: %v", err)
+		return err
+	}
+
+	creds, err := s.feedCredentials(req)
+	if err != nil {
+		return err
+	}
+	sub, err := s.tickHub.Subscribe(srv.Context(), creds, req.Mode, req.Instruments)
+	if errors.Is(err, stream.ErrTooManyTokens) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	} else if err != nil {
+		log.Printf("Broker Service: SubscribeTicks could not subscribe: %v", err)
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer func() {
+		sub.Close()
+		if dropped := sub.Dropped(); dropped > 0 {
+			log.Printf("Broker Service: SubscribeTicks client fell behind and missed %d ticks", dropped)
+		}
+	}()
+
+	for {
+		tick, err := sub.Next(srv.Context())
+		if err != nil {
+			if srv.Context().Err() != nil {
+				return nil
+			}
+			log.Printf("Broker Service: SubscribeTicks upstream ended: %v", err)
+			return status.Errorf(codes.Unavailable, "market data feed closed: %v", err)
+		}
+		if err := srv.Send(tick); err != nil {
+			return err
+		}
+	}
+}
+
+// feedCredentials fills in the client code when the caller did not send it.
+// It is cached per JWT, so restarting a stream does not look up the profile.
+func (s *BrokerServer) feedCredentials(req *pb.SubscribeTicksRequest) (stream.Credentials, error) {
+	creds := stream.Credentials{JWT: req.AngelOneJwt, FeedToken: req.FeedToken, ClientCode: req.ClientCode}
+	if creds.ClientCode != "" {
+		return creds, nil
+	}
+
+	clientCode, profile, err := s.clientCode(req.AngelOneJwt, req.ClientLocalIp, req.ClientPublicIp, req.MacAddress)
+	if err != nil {
+		return creds, err
+	}
+	if profile != nil {
+		return creds, status.Errorf(codes.Unauthenticated, "could not look up the client code: %s", profile.Message)
+	}
+	creds.ClientCode = clientCode
+	return creds, nil
+}
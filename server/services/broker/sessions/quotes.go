@@ -0,0 +1,84 @@
+package sessions
+
+import (
+	"errors"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	"github.com/Sagar-v4/Angel-Two/services/broker/stream"
+)
+
+// QuoteBatchSize is the most tokens Angel One's quote API accepts in one call.
+const QuoteBatchSize = 50
+
+// LTP fetches the last traded price of the instruments for the client,
+// QuoteBatchSize at a time. When a batch fails it returns the prices fetched
+// so far with the error, forgetting the session if Angel One rejected it.
+// Instruments Angel One did not quote are missing.
+func (r *Registry) LTP(sess Session, instruments []stream.Instrument) (map[stream.Instrument]float64, error) {
+	prices := make(map[stream.Instrument]float64, len(instruments))
+	for start := 0; start < len(instruments); start += QuoteBatchSize {
+		resp, err := r.angel.GetLTP(&pb.GetLTPRequest{
+			AngelOneJwt:    sess.JWT,
+			ExchangeTokens: exchangeTokens(instruments[start:min(start+QuoteBatchSize, len(instruments))]),
+			ClientLocalIp:  sess.ClientLocalIP,
+			ClientPublicIp: sess.ClientPublicIP,
+			MacAddress:     sess.MacAddress,
+		})
+		if err := r.quoted(sess, resp.GetStatus(), resp.GetMessage(), resp.GetErrorcode(), err); err != nil {
+			return prices, err
+		}
+		for _, ltp := range resp.GetData().GetFetched() {
+			prices[stream.Instrument{Exchange: ltp.Exchange, Symboltoken: ltp.SymbolToken}] = ltp.Ltp
+		}
+	}
+	return prices, nil
+}
+
+// FullQuotes is LTP for full quotes, which carry the percent change from the
+// previous close.
+func (r *Registry) FullQuotes(sess Session, instruments []stream.Instrument) (map[stream.Instrument]*pb.FullQuoteData, error) {
+	quotes := make(map[stream.Instrument]*pb.FullQuoteData, len(instruments))
+	for start := 0; start < len(instruments); start += QuoteBatchSize {
+		resp, err := r.angel.GetFullQuote(&pb.GetFullQuoteRequest{
+			AngelOneJwt:    sess.JWT,
+			ExchangeTokens: exchangeTokens(instruments[start:min(start+QuoteBatchSize, len(instruments))]),
+			ClientLocalIp:  sess.ClientLocalIP,
+			ClientPublicIp: sess.ClientPublicIP,
+			MacAddress:     sess.MacAddress,
+		})
+		if err := r.quoted(sess, resp.GetStatus(), resp.GetMessage(), resp.GetErrorcode(), err); err != nil {
+			return quotes, err
+		}
+		for _, fq := range resp.GetData().GetFetched() {
+			quotes[stream.Instrument{Exchange: fq.Exchange, Symboltoken: fq.SymbolToken}] = fq
+		}
+	}
+	return quotes, nil
+}
+
+// quoted turns a failed quote call into an error.
+func (r *Registry) quoted(sess Session, ok bool, message, errorcode string, err error) error {
+	if err != nil {
+		return err
+	}
+	if !ok {
+		r.Check(sess, errorcode)
+		return errors.New(message)
+	}
+	return nil
+}
+
+func exchangeTokens(instruments []stream.Instrument) []*pb.ExchangeTokenPair {
+	var pairs []*pb.ExchangeTokenPair
+	byExchange := make(map[string]*pb.ExchangeTokenPair)
+	for _, inst := range instruments {
+		pair, ok := byExchange[inst.Exchange]
+		if !ok {
+			pair = &pb.ExchangeTokenPair{Exchange: inst.Exchange}
+			byExchange[inst.Exchange] = pair
+			pairs = append(pairs, pair)
+		}
+		pair.Tokens = append(pair.Tokens, inst.Symboltoken)
+	}
+	return pairs
+}
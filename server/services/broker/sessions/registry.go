@@ -0,0 +1,95 @@
+package sessions
+
+import (
+	"log"
+	"sync"
+
+	"github.com/Sagar-v4/Angel-Two/errorcodes"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+)
+
+// Session is what the broker needs to act for a client in the background:
+// to fire rules, poll price alerts and work bracket orders.
+type Session struct {
+	ClientCode     string
+	JWT            string
+	FeedToken      string // Optional; without it prices are polled
+	ClientLocalIP  string
+	ClientPublicIP string
+	MacAddress     string
+}
+
+// Registry holds the latest session of every client, shared by the rule
+// engine, the price alert monitor and the bracket manager. It is only kept in
+// memory; the gateway hands the broker the session of authenticated requests,
+// which keeps it for clients with background work, so that work resumes with
+// the client's next request after a restart or a refreshed JWT.
+type Registry struct {
+	angel *angelone.Client
+
+	mu       sync.Mutex
+	sessions map[string]Session // Keyed by client code
+	watchers []func()
+}
+
+func NewRegistry(angelClient *angelone.Client) *Registry {
+	return &Registry{angel: angelClient, sessions: make(map[string]Session)}
+}
+
+// Watch registers fn to be called whenever a client's session is set,
+// changes or expires. fn must not block.
+func (r *Registry) Watch(fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.watchers = append(r.watchers, fn)
+}
+
+// Set records the latest session of a client. A session without a feed
+// token keeps the one already known for the same JWT.
+func (r *Registry) Set(sess Session) {
+	r.mu.Lock()
+	current, known := r.sessions[sess.ClientCode]
+	if sess.FeedToken == "" && current.JWT == sess.JWT {
+		sess.FeedToken = current.FeedToken
+	}
+	r.sessions[sess.ClientCode] = sess
+	watchers := r.watchers
+	r.mu.Unlock()
+	if !known || current != sess {
+		for _, fn := range watchers {
+			fn()
+		}
+	}
+}
+
+// Get returns the client's session; ok is false while the broker is waiting
+// for one.
+func (r *Registry) Get(clientCode string) (sess Session, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sess, ok = r.sessions[clientCode]
+	return sess, ok
+}
+
+// Check forgets sess when errorcode says Angel One no longer accepts it, and
+// reports whether it did. A newer session of the client is kept.
+func (r *Registry) Check(sess Session, errorcode string) bool {
+	if !errorcodes.IsSessionError(errorcode) {
+		return false
+	}
+	r.mu.Lock()
+	current, ok := r.sessions[sess.ClientCode]
+	forget := ok && current.JWT == sess.JWT
+	if forget {
+		delete(r.sessions, sess.ClientCode)
+	}
+	watchers := r.watchers
+	r.mu.Unlock()
+	if forget {
+		log.Printf("Broker Service: Angel One session of client %s expired; its background work waits for a new one", sess.ClientCode)
+		for _, fn := range watchers {
+			fn()
+		}
+	}
+	return true
+}
@@ -0,0 +1,193 @@
+// Package stream connects to Angel One's SmartWebSocket V2 market data feed.
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	DefaultURL = "wss://smartapisocket.angelone.in/smart-stream"
+
+	// MaxTokens is the SmartWebSocket V2 limit on subscribed tokens per session.
+	MaxTokens = 1000
+
+	heartbeatInterval = 30 * time.Second // Angel drops the socket after ~30s without a ping
+	writeTimeout      = 10 * time.Second
+	tickBuffer        = 256
+)
+
+// Subscribe and unsubscribe actions.
+const (
+	actionUnsubscribe = 0
+	actionSubscribe   = 1
+)
+
+// Instrument is a single subscription key on the feed.
+type Instrument struct {
+	Exchange    string
+	Symboltoken string
+}
+
+// Credentials identify the Angel One session the feed is opened for.
+type Credentials struct {
+	JWT        string
+	FeedToken  string
+	ClientCode string
+}
+
+// Dialer opens feed connections with the app's API key.
+type Dialer struct {
+	url    string
+	apiKey string
+	dialer *websocket.Dialer
+}
+
+func NewDialer(apiKey string) *Dialer {
+	return &Dialer{
+		url:    DefaultURL,
+		apiKey: apiKey,
+		dialer: &websocket.Dialer{HandshakeTimeout: 10 * time.Second, Proxy: http.ProxyFromEnvironment},
+	}
+}
+
+// Conn is one SmartWebSocket V2 session. Ticks are delivered on Ticks() until
+// the connection fails or is closed, after which Err reports why.
+type Conn struct {
+	*socket
+	ticks chan *pb.Tick
+}
+
+func (d *Dialer) Dial(ctx context.Context, creds Credentials) (*Conn, error) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+creds.JWT)
+	header.Set("x-api-key", d.apiKey)
+	header.Set("x-client-code", creds.ClientCode)
+	header.Set("x-feed-token", creds.FeedToken)
+
+	ws, res, err := d.dialer.DialContext(ctx, d.url, header)
+	if err != nil {
+		if res != nil {
+			return nil, fmt.Errorf("dial market data feed: %w (HTTP %d)", err, res.StatusCode)
+		}
+		return nil, fmt.Errorf("dial market data feed: %w", err)
+	}
+
+	c := &Conn{
+		socket: newSocket(ws, heartbeatInterval),
+		ticks:  make(chan *pb.Tick, tickBuffer),
+	}
+	go c.readLoop()
+	return c, nil
+}
+
+// Ticks is closed when the connection ends.
+func (c *Conn) Ticks() <-chan *pb.Tick { return c.ticks }
+
+func (c *Conn) Subscribe(mode pb.TickMode, instruments []Instrument) error {
+	return c.send(actionSubscribe, mode, instruments)
+}
+
+func (c *Conn) Unsubscribe(mode pb.TickMode, instruments []Instrument) error {
+	return c.send(actionUnsubscribe, mode, instruments)
+}
+
+type tokenList struct {
+	ExchangeType int      `json:"exchangeType"`
+	Tokens       []string `json:"tokens"`
+}
+
+type subscribeRequest struct {
+	CorrelationID string `json:"correlationID"`
+	Action        int    `json:"action"`
+	Params        struct {
+		Mode      int         `json:"mode"`
+		TokenList []tokenList `json:"tokenList"`
+	} `json:"params"`
+}
+
+func (c *Conn) send(action int, mode pb.TickMode, instruments []Instrument) error {
+	payload, err := c.request(action, mode, instruments)
+	if err != nil || payload == nil {
+		return err
+	}
+	return c.write(websocket.TextMessage, payload)
+}
+
+// request builds the (un)subscribe request for the instruments, or returns
+// nil when there are none.
+func (c *Conn) request(action int, mode pb.TickMode, instruments []Instrument) ([]byte, error) {
+	if len(instruments) == 0 {
+		return nil, nil
+	}
+
+	req := subscribeRequest{
+		CorrelationID: strconv.FormatInt(time.Now().UnixNano(), 36),
+		Action:        action,
+	}
+	req.Params.Mode = int(mode)
+	byType := map[int]int{} // exchange type -> index in TokenList
+	for _, inst := range instruments {
+		exchangeType, ok := ExchangeType(inst.Exchange)
+		if !ok {
+			return nil, fmt.Errorf("exchange %q is not available on the market data feed", inst.Exchange)
+		}
+		i, ok := byType[exchangeType]
+		if !ok {
+			i = len(req.Params.TokenList)
+			byType[exchangeType] = i
+			req.Params.TokenList = append(req.Params.TokenList, tokenList{ExchangeType: exchangeType})
+		}
+		req.Params.TokenList[i].Tokens = append(req.Params.TokenList[i].Tokens, inst.Symboltoken)
+	}
+	return json.Marshal(req)
+}
+
+// feedError is the JSON Angel sends for rejected requests.
+type feedError struct {
+	CorrelationID string `json:"correlationID"`
+	ErrorCode     string `json:"errorCode"`
+	ErrorMessage  string `json:"errorMessage"`
+}
+
+func (c *Conn) readLoop() {
+	defer close(c.ticks)
+	for {
+		messageType, data, err := c.ws.ReadMessage()
+		if err != nil {
+			c.fail(fmt.Errorf("read market data feed: %w", err))
+			return
+		}
+
+		if messageType == websocket.TextMessage {
+			if string(data) == "pong" {
+				continue
+			}
+			var fe feedError
+			if json.Unmarshal(data, &fe) == nil && fe.ErrorCode != "" {
+				log.Printf("Broker Service: Market data feed error %s: %s", fe.ErrorCode, fe.ErrorMessage)
+			}
+			continue
+		}
+
+		tick, err := ParseTick(data)
+		if err != nil {
+			log.Printf("Broker Service: Skipping malformed tick: %v", err)
+			continue
+		}
+		select {
+		case c.ticks <- tick:
+		case <-c.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,439 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// DefaultBufferSize is how many ticks a subscriber may fall behind before
+	// its oldest ticks are dropped.
+	DefaultBufferSize = 512
+
+	dialTimeout = 15 * time.Second
+	// idleTimeout keeps an unused upstream open briefly, so a client replacing
+	// its subscription does not reconnect to Angel One.
+	idleTimeout = time.Minute
+)
+
+var (
+	ErrTooManyTokens = fmt.Errorf("more than %d subscriptions on one Angel One session", MaxTokens)
+	ErrHubClosed     = errors.New("tick hub closed")
+)
+
+// Hub multiplexes subscribers onto one upstream connection per Angel One
+// session. Subscriptions are reference counted per (exchange, token, mode):
+// the first listener subscribes upstream and the last one to leave
+// unsubscribes. Ticks are shared between subscribers and must not be modified.
+type Hub struct {
+	dialer     *Dialer
+	bufferSize int
+
+	mu       sync.Mutex
+	sessions map[string]*session // Keyed by client code and feed token
+	closed   bool
+}
+
+func NewHub(dialer *Dialer, bufferSize int) *Hub {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	return &Hub{
+		dialer:     dialer,
+		bufferSize: bufferSize,
+		sessions:   make(map[string]*session),
+	}
+}
+
+// subKey is the unit of reference counting.
+type subKey struct {
+	Instrument
+	mode pb.TickMode
+}
+
+// session is one upstream connection and everyone listening on it.
+type session struct {
+	hub   *Hub
+	key   string
+	ready chan struct{} // Closed once dialing finished
+	err   error         // Dial error, set before ready is closed
+
+	mu        sync.Mutex
+	conn      *Conn
+	listeners map[subKey]map[*Subscriber]struct{}
+	symbols   map[Instrument]string // Filled in on ticks, from whichever subscriber knew it
+	count     int                   // Subscribers attached
+	idle      *time.Timer
+	closed    bool
+	queued    uint64 // Requests numbered by queueLocked
+
+	// Requests are written without holding mu, so a slow write never holds
+	// up dispatch, but in the order they were queued under mu.
+	sendMu   sync.Mutex
+	sendCond *sync.Cond
+	sent     uint64
+}
+
+// request is an upstream (un)subscribe request waiting to be written.
+type request struct {
+	seq     uint64
+	payload []byte
+}
+
+// Subscribe attaches a new subscriber for the instruments in one mode,
+// connecting upstream if the session has no connection yet.
+func (h *Hub) Subscribe(ctx context.Context, creds Credentials, mode pb.TickMode, instruments []*pb.TickInstrument) (*Subscriber, error) {
+	sess, err := h.session(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &Subscriber{
+		sess:   sess,
+		buf:    make([]*pb.Tick, h.bufferSize),
+		notify: make(chan struct{}, 1),
+	}
+	for _, inst := range instruments {
+		sub.keys = append(sub.keys, subKey{Instrument{Exchange: inst.Exchange, Symboltoken: inst.Symboltoken}, mode})
+	}
+
+	sess.mu.Lock()
+	if sess.closed {
+		sess.mu.Unlock()
+		return nil, errors.New("market data feed closed; subscribe again")
+	}
+
+	var added []Instrument
+	seen := make(map[subKey]bool, len(sub.keys))
+	for _, key := range sub.keys {
+		if _, ok := sess.listeners[key]; !ok && !seen[key] {
+			added = append(added, key.Instrument)
+		}
+		seen[key] = true
+	}
+	if len(sess.listeners)+len(added) > MaxTokens {
+		sess.idleLocked() // A freshly dialed session may have no one else on it
+		sess.mu.Unlock()
+		return nil, ErrTooManyTokens
+	}
+	payload, err := sess.conn.request(actionSubscribe, mode, added)
+	if err != nil {
+		sess.idleLocked()
+		sess.mu.Unlock()
+		return nil, err
+	}
+
+	for i, key := range sub.keys {
+		listeners, ok := sess.listeners[key]
+		if !ok {
+			listeners = make(map[*Subscriber]struct{})
+			sess.listeners[key] = listeners
+		}
+		listeners[sub] = struct{}{}
+		if symbol := instruments[i].Tradingsymbol; symbol != "" {
+			sess.symbols[key.Instrument] = symbol
+		}
+	}
+	sess.count++
+	if sess.idle != nil {
+		sess.idle.Stop()
+		sess.idle = nil
+	}
+	var req request
+	if payload != nil {
+		req = sess.queueLocked(payload)
+	}
+	sess.mu.Unlock()
+
+	// Listeners attached meanwhile rely on this request, so a failed write
+	// ends the connection and every subscriber on it.
+	if payload != nil {
+		if err := sess.send(req); err != nil {
+			sess.conn.fail(fmt.Errorf("subscribe: %w", err))
+			return nil, err
+		}
+	}
+	return sub, nil
+}
+
+// session returns the connected session for the credentials, dialing it once
+// even when several subscribers arrive together.
+func (h *Hub) session(ctx context.Context, creds Credentials) (*session, error) {
+	key := creds.ClientCode + "|" + creds.FeedToken
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return nil, ErrHubClosed
+	}
+	sess, ok := h.sessions[key]
+	if !ok {
+		sess = &session{
+			hub:       h,
+			key:       key,
+			ready:     make(chan struct{}),
+			listeners: make(map[subKey]map[*Subscriber]struct{}),
+			symbols:   make(map[Instrument]string),
+		}
+		sess.sendCond = sync.NewCond(&sess.sendMu)
+		h.sessions[key] = sess
+	}
+	h.mu.Unlock()
+
+	if !ok {
+		dialCtx, cancel := context.WithTimeout(context.Background(), dialTimeout) // The connection outlives this caller
+		conn, err := h.dialer.Dial(dialCtx, creds)
+		cancel()
+		if err != nil {
+			sess.err = err
+			h.remove(sess)
+		} else {
+			sess.conn = conn
+			log.Printf("Broker Service: Opened market data feed for client %s", creds.ClientCode)
+			go sess.dispatch()
+		}
+		close(sess.ready)
+	}
+
+	select {
+	case <-sess.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if sess.err != nil {
+		return nil, sess.err
+	}
+	return sess, nil
+}
+
+func (h *Hub) remove(sess *session) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.sessions[sess.key] == sess {
+		delete(h.sessions, sess.key)
+	}
+}
+
+// Close disconnects every session; their subscribers see ErrHubClosed.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	h.closed = true
+	sessions := make([]*session, 0, len(h.sessions))
+	for _, sess := range h.sessions {
+		sessions = append(sessions, sess)
+	}
+	h.mu.Unlock()
+
+	for _, sess := range sessions {
+		<-sess.ready
+		if sess.conn != nil {
+			sess.conn.fail(ErrHubClosed)
+		}
+	}
+}
+
+// dispatch fans ticks out to the listeners of their (exchange, token, mode)
+// and fails every subscriber once the upstream connection ends.
+func (sess *session) dispatch() {
+	for tick := range sess.conn.Ticks() {
+		key := subKey{Instrument{Exchange: tick.Exchange, Symboltoken: tick.Symboltoken}, tick.Mode}
+		sess.mu.Lock()
+		tick.Tradingsymbol = sess.symbols[key.Instrument]
+		for sub := range sess.listeners[key] {
+			sub.push(tick)
+		}
+		sess.mu.Unlock()
+	}
+
+	err := sess.conn.Err()
+	log.Printf("Broker Service: Market data feed closed: %v", err)
+	sess.hub.remove(sess)
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.closed = true
+	if sess.idle != nil {
+		sess.idle.Stop()
+	}
+	for _, listeners := range sess.listeners {
+		for sub := range listeners {
+			sub.fail(err)
+		}
+	}
+	sess.listeners = nil
+}
+
+// release detaches a subscriber and unsubscribes upstream from keys nobody
+// else listens to.
+func (sess *session) release(sub *Subscriber) {
+	sess.mu.Lock()
+	if sess.closed {
+		sess.mu.Unlock()
+		return
+	}
+
+	byMode := map[pb.TickMode][]Instrument{}
+	for _, key := range sub.keys {
+		listeners := sess.listeners[key]
+		if _, ok := listeners[sub]; !ok {
+			continue // Listed twice in the same request
+		}
+		delete(listeners, sub)
+		if len(listeners) == 0 {
+			delete(sess.listeners, key)
+			byMode[key.mode] = append(byMode[key.mode], key.Instrument)
+		}
+	}
+	var reqs []request
+	for mode, instruments := range byMode {
+		payload, err := sess.conn.request(actionUnsubscribe, mode, instruments)
+		if err != nil {
+			log.Printf("Broker Service: Failed to unsubscribe %d %s instruments: %v", len(instruments), mode, err)
+			continue
+		}
+		reqs = append(reqs, sess.queueLocked(payload))
+	}
+
+	sess.count--
+	sess.idleLocked()
+	sess.mu.Unlock()
+
+	for _, req := range reqs {
+		if err := sess.send(req); err != nil {
+			log.Printf("Broker Service: Failed to unsubscribe: %v", err)
+		}
+	}
+}
+
+// queueLocked numbers payload for send. sess.mu must be held, and the
+// request must be sent, as later ones wait for it.
+func (sess *session) queueLocked(payload []byte) request {
+	sess.queued++
+	return request{seq: sess.queued, payload: payload}
+}
+
+// send writes req once every request queued before it is written.
+func (sess *session) send(req request) error {
+	sess.sendMu.Lock()
+	defer sess.sendMu.Unlock()
+	for sess.sent+1 != req.seq {
+		sess.sendCond.Wait()
+	}
+	err := sess.conn.write(websocket.TextMessage, req.payload)
+	sess.sent++
+	sess.sendCond.Broadcast()
+	return err
+}
+
+// idleLocked schedules the connection to close if nobody is subscribed.
+func (sess *session) idleLocked() {
+	if sess.count == 0 && sess.idle == nil {
+		sess.idle = time.AfterFunc(idleTimeout, sess.closeIfIdle)
+	}
+}
+
+func (sess *session) closeIfIdle() {
+	sess.mu.Lock()
+	idle := sess.count == 0 && !sess.closed
+	if idle {
+		sess.closed = true // Late subscribers must not attach to a closing connection
+	}
+	sess.mu.Unlock()
+	if idle {
+		sess.hub.remove(sess)
+		sess.conn.fail(errors.New("no subscribers left"))
+	}
+}
+
+// Subscriber receives the ticks of one subscription through a bounded ring
+// buffer. When it falls behind, the oldest ticks are dropped so a slow
+// consumer never holds up the others.
+type Subscriber struct {
+	sess *session
+	keys []subKey
+
+	mu      sync.Mutex
+	buf     []*pb.Tick
+	head    int
+	size    int
+	dropped uint64
+	err     error
+	notify  chan struct{}
+	once    sync.Once
+}
+
+func (sub *Subscriber) push(tick *pb.Tick) {
+	sub.mu.Lock()
+	if sub.size == len(sub.buf) {
+		sub.head = (sub.head + 1) % len(sub.buf)
+		sub.size--
+		sub.dropped++
+	}
+	sub.buf[(sub.head+sub.size)%len(sub.buf)] = tick
+	sub.size++
+	sub.mu.Unlock()
+	sub.wake()
+}
+
+func (sub *Subscriber) fail(err error) {
+	sub.mu.Lock()
+	if sub.err == nil {
+		sub.err = err
+	}
+	sub.mu.Unlock()
+	sub.wake()
+}
+
+func (sub *Subscriber) wake() {
+	select {
+	case sub.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Next blocks for the next tick. After the upstream connection ends, the
+// buffered ticks are returned first and then the reason it ended.
+func (sub *Subscriber) Next(ctx context.Context) (*pb.Tick, error) {
+	for {
+		sub.mu.Lock()
+		if sub.size > 0 {
+			tick := sub.buf[sub.head]
+			sub.buf[sub.head] = nil
+			sub.head = (sub.head + 1) % len(sub.buf)
+			sub.size--
+			sub.mu.Unlock()
+			return tick, nil
+		}
+		err := sub.err
+		sub.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-sub.notify:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Dropped reports how many ticks were discarded because the subscriber fell behind.
+func (sub *Subscriber) Dropped() uint64 {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	return sub.dropped
+}
+
+// Close detaches the subscriber from the hub.
+func (sub *Subscriber) Close() {
+	sub.once.Do(func() { sub.sess.release(sub) })
+}
@@ -0,0 +1,244 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	"github.com/gorilla/websocket"
+)
+
+// feedServer is a SmartWebSocket V2 stand-in that records subscribe and
+// unsubscribe requests and sends the ticks it is given.
+type feedServer struct {
+	*httptest.Server
+	requests chan subscribeRequest
+	ticks    chan []byte
+}
+
+func newFeedServer(t *testing.T) *feedServer {
+	t.Helper()
+	s := &feedServer{requests: make(chan subscribeRequest, 16), ticks: make(chan []byte, 16)}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.Close()
+		go func() {
+			for tick := range s.ticks {
+				if ws.WriteMessage(websocket.BinaryMessage, tick) != nil {
+					return
+				}
+			}
+		}()
+		for {
+			_, data, err := ws.ReadMessage()
+			if err != nil {
+				return
+			}
+			var req subscribeRequest
+			if json.Unmarshal(data, &req) == nil {
+				s.requests <- req
+			}
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *feedServer) dialer() *Dialer {
+	d := NewDialer("key")
+	d.url = "ws" + strings.TrimPrefix(s.URL, "http")
+	return d
+}
+
+// expect waits for the next request and compares its action and tokens.
+func (s *feedServer) expect(t *testing.T, action int, tokens ...string) {
+	t.Helper()
+	select {
+	case req := <-s.requests:
+		var got []string
+		for _, list := range req.Params.TokenList {
+			got = append(got, list.Tokens...)
+		}
+		if req.Action != action || !reflect.DeepEqual(got, tokens) {
+			t.Fatalf("request = action %d tokens %v, want action %d tokens %v", req.Action, got, action, tokens)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("no request, want action %d tokens %v", action, tokens)
+	}
+}
+
+func (s *feedServer) expectNone(t *testing.T) {
+	t.Helper()
+	select {
+	case req := <-s.requests:
+		t.Fatalf("unexpected request %+v", req)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func nse(tokens ...string) []*pb.TickInstrument {
+	var instruments []*pb.TickInstrument
+	for _, token := range tokens {
+		instruments = append(instruments, &pb.TickInstrument{Exchange: "NSE", Symboltoken: token})
+	}
+	return instruments
+}
+
+func TestHubReferenceCounting(t *testing.T) {
+	server := newFeedServer(t)
+	hub := NewHub(server.dialer(), 8)
+	defer hub.Close()
+	ctx := context.Background()
+	creds := Credentials{JWT: "jwt", FeedToken: "feed", ClientCode: "C1"}
+
+	a, err := hub.Subscribe(ctx, creds, pb.TickMode_LTP, nse("1", "2"))
+	if err != nil {
+		t.Fatalf("Subscribe(a) error = %v", err)
+	}
+	server.expect(t, actionSubscribe, "1", "2")
+
+	b, err := hub.Subscribe(ctx, creds, pb.TickMode_LTP, nse("2", "3", "3"))
+	if err != nil {
+		t.Fatalf("Subscribe(b) error = %v", err)
+	}
+	server.expect(t, actionSubscribe, "3") // 2 is already subscribed
+
+	quote, err := hub.Subscribe(ctx, creds, pb.TickMode_QUOTE, nse("2"))
+	if err != nil {
+		t.Fatalf("Subscribe(quote) error = %v", err)
+	}
+	server.expect(t, actionSubscribe, "2") // Same token, another mode
+
+	// A tick reaches every listener of its token and mode only.
+	server.ticks <- packet(ltpPacketSize, pb.TickMode_LTP, 1, "2")
+	for name, sub := range map[string]*Subscriber{"a": a, "b": b} {
+		tickCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		tick, err := sub.Next(tickCtx)
+		cancel()
+		if err != nil || tick.Symboltoken != "2" {
+			t.Fatalf("%s.Next() = %v, %v, want the tick of token 2", name, tick, err)
+		}
+	}
+	quote.mu.Lock()
+	if quote.size != 0 {
+		t.Errorf("QUOTE subscriber got an LTP tick")
+	}
+	quote.mu.Unlock()
+
+	a.Close()
+	server.expect(t, actionUnsubscribe, "1") // b still listens to 2
+	a.Close()
+	server.expectNone(t)
+
+	quote.Close()
+	server.expect(t, actionUnsubscribe, "2")
+
+	b.Close()
+	server.expect(t, actionUnsubscribe, "2", "3")
+}
+
+func TestHubTooManyTokens(t *testing.T) {
+	server := newFeedServer(t)
+	hub := NewHub(server.dialer(), 8)
+	defer hub.Close()
+
+	tokens := make([]string, MaxTokens+1)
+	for i := range tokens {
+		tokens[i] = string(rune('a'+i%26)) + strings.Repeat("x", i/26)
+	}
+	_, err := hub.Subscribe(context.Background(), Credentials{ClientCode: "C1"}, pb.TickMode_LTP, nse(tokens...))
+	if !errors.Is(err, ErrTooManyTokens) {
+		t.Fatalf("Subscribe() error = %v, want ErrTooManyTokens", err)
+	}
+	server.expectNone(t)
+}
+
+func TestSubscriberDropsOldest(t *testing.T) {
+	tests := []struct {
+		name        string
+		bufferSize  int
+		pushed      int
+		want        []int64 // Sequences returned by Next, in order
+		wantDropped uint64
+	}{
+		{name: "within the buffer", bufferSize: 4, pushed: 3, want: []int64{1, 2, 3}},
+		{name: "exactly full", bufferSize: 3, pushed: 3, want: []int64{1, 2, 3}},
+		{name: "one over", bufferSize: 3, pushed: 4, want: []int64{2, 3, 4}, wantDropped: 1},
+		{name: "wrapped several times", bufferSize: 3, pushed: 10, want: []int64{8, 9, 10}, wantDropped: 7},
+		{name: "buffer of one", bufferSize: 1, pushed: 5, want: []int64{5}, wantDropped: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := &Subscriber{buf: make([]*pb.Tick, tt.bufferSize), notify: make(chan struct{}, 1)}
+			for seq := int64(1); seq <= int64(tt.pushed); seq++ {
+				sub.push(&pb.Tick{Sequence: seq})
+			}
+			closed := errors.New("feed closed")
+			sub.fail(closed)
+
+			var got []int64
+			for {
+				tick, err := sub.Next(context.Background())
+				if err != nil {
+					if err != closed {
+						t.Fatalf("Next() error = %v, want %v", err, closed)
+					}
+					break
+				}
+				got = append(got, tick.Sequence)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Next() returned %v, want %v", got, tt.want)
+			}
+			if sub.Dropped() != tt.wantDropped {
+				t.Errorf("Dropped() = %d, want %d", sub.Dropped(), tt.wantDropped)
+			}
+		})
+	}
+}
+
+func TestSubscriberNextInterleaved(t *testing.T) {
+	sub := &Subscriber{buf: make([]*pb.Tick, 2), notify: make(chan struct{}, 1)}
+	var got []int64
+	for seq := int64(1); seq <= 5; seq++ {
+		sub.push(&pb.Tick{Sequence: seq})
+		if seq%2 == 0 {
+			tick, err := sub.Next(context.Background())
+			if err != nil {
+				t.Fatalf("Next() error = %v", err)
+			}
+			got = append(got, tick.Sequence)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	for {
+		tick, err := sub.Next(ctx)
+		if err != nil {
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("Next() error = %v, want the context's", err)
+			}
+			break
+		}
+		got = append(got, tick.Sequence)
+	}
+	if want := []int64{1, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Next() returned %v, want %v", got, want)
+	}
+	if sub.Dropped() != 1 {
+		t.Errorf("Dropped() = %d, want 1", sub.Dropped())
+	}
+}
@@ -0,0 +1,234 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+var (
+	// ErrFellBehind ends an order subscriber that let orderUpdateBuffer
+	// updates pile up, rather than have it miss orders or hold up the others.
+	ErrFellBehind     = errors.New("order update subscriber fell behind")
+	ErrOrderHubClosed = errors.New("order hub closed")
+)
+
+// OrderHub shares one order-status connection per Angel One JWT between its
+// subscribers, e.g. the bracket manager and every StreamOrderUpdates caller of
+// the same session. Every update reaches every subscriber and must not be
+// modified. The connection is closed idleTimeout after the last subscriber
+// leaves.
+type OrderHub struct {
+	url string
+
+	mu       sync.Mutex
+	sessions map[string]*orderSession // Keyed by JWT
+	closed   bool
+}
+
+func NewOrderHub() *OrderHub {
+	return &OrderHub{url: OrderUpdatesURL, sessions: make(map[string]*orderSession)}
+}
+
+// orderSession is one upstream order feed and everyone listening on it.
+type orderSession struct {
+	hub   *OrderHub
+	jwt   string
+	ready chan struct{} // Closed once dialing finished
+	err   error         // Dial error, set before ready is closed
+	feed  *OrderFeed
+
+	mu     sync.Mutex
+	subs   map[*OrderSubscriber]struct{}
+	idle   *time.Timer
+	closed bool
+}
+
+// OrderSubscriber receives the updates of a shared order feed.
+type OrderSubscriber struct {
+	sess    *orderSession
+	updates chan *pb.OrderUpdate
+	err     error // Set under sess.mu before updates is closed
+}
+
+// Subscribe attaches a new subscriber to the JWT's order feed, connecting
+// upstream if nobody listens to it yet.
+func (h *OrderHub) Subscribe(ctx context.Context, jwt string) (*OrderSubscriber, error) {
+	sess, err := h.session(ctx, jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if sess.closed {
+		return nil, errors.New("order update feed closed; subscribe again")
+	}
+	sub := &OrderSubscriber{sess: sess, updates: make(chan *pb.OrderUpdate, orderUpdateBuffer)}
+	sess.subs[sub] = struct{}{}
+	if sess.idle != nil {
+		sess.idle.Stop()
+		sess.idle = nil
+	}
+	return sub, nil
+}
+
+// session returns the connected session for the JWT, dialing it once even
+// when several subscribers arrive together.
+func (h *OrderHub) session(ctx context.Context, jwt string) (*orderSession, error) {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return nil, ErrOrderHubClosed
+	}
+	sess, ok := h.sessions[jwt]
+	if !ok {
+		sess = &orderSession{
+			hub:   h,
+			jwt:   jwt,
+			ready: make(chan struct{}),
+			subs:  make(map[*OrderSubscriber]struct{}),
+		}
+		h.sessions[jwt] = sess
+	}
+	h.mu.Unlock()
+
+	if !ok {
+		dialCtx, cancel := context.WithTimeout(context.Background(), dialTimeout) // The connection outlives this caller
+		feed, err := dialOrderUpdates(dialCtx, h.url, jwt)
+		cancel()
+		if err != nil {
+			sess.err = err
+			h.remove(sess)
+		} else {
+			sess.feed = feed
+			sess.mu.Lock()
+			sess.idleLocked() // Until the caller subscribes, should it give up first
+			sess.mu.Unlock()
+			log.Printf("Broker Service: Opened order update feed")
+			go sess.dispatch()
+		}
+		close(sess.ready)
+	}
+
+	select {
+	case <-sess.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if sess.err != nil {
+		return nil, sess.err
+	}
+	return sess, nil
+}
+
+func (h *OrderHub) remove(sess *orderSession) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.sessions[sess.jwt] == sess {
+		delete(h.sessions, sess.jwt)
+	}
+}
+
+// Close disconnects every session; their subscribers see ErrOrderHubClosed.
+func (h *OrderHub) Close() {
+	h.mu.Lock()
+	h.closed = true
+	sessions := make([]*orderSession, 0, len(h.sessions))
+	for _, sess := range h.sessions {
+		sessions = append(sessions, sess)
+	}
+	h.mu.Unlock()
+
+	for _, sess := range sessions {
+		<-sess.ready
+		if sess.feed != nil {
+			sess.feed.fail(ErrOrderHubClosed)
+		}
+	}
+}
+
+// dispatch fans updates out to every subscriber and ends them all once the
+// upstream connection ends.
+func (sess *orderSession) dispatch() {
+	for update := range sess.feed.Updates() {
+		sess.mu.Lock()
+		for sub := range sess.subs {
+			select {
+			case sub.updates <- update:
+			default:
+				sess.dropLocked(sub, ErrFellBehind)
+			}
+		}
+		sess.idleLocked()
+		sess.mu.Unlock()
+	}
+
+	err := sess.feed.Err()
+	log.Printf("Broker Service: Order update feed closed: %v", err)
+	sess.hub.remove(sess)
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.closed = true
+	if sess.idle != nil {
+		sess.idle.Stop()
+	}
+	for sub := range sess.subs {
+		sess.dropLocked(sub, err)
+	}
+}
+
+// dropLocked detaches sub and closes its updates with err.
+func (sess *orderSession) dropLocked(sub *OrderSubscriber, err error) {
+	sub.err = err
+	close(sub.updates)
+	delete(sess.subs, sub)
+}
+
+// idleLocked schedules the connection to close if nobody is subscribed.
+func (sess *orderSession) idleLocked() {
+	if len(sess.subs) == 0 && sess.idle == nil && !sess.closed {
+		sess.idle = time.AfterFunc(idleTimeout, sess.closeIfIdle)
+	}
+}
+
+func (sess *orderSession) closeIfIdle() {
+	sess.mu.Lock()
+	idle := len(sess.subs) == 0 && !sess.closed
+	if idle {
+		sess.closed = true // Late subscribers must not attach to a closing connection
+	}
+	sess.mu.Unlock()
+	if idle {
+		sess.hub.remove(sess)
+		sess.feed.fail(errors.New("no subscribers left"))
+	}
+}
+
+// Updates is closed when the subscriber is closed, falls behind or the
+// connection ends; Err then tells which.
+func (sub *OrderSubscriber) Updates() <-chan *pb.OrderUpdate { return sub.updates }
+
+// Err returns the reason the updates ended, or nil while they flow.
+func (sub *OrderSubscriber) Err() error {
+	sub.sess.mu.Lock()
+	defer sub.sess.mu.Unlock()
+	return sub.err
+}
+
+// Close detaches the subscriber from the hub.
+func (sub *OrderSubscriber) Close() {
+	sess := sub.sess
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if _, ok := sess.subs[sub]; !ok {
+		return // Already ended
+	}
+	sess.dropLocked(sub, errors.New("subscriber closed"))
+	sess.idleLocked()
+}
@@ -0,0 +1,150 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// orderServer is an order-status feed stand-in that counts connections and
+// sends every connection the messages it is given.
+type orderServer struct {
+	*httptest.Server
+	conns    atomic.Int32
+	messages chan string
+}
+
+func newOrderServer(t *testing.T) *orderServer {
+	t.Helper()
+	s := &orderServer{messages: make(chan string, 2*orderUpdateBuffer)}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.Close()
+		s.conns.Add(1)
+		go func() {
+			for {
+				if _, _, err := ws.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+		for msg := range s.messages {
+			if ws.WriteMessage(websocket.TextMessage, []byte(msg)) != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(func() {
+		close(s.messages)
+		s.Close()
+	})
+	return s
+}
+
+func (s *orderServer) hub() *OrderHub {
+	h := NewOrderHub()
+	h.url = "ws" + strings.TrimPrefix(s.URL, "http")
+	return h
+}
+
+func (s *orderServer) send(orderid string) {
+	s.messages <- fmt.Sprintf(`{"status-code":"200","order-status":"AB02","orderData":{"orderid":%q,"orderstatus":"open"}}`, orderid)
+}
+
+func nextOrder(t *testing.T, sub *OrderSubscriber) string {
+	t.Helper()
+	select {
+	case update, ok := <-sub.Updates():
+		if !ok {
+			t.Fatalf("updates closed: %v", sub.Err())
+		}
+		return update.Order.Orderid
+	case <-time.After(2 * time.Second):
+		t.Fatal("no update")
+	}
+	return ""
+}
+
+func TestOrderHubSharesConnection(t *testing.T) {
+	server := newOrderServer(t)
+	hub := server.hub()
+	defer hub.Close()
+	ctx := context.Background()
+
+	a, err := hub.Subscribe(ctx, "jwt")
+	if err != nil {
+		t.Fatalf("Subscribe(a) error = %v", err)
+	}
+	b, err := hub.Subscribe(ctx, "jwt")
+	if err != nil {
+		t.Fatalf("Subscribe(b) error = %v", err)
+	}
+	if n := server.conns.Load(); n != 1 {
+		t.Fatalf("connections = %d, want 1", n)
+	}
+
+	server.send("1")
+	if got := nextOrder(t, a); got != "1" {
+		t.Errorf("a got order %s, want 1", got)
+	}
+	if got := nextOrder(t, b); got != "1" {
+		t.Errorf("b got order %s, want 1", got)
+	}
+
+	a.Close()
+	if _, ok := <-a.Updates(); ok || a.Err() == nil {
+		t.Errorf("closed subscriber still open")
+	}
+	server.send("2")
+	if got := nextOrder(t, b); got != "2" {
+		t.Errorf("b got order %s after a left, want 2", got)
+	}
+	b.Close()
+}
+
+func TestOrderHubDropsSlowSubscriber(t *testing.T) {
+	server := newOrderServer(t)
+	hub := server.hub()
+	defer hub.Close()
+
+	slow, err := hub.Subscribe(context.Background(), "jwt")
+	if err != nil {
+		t.Fatalf("Subscribe(slow) error = %v", err)
+	}
+	fast, err := hub.Subscribe(context.Background(), "jwt")
+	if err != nil {
+		t.Fatalf("Subscribe(fast) error = %v", err)
+	}
+	defer fast.Close()
+
+	for i := 0; i <= orderUpdateBuffer; i++ {
+		server.send(fmt.Sprint(i))
+	}
+	for i := 0; i <= orderUpdateBuffer; i++ {
+		nextOrder(t, fast)
+	}
+	// The last update was sent to fast while the session was locked, so the
+	// slow subscriber has been dealt with once the lock is free.
+	slow.sess.mu.Lock()
+	slow.sess.mu.Unlock()
+
+	got := 0
+	for range slow.Updates() {
+		got++
+	}
+	if got != orderUpdateBuffer || !errors.Is(slow.Err(), ErrFellBehind) {
+		t.Errorf("slow subscriber got %d updates and %v, want %d and ErrFellBehind", got, slow.Err(), orderUpdateBuffer)
+	}
+}
@@ -0,0 +1,139 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	OrderUpdatesURL = "wss://tns.angelone.in/smart-order-update"
+
+	orderHeartbeatInterval = 10 * time.Second
+	orderUpdateBuffer      = 64
+	orderStatusConnected   = "AB00" // Sent once after the connection is accepted
+)
+
+// OrderFeed is one connection to Angel One's order-status WebSocket, which
+// pushes every change to the account's orders.
+type OrderFeed struct {
+	*socket
+	updates chan *pb.OrderUpdate
+}
+
+// dialOrderUpdates connects to the order-status feed at url. Unlike market
+// data it only needs the session JWT.
+func dialOrderUpdates(ctx context.Context, url, jwt string) (*OrderFeed, error) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+jwt)
+
+	dialer := &websocket.Dialer{HandshakeTimeout: 10 * time.Second, Proxy: http.ProxyFromEnvironment}
+	ws, res, err := dialer.DialContext(ctx, url, header)
+	if err != nil {
+		if res != nil {
+			return nil, fmt.Errorf("dial order updates: %w (HTTP %d)", err, res.StatusCode)
+		}
+		return nil, fmt.Errorf("dial order updates: %w", err)
+	}
+
+	f := &OrderFeed{
+		socket:  newSocket(ws, orderHeartbeatInterval),
+		updates: make(chan *pb.OrderUpdate, orderUpdateBuffer),
+	}
+	go f.readLoop()
+	return f, nil
+}
+
+// Updates is closed when the connection ends.
+func (f *OrderFeed) Updates() <-chan *pb.OrderUpdate { return f.updates }
+
+// orderStatusMessage is the JSON Angel sends for each order change.
+type orderStatusMessage struct {
+	UserID       string            `json:"user-id"`
+	StatusCode   string            `json:"status-code"`
+	OrderStatus  string            `json:"order-status"`
+	ErrorMessage string            `json:"error-message"`
+	OrderData    *pb.OrderBookItem `json:"orderData"`
+}
+
+func (f *OrderFeed) readLoop() {
+	defer close(f.updates)
+	for {
+		_, data, err := f.ws.ReadMessage()
+		if err != nil {
+			f.fail(fmt.Errorf("read order updates: %w", err))
+			return
+		}
+		if string(data) == "pong" {
+			continue
+		}
+
+		var msg orderStatusMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			log.Printf("Broker Service: Skipping malformed order update: %v. Body: %s", err, string(data))
+			continue
+		}
+		if msg.StatusCode != "" && msg.StatusCode != "200" {
+			f.fail(fmt.Errorf("order updates rejected: %s %s", msg.StatusCode, msg.ErrorMessage))
+			return
+		}
+		if msg.OrderStatus == orderStatusConnected || msg.OrderData == nil || msg.OrderData.Orderid == "" {
+			continue
+		}
+
+		eventType := OrderEventType(msg.OrderData)
+		if eventType == pb.OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED {
+			log.Printf("Broker Service: Skipping order update %s with status %q", msg.OrderStatus, msg.OrderData.Orderstatus)
+			continue
+		}
+		update := &pb.OrderUpdate{
+			Type:            eventType,
+			OrderStatusCode: msg.OrderStatus,
+			ReceivedAt:      time.Now().UnixMilli(),
+			Order:           msg.OrderData,
+		}
+		select {
+		case f.updates <- update:
+		case <-f.done:
+			return
+		}
+	}
+}
+
+// OrderEventType classifies an order by its Angel One status text. Open
+// orders with filled quantity are reported as partially filled.
+func OrderEventType(order *pb.OrderBookItem) pb.OrderEventType {
+	status := strings.ToLower(strings.TrimSpace(order.Orderstatus))
+	if status == "" {
+		status = strings.ToLower(strings.TrimSpace(order.Status))
+	}
+
+	switch status {
+	case "complete":
+		return pb.OrderEventType_COMPLETE
+	case "rejected":
+		return pb.OrderEventType_REJECTED
+	case "cancelled":
+		return pb.OrderEventType_CANCELLED
+	case "open", "trigger pending", "modified", "modify pending", "modify validation pending", "cancel pending":
+		if filled, _ := strconv.ParseInt(order.Filledshares, 10, 64); filled > 0 {
+			return pb.OrderEventType_PARTIALLY_FILLED
+		}
+		return pb.OrderEventType_OPEN
+	}
+	// "open pending", "validation pending", "put order req received",
+	// "after market order req received" and the like
+	if strings.Contains(status, "pending") || strings.Contains(status, "received") {
+		return pb.OrderEventType_PLACED
+	}
+	return pb.OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED
+}
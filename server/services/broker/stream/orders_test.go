@@ -0,0 +1,41 @@
+package stream
+
+import (
+	"testing"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+func TestOrderEventType(t *testing.T) {
+	tests := []struct {
+		name  string
+		order *pb.OrderBookItem
+		want  pb.OrderEventType
+	}{
+		{"complete", &pb.OrderBookItem{Orderstatus: "complete", Filledshares: "10"}, pb.OrderEventType_COMPLETE},
+		{"rejected", &pb.OrderBookItem{Orderstatus: "rejected"}, pb.OrderEventType_REJECTED},
+		{"cancelled with a partial fill", &pb.OrderBookItem{Orderstatus: "cancelled", Filledshares: "4"}, pb.OrderEventType_CANCELLED},
+		{"open", &pb.OrderBookItem{Orderstatus: "open", Filledshares: "0"}, pb.OrderEventType_OPEN},
+		{"open partially filled", &pb.OrderBookItem{Orderstatus: "open", Filledshares: "4"}, pb.OrderEventType_PARTIALLY_FILLED},
+		{"trigger pending", &pb.OrderBookItem{Orderstatus: "trigger pending"}, pb.OrderEventType_OPEN},
+		{"cancel pending partially filled", &pb.OrderBookItem{Orderstatus: "cancel pending", Filledshares: "1"}, pb.OrderEventType_PARTIALLY_FILLED},
+		{"modified", &pb.OrderBookItem{Orderstatus: "modified"}, pb.OrderEventType_OPEN},
+		{"open pending", &pb.OrderBookItem{Orderstatus: "open pending"}, pb.OrderEventType_PLACED},
+		{"validation pending", &pb.OrderBookItem{Orderstatus: "validation pending"}, pb.OrderEventType_PLACED},
+		{"after market order received", &pb.OrderBookItem{Orderstatus: "after market order req received"}, pb.OrderEventType_PLACED},
+		{"case and spaces", &pb.OrderBookItem{Orderstatus: "  Complete "}, pb.OrderEventType_COMPLETE},
+		{"status when orderstatus is empty", &pb.OrderBookItem{Status: "rejected"}, pb.OrderEventType_REJECTED},
+		{"orderstatus over status", &pb.OrderBookItem{Orderstatus: "open", Status: "complete"}, pb.OrderEventType_OPEN},
+		{"unparsable filledshares", &pb.OrderBookItem{Orderstatus: "open", Filledshares: "n/a"}, pb.OrderEventType_OPEN},
+		{"unknown", &pb.OrderBookItem{Orderstatus: "frozen"}, pb.OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED},
+		{"empty", &pb.OrderBookItem{}, pb.OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OrderEventType(tt.order); got != tt.want {
+				t.Errorf("OrderEventType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
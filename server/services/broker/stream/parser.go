@@ -0,0 +1,129 @@
+package stream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// SmartWebSocket V2 exchange types.
+var exchangeTypes = map[string]int{
+	"NSE":   1,  // nse_cm
+	"NFO":   2,  // nse_fo
+	"BSE":   3,  // bse_cm
+	"BFO":   4,  // bse_fo
+	"MCX":   5,  // mcx_fo
+	"NCDEX": 7,  // ncx_fo
+	"CDS":   13, // cde_fo
+}
+
+var exchangeNames = func() map[int]string {
+	names := make(map[int]string, len(exchangeTypes))
+	for name, t := range exchangeTypes {
+		names[t] = name
+	}
+	return names
+}()
+
+// ExchangeType returns the SmartWebSocket V2 exchange type for an exchange name.
+func ExchangeType(exchange string) (int, bool) {
+	t, ok := exchangeTypes[strings.ToUpper(exchange)]
+	return t, ok
+}
+
+// Binary tick packet sizes per mode.
+const (
+	ltpPacketSize       = 51
+	quotePacketSize     = 123
+	snapQuotePacketSize = 379
+	depthLevels         = 10 // Five bids and five asks
+	depthPacketSize     = 20
+)
+
+// ParseTick decodes one binary tick. All integers are little-endian and prices
+// are in paise (1e-7 rupees for currency derivatives).
+func ParseTick(b []byte) (*pb.Tick, error) {
+	if len(b) < ltpPacketSize {
+		return nil, fmt.Errorf("tick too short: %d bytes", len(b))
+	}
+
+	mode := pb.TickMode(b[0])
+	exchange, ok := exchangeNames[int(b[1])]
+	if !ok {
+		return nil, fmt.Errorf("unknown exchange type %d", b[1])
+	}
+	divisor := 100.0
+	if exchange == "CDS" {
+		divisor = 1e7
+	}
+	price := func(offset int) float64 {
+		return float64(int64(binary.LittleEndian.Uint64(b[offset:]))) / divisor
+	}
+	i64 := func(offset int) int64 {
+		return int64(binary.LittleEndian.Uint64(b[offset:]))
+	}
+	f64 := func(offset int) float64 {
+		return math.Float64frombits(binary.LittleEndian.Uint64(b[offset:]))
+	}
+
+	token := b[2:27]
+	if end := bytes.IndexByte(token, 0); end >= 0 {
+		token = token[:end]
+	}
+
+	tick := &pb.Tick{
+		Mode:              mode,
+		Exchange:          exchange,
+		Symboltoken:       string(token),
+		Sequence:          i64(27),
+		ExchangeTimestamp: i64(35),
+		Ltp:               price(43),
+	}
+	if mode == pb.TickMode_LTP {
+		return tick, nil
+	}
+
+	if len(b) < quotePacketSize {
+		return nil, fmt.Errorf("%s tick too short: %d bytes", mode, len(b))
+	}
+	tick.LastTradedQuantity = i64(51)
+	tick.AveragePrice = price(59)
+	tick.Volume = i64(67)
+	tick.TotalBuyQuantity = f64(75)
+	tick.TotalSellQuantity = f64(83)
+	tick.Open = price(91)
+	tick.High = price(99)
+	tick.Low = price(107)
+	tick.Close = price(115)
+	if mode == pb.TickMode_QUOTE {
+		return tick, nil
+	}
+
+	if len(b) < snapQuotePacketSize {
+		return nil, fmt.Errorf("%s tick too short: %d bytes", mode, len(b))
+	}
+	tick.LastTradedTimestamp = i64(123)
+	tick.OpenInterest = i64(131)
+	for level := 0; level < depthLevels; level++ {
+		offset := 147 + level*depthPacketSize
+		depth := &pb.DepthLevel{
+			Quantity: i64(offset + 2),
+			Price:    price(offset + 10),
+			Orders:   int32(int16(binary.LittleEndian.Uint16(b[offset+18:]))),
+		}
+		if binary.LittleEndian.Uint16(b[offset:]) == 1 {
+			tick.BestBids = append(tick.BestBids, depth)
+		} else {
+			tick.BestAsks = append(tick.BestAsks, depth)
+		}
+	}
+	tick.UpperCircuit = price(347)
+	tick.LowerCircuit = price(355)
+	tick.Week52High = price(363)
+	tick.Week52Low = price(371)
+	return tick, nil
+}
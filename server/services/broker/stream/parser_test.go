@@ -0,0 +1,177 @@
+package stream
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+)
+
+// packet builds a binary tick of the given size with the header filled in.
+func packet(size int, mode pb.TickMode, exchangeType byte, token string) []byte {
+	b := make([]byte, size)
+	b[0] = byte(mode)
+	b[1] = exchangeType
+	copy(b[2:27], token)
+	return b
+}
+
+func putInt(b []byte, offset int, v int64) {
+	binary.LittleEndian.PutUint64(b[offset:], uint64(v))
+}
+
+func putFloat(b []byte, offset int, v float64) {
+	binary.LittleEndian.PutUint64(b[offset:], math.Float64bits(v))
+}
+
+func TestParseTick(t *testing.T) {
+	ltp := packet(ltpPacketSize, pb.TickMode_LTP, 1, "2885")
+	putInt(ltp, 27, 42)
+	putInt(ltp, 35, 1700000000000)
+	putInt(ltp, 43, 123450)
+
+	cds := packet(ltpPacketSize, pb.TickMode_LTP, 13, "1234")
+	putInt(cds, 43, 832512500)
+
+	quote := packet(quotePacketSize, pb.TickMode_QUOTE, 3, "500325")
+	putInt(quote, 43, 250000)
+	putInt(quote, 51, 15)
+	putInt(quote, 59, 249950)
+	putInt(quote, 67, 100000)
+	putFloat(quote, 75, 5000)
+	putFloat(quote, 83, 7000)
+	putInt(quote, 91, 248000)
+	putInt(quote, 99, 251000)
+	putInt(quote, 107, 247500)
+	putInt(quote, 115, 249000)
+
+	snap := packet(snapQuotePacketSize, pb.TickMode_SNAP_QUOTE, 1, "11536")
+	putInt(snap, 43, 380000)
+	putInt(snap, 123, 1700000001000)
+	putInt(snap, 131, 900)
+	for level := 0; level < depthLevels; level++ {
+		offset := 147 + level*depthPacketSize
+		if level < 5 {
+			binary.LittleEndian.PutUint16(snap[offset:], 1) // Buy side
+		}
+		putInt(snap, offset+2, int64(10*(level+1)))
+		putInt(snap, offset+10, int64(379900+level*5))
+		binary.LittleEndian.PutUint16(snap[offset+18:], uint16(level+1))
+	}
+	putInt(snap, 347, 418000)
+	putInt(snap, 355, 342000)
+	putInt(snap, 363, 400000)
+	putInt(snap, 371, 300000)
+
+	tests := []struct {
+		name    string
+		packet  []byte
+		wantErr string
+		check   func(t *testing.T, tick *pb.Tick)
+	}{
+		{
+			name:   "ltp",
+			packet: ltp,
+			check: func(t *testing.T, tick *pb.Tick) {
+				if tick.Mode != pb.TickMode_LTP || tick.Exchange != "NSE" || tick.Symboltoken != "2885" {
+					t.Errorf("header = %v %s %q, want LTP NSE \"2885\"", tick.Mode, tick.Exchange, tick.Symboltoken)
+				}
+				if tick.Sequence != 42 || tick.ExchangeTimestamp != 1700000000000 {
+					t.Errorf("sequence, timestamp = %d, %d", tick.Sequence, tick.ExchangeTimestamp)
+				}
+				if tick.Ltp != 1234.50 {
+					t.Errorf("ltp = %v, want 1234.50", tick.Ltp)
+				}
+			},
+		},
+		{
+			name:   "currency prices in 1e-7 rupees",
+			packet: cds,
+			check: func(t *testing.T, tick *pb.Tick) {
+				if tick.Exchange != "CDS" || tick.Ltp != 83.25125 {
+					t.Errorf("exchange, ltp = %s, %v, want CDS, 83.25125", tick.Exchange, tick.Ltp)
+				}
+			},
+		},
+		{
+			name:   "quote",
+			packet: quote,
+			check: func(t *testing.T, tick *pb.Tick) {
+				if tick.Exchange != "BSE" || tick.Symboltoken != "500325" || tick.Ltp != 2500 {
+					t.Errorf("exchange, token, ltp = %s, %q, %v", tick.Exchange, tick.Symboltoken, tick.Ltp)
+				}
+				if tick.LastTradedQuantity != 15 || tick.AveragePrice != 2499.50 || tick.Volume != 100000 {
+					t.Errorf("ltq, average, volume = %d, %v, %d", tick.LastTradedQuantity, tick.AveragePrice, tick.Volume)
+				}
+				if tick.TotalBuyQuantity != 5000 || tick.TotalSellQuantity != 7000 {
+					t.Errorf("total buy, sell = %v, %v", tick.TotalBuyQuantity, tick.TotalSellQuantity)
+				}
+				if tick.Open != 2480 || tick.High != 2510 || tick.Low != 2475 || tick.Close != 2490 {
+					t.Errorf("ohlc = %v %v %v %v", tick.Open, tick.High, tick.Low, tick.Close)
+				}
+				if len(tick.BestBids) != 0 || tick.OpenInterest != 0 {
+					t.Errorf("quote tick has snap quote fields: %v", tick)
+				}
+			},
+		},
+		{
+			name:   "snap quote",
+			packet: snap,
+			check: func(t *testing.T, tick *pb.Tick) {
+				if tick.Ltp != 3800 || tick.LastTradedTimestamp != 1700000001000 || tick.OpenInterest != 900 {
+					t.Errorf("ltp, ltt, oi = %v, %d, %d", tick.Ltp, tick.LastTradedTimestamp, tick.OpenInterest)
+				}
+				if len(tick.BestBids) != 5 || len(tick.BestAsks) != 5 {
+					t.Fatalf("depth = %d bids, %d asks, want 5 each", len(tick.BestBids), len(tick.BestAsks))
+				}
+				if bid := tick.BestBids[0]; bid.Quantity != 10 || bid.Price != 3799 || bid.Orders != 1 {
+					t.Errorf("best bid = %v", bid)
+				}
+				if ask := tick.BestAsks[4]; ask.Quantity != 100 || ask.Price != 3799.45 || ask.Orders != 10 {
+					t.Errorf("last ask = %v", ask)
+				}
+				if tick.UpperCircuit != 4180 || tick.LowerCircuit != 3420 || tick.Week52High != 4000 || tick.Week52Low != 3000 {
+					t.Errorf("circuits, 52 week = %v %v %v %v", tick.UpperCircuit, tick.LowerCircuit, tick.Week52High, tick.Week52Low)
+				}
+			},
+		},
+		{
+			name:    "shorter than ltp",
+			packet:  ltp[:ltpPacketSize-1],
+			wantErr: "tick too short",
+		},
+		{
+			name:    "quote cut to ltp size",
+			packet:  append(packet(ltpPacketSize, pb.TickMode_QUOTE, 1, "2885"), make([]byte, 10)...),
+			wantErr: "QUOTE tick too short",
+		},
+		{
+			name:    "snap quote cut to quote size",
+			packet:  packet(quotePacketSize, pb.TickMode_SNAP_QUOTE, 1, "2885"),
+			wantErr: "SNAP_QUOTE tick too short",
+		},
+		{
+			name:    "unknown exchange type",
+			packet:  packet(ltpPacketSize, pb.TickMode_LTP, 6, "2885"),
+			wantErr: "unknown exchange type 6",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tick, err := ParseTick(tt.packet)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseTick() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTick() error = %v", err)
+			}
+			tt.check(t, tick)
+		})
+	}
+}
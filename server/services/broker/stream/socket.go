@@ -0,0 +1,76 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// socket is the plumbing shared by Angel One's WebSocket feeds: serialized
+// writes, a text "ping" heartbeat and a single recorded close reason.
+type socket struct {
+	ws      *websocket.Conn
+	writeMu sync.Mutex
+	done    chan struct{}
+
+	closeOnce sync.Once
+	errMu     sync.Mutex
+	err       error
+}
+
+func newSocket(ws *websocket.Conn, heartbeatInterval time.Duration) *socket {
+	s := &socket{ws: ws, done: make(chan struct{})}
+	go s.heartbeat(heartbeatInterval)
+	return s
+}
+
+// Done is closed when the connection ends.
+func (s *socket) Done() <-chan struct{} { return s.done }
+
+// Err returns the reason the connection ended, or nil while it is open.
+func (s *socket) Err() error {
+	s.errMu.Lock()
+	defer s.errMu.Unlock()
+	return s.err
+}
+
+func (s *socket) Close() error {
+	s.fail(errors.New("connection closed"))
+	return nil
+}
+
+func (s *socket) write(messageType int, data []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.ws.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return s.ws.WriteMessage(messageType, data)
+}
+
+func (s *socket) heartbeat(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.write(websocket.TextMessage, []byte("ping")); err != nil {
+				s.fail(fmt.Errorf("heartbeat: %w", err))
+				return
+			}
+		}
+	}
+}
+
+func (s *socket) fail(err error) {
+	s.closeOnce.Do(func() {
+		s.errMu.Lock()
+		s.err = err
+		s.errMu.Unlock()
+		close(s.done)
+		s.ws.Close()
+	})
+}
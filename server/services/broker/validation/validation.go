@@ -0,0 +1,788 @@
+// Package validation checks order requests before they are sent to Angel One,
+// so malformed orders fail fast with field-level gRPC InvalidArgument errors
+// instead of a network round trip and a cryptic broker message.
+package validation
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"time"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+	angelone "github.com/Sagar-v4/Angel-Two/services/broker/angel-one"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Angel One order varieties
+const (
+	VarietyNormal   = "NORMAL"
+	VarietyStopLoss = "STOPLOSS"
+	VarietyAMO      = "AMO"
+	VarietyROBO     = "ROBO"
+)
+
+// Angel One order types
+const (
+	OrderTypeMarket         = "MARKET"
+	OrderTypeLimit          = "LIMIT"
+	OrderTypeStopLossLimit  = "STOPLOSS_LIMIT"
+	OrderTypeStopLossMarket = "STOPLOSS_MARKET"
+)
+
+// Angel One product types
+const (
+	ProductTypeDelivery     = "DELIVERY"
+	ProductTypeCarryForward = "CARRYFORWARD"
+	ProductTypeMargin       = "MARGIN"
+	ProductTypeIntraday     = "INTRADAY"
+	ProductTypeBO           = "BO" // Required for ROBO (bracket) orders
+)
+
+// Angel One transaction types and durations
+const (
+	TransactionTypeBuy  = "BUY"
+	TransactionTypeSell = "SELL"
+
+	DurationDay = "DAY"
+	DurationIOC = "IOC"
+)
+
+// Position types accepted by Angel One's position conversion
+const (
+	PositionTypeDay          = "DAY"
+	PositionTypeCarryForward = "CARRYFORWARD"
+)
+
+// GTT rule statuses accepted by Angel One's rule list
+const (
+	GTTStatusNew            = "NEW"
+	GTTStatusActive         = "ACTIVE"
+	GTTStatusSentToExchange = "SENTTOEXCHANGE"
+	GTTStatusCancelled      = "CANCELLED"
+	GTTStatusForAll         = "FORALL"
+)
+
+// MaxGTTTimePeriod is the longest a GTT rule can stay active, in days.
+const MaxGTTTimePeriod = 365
+
+var (
+	varieties        = []string{VarietyNormal, VarietyStopLoss, VarietyAMO, VarietyROBO}
+	orderTypes       = []string{OrderTypeMarket, OrderTypeLimit, OrderTypeStopLossLimit, OrderTypeStopLossMarket}
+	productTypes     = []string{ProductTypeDelivery, ProductTypeCarryForward, ProductTypeMargin, ProductTypeIntraday, ProductTypeBO}
+	durations        = []string{DurationDay, DurationIOC}
+	transactionTypes = []string{TransactionTypeBuy, TransactionTypeSell}
+	exchanges        = []string{"NSE", "BSE", "NFO", "BFO", "MCX", "CDS", "NCDEX"}
+	positionTypes    = []string{PositionTypeDay, PositionTypeCarryForward}
+	// BO positions are tied to their bracket order and cannot be converted.
+	convertibleProductTypes = []string{ProductTypeDelivery, ProductTypeCarryForward, ProductTypeMargin, ProductTypeIntraday}
+	gttProductTypes         = []string{ProductTypeDelivery, ProductTypeMargin}
+	gttStatuses             = []string{GTTStatusNew, GTTStatusActive, GTTStatusSentToExchange, GTTStatusCancelled, GTTStatusForAll}
+)
+
+// MaxMarginLegs is the most positions Angel One's margin calculator accepts in one call.
+const MaxMarginLegs = 50
+
+// MaxBasketLegs is the most orders one basket may place.
+const MaxBasketLegs = 20
+
+// InstrumentInfo holds the contract details orders are checked against.
+type InstrumentInfo struct {
+	LotSize  int32
+	TickSize float64 // In rupees
+}
+
+// InstrumentLookup returns the contract details of an instrument, or ok=false when it is unknown.
+type InstrumentLookup func(exchange, symboltoken string) (info InstrumentInfo, ok bool)
+
+// Validator validates order requests. Lot-size and tick-size multiples are
+// only checked when an InstrumentLookup is configured.
+type Validator struct {
+	lookup InstrumentLookup
+}
+
+func New(lookup InstrumentLookup) *Validator {
+	return &Validator{lookup: lookup}
+}
+
+// FieldError builds the same InvalidArgument error the validator returns, for
+// checks made outside this package (e.g. resolving symbols).
+func FieldError(what, field, format string, args ...interface{}) error {
+	var v violations
+	v.add(field, format, args...)
+	return v.err(what)
+}
+
+func (val *Validator) instrument(exchange, symboltoken string) (InstrumentInfo, bool) {
+	if val.lookup == nil || exchange == "" || symboltoken == "" {
+		return InstrumentInfo{}, false
+	}
+	return val.lookup(exchange, symboltoken)
+}
+
+// violations collects field-level problems for one request.
+type violations []*errdetails.BadRequest_FieldViolation
+
+func (v *violations) add(field, format string, args ...interface{}) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// err converts the collected violations into an InvalidArgument status, or nil if there are none.
+func (v violations) err(what string) error {
+	if len(v) == 0 {
+		return nil
+	}
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", what, v[0].Description))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (v *violations) requireOneOf(field, value string, allowed []string) {
+	if value == "" {
+		v.add(field, "%s is required", field)
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.add(field, "%s must be one of %v, got %q", field, allowed, value)
+}
+
+func (v *violations) requireNonEmpty(field, value string) {
+	if value == "" {
+		v.add(field, "%s is required", field)
+	}
+}
+
+// PlaceOrder validates enums, price/trigger consistency, quantities and the
+// fields required by each variety/order type combination.
+func (val *Validator) PlaceOrder(req *pb.PlaceOrderRequest) error {
+	return val.placeOrder(req).err("order")
+}
+
+// PlaceBasket validates every leg of a basket like PlaceOrder, with field
+// names qualified by the leg (e.g. legs[1].price).
+func (val *Validator) PlaceBasket(req *pb.PlaceBasketRequest) error {
+	var v violations
+
+	if len(req.Legs) == 0 {
+		v.add("legs", "at least one leg is required")
+	} else if len(req.Legs) > MaxBasketLegs {
+		v.add("legs", "at most %d legs are allowed, got %d", MaxBasketLegs, len(req.Legs))
+	}
+	if req.MaxParallel < 0 {
+		v.add("max_parallel", "max_parallel must not be negative")
+	}
+	for i, leg := range req.Legs {
+		if leg == nil {
+			v.add(fmt.Sprintf("legs[%d]", i), "leg is required")
+			continue
+		}
+		for _, fv := range val.placeOrder(leg) {
+			fv.Field = fmt.Sprintf("legs[%d].%s", i, fv.Field)
+			v = append(v, fv)
+		}
+	}
+
+	return v.err("basket")
+}
+
+func (val *Validator) placeOrder(req *pb.PlaceOrderRequest) violations {
+	var v violations
+
+	v.requireOneOf("variety", req.Variety, varieties)
+	v.requireOneOf("ordertype", req.Ordertype, orderTypes)
+	v.requireOneOf("producttype", req.Producttype, productTypes)
+	v.requireOneOf("duration", req.Duration, durations)
+	v.requireOneOf("transactiontype", req.Transactiontype, transactionTypes)
+	v.requireOneOf("exchange", req.Exchange, exchanges)
+	v.requireNonEmpty("tradingsymbol", req.Tradingsymbol)
+	v.requireNonEmpty("symboltoken", req.Symboltoken)
+
+	val.checkQuantity(&v, req.Exchange, req.Symboltoken, req.Quantity)
+	if req.Disclosedquantity < 0 || req.Disclosedquantity > req.Quantity {
+		v.add("disclosedquantity", "disclosedquantity must be between 0 and quantity (%d)", req.Quantity)
+	}
+
+	checkPrices(&v, req.Ordertype, req.Transactiontype, req.Price, req.Triggerprice)
+	val.checkTickSize(&v, req.Exchange, req.Symboltoken, fieldPrice{"price", req.Price}, fieldPrice{"triggerprice", req.Triggerprice})
+	if req.Marketprotection < 0 || req.Marketprotection > 100 {
+		v.add("marketprotection", "marketprotection must be a percentage between 0 and 100")
+	} else if req.Marketprotection != 0 && req.Ordertype != OrderTypeMarket && req.Ordertype != OrderTypeStopLossMarket {
+		v.add("marketprotection", "marketprotection is only allowed for market orders")
+	}
+	if len(req.Ordertag) > 20 {
+		v.add("ordertag", "ordertag must be at most 20 characters")
+	}
+
+	checkVariety(&v, req)
+
+	return v
+}
+
+// ModifyOrder validates the fields Angel One needs to modify an open order.
+func (val *Validator) ModifyOrder(req *pb.ModifyOrderRequest) error {
+	var v violations
+
+	v.requireNonEmpty("orderid", req.Orderid)
+	v.requireOneOf("variety", req.Variety, varieties)
+	v.requireOneOf("ordertype", req.Ordertype, orderTypes)
+	v.requireOneOf("producttype", req.Producttype, productTypes)
+	v.requireOneOf("duration", req.Duration, durations)
+	if req.Exchange != "" {
+		v.requireOneOf("exchange", req.Exchange, exchanges)
+	}
+
+	val.checkQuantity(&v, req.Exchange, req.Symboltoken, req.Quantity)
+	checkPrices(&v, req.Ordertype, "", req.Price, req.Triggerprice)
+	val.checkTickSize(&v, req.Exchange, req.Symboltoken, fieldPrice{"price", req.Price}, fieldPrice{"triggerprice", req.Triggerprice})
+
+	return v.err("order modification")
+}
+
+// CalculateMargin validates the legs of a margin calculation. Only the fields
+// the margin calculator uses are checked.
+func (val *Validator) CalculateMargin(req *pb.CalculateMarginRequest) error {
+	var v violations
+
+	if len(req.Legs) == 0 {
+		v.add("legs", "at least one leg is required")
+	} else if len(req.Legs) > MaxMarginLegs {
+		v.add("legs", "at most %d legs are allowed, got %d", MaxMarginLegs, len(req.Legs))
+	}
+	for i, leg := range req.Legs {
+		var lv violations
+		lv.requireOneOf("exchange", leg.Exchange, exchanges)
+		lv.requireNonEmpty("symboltoken", leg.Symboltoken)
+		lv.requireOneOf("transactiontype", leg.Transactiontype, transactionTypes)
+		lv.requireOneOf("ordertype", leg.Ordertype, orderTypes)
+		lv.requireOneOf("producttype", leg.Producttype, productTypes)
+		val.checkQuantity(&lv, leg.Exchange, leg.Symboltoken, leg.Quantity)
+		checkPrices(&lv, leg.Ordertype, leg.Transactiontype, leg.Price, leg.Triggerprice)
+		val.checkTickSize(&lv, leg.Exchange, leg.Symboltoken, fieldPrice{"price", leg.Price}, fieldPrice{"triggerprice", leg.Triggerprice})
+		for _, fv := range lv {
+			fv.Field = fmt.Sprintf("legs[%d].%s", i, fv.Field)
+			v = append(v, fv)
+		}
+	}
+
+	return v.err("margin request")
+}
+
+// CandleData validates a historical candle request. Long ranges are allowed;
+// the client splits them into windows Angel One accepts.
+func (val *Validator) CandleData(req *pb.GetCandleDataRequest) error {
+	var v violations
+
+	v.requireOneOf("exchange", req.Exchange, exchanges)
+	v.requireNonEmpty("symboltoken", req.Symboltoken)
+	if req.Interval == pb.CandleInterval_CANDLE_INTERVAL_UNSPECIFIED {
+		v.add("interval", "interval is required")
+	} else if _, ok := pb.CandleInterval_name[int32(req.Interval)]; !ok {
+		v.add("interval", "unknown interval %d", req.Interval)
+	}
+
+	from, fromErr := angelone.ParseCandleDate(req.Fromdate)
+	if fromErr != nil {
+		v.add("fromdate", "fromdate must be formatted as yyyy-MM-dd HH:mm, got %q", req.Fromdate)
+	}
+	to, toErr := angelone.ParseCandleDate(req.Todate)
+	if toErr != nil {
+		v.add("todate", "todate must be formatted as yyyy-MM-dd HH:mm, got %q", req.Todate)
+	}
+	if fromErr == nil && toErr == nil && !from.Before(to) {
+		v.add("todate", "todate must be after fromdate")
+	} else if fromErr == nil && toErr == nil {
+		if chunks := angelone.CandleChunks(req.Interval, from, to); chunks > angelone.MaxCandleChunks {
+			days := int(angelone.MaxCandleWindow(req.Interval) / (24 * time.Hour))
+			v.add("todate", "the range needs %d requests of at most %d days for %s candles; at most %d are allowed",
+				chunks, days, req.Interval, angelone.MaxCandleChunks)
+		}
+	}
+
+	return v.err("candle request")
+}
+
+// CreateGTT validates a new GTT rule. Both the trigger and the limit price of
+// the order placed when it fires are required.
+func (val *Validator) CreateGTT(req *pb.CreateGTTRequest) error {
+	var v violations
+
+	v.requireOneOf("exchange", req.Exchange, exchanges)
+	v.requireNonEmpty("tradingsymbol", req.Tradingsymbol)
+	v.requireNonEmpty("symboltoken", req.Symboltoken)
+	v.requireOneOf("transactiontype", req.Transactiontype, transactionTypes)
+	v.requireOneOf("producttype", req.Producttype, gttProductTypes)
+	val.checkGTTOrder(&v, req.Exchange, req.Symboltoken, req.Price, req.Triggerprice, req.Qty, req.Disclosedqty, req.Timeperiod)
+
+	return v.err("GTT rule")
+}
+
+// ModifyGTT validates a GTT rule modification after the rule's exchange and
+// symboltoken have been filled in.
+func (val *Validator) ModifyGTT(req *pb.ModifyGTTRequest) error {
+	var v violations
+
+	v.requireNonEmpty("id", req.Id)
+	v.requireOneOf("exchange", req.Exchange, exchanges)
+	v.requireNonEmpty("symboltoken", req.Symboltoken)
+	val.checkGTTOrder(&v, req.Exchange, req.Symboltoken, req.Price, req.Triggerprice, req.Qty, req.Disclosedqty, req.Timeperiod)
+
+	return v.err("GTT modification")
+}
+
+// ListGTT validates the status filter and paging of a rule list request.
+func (val *Validator) ListGTT(req *pb.ListGTTRequest) error {
+	var v violations
+
+	for i, st := range req.Status {
+		v.requireOneOf(fmt.Sprintf("status[%d]", i), st, gttStatuses)
+	}
+	if req.Page < 0 {
+		v.add("page", "page must not be negative")
+	}
+	if req.Count < 0 {
+		v.add("count", "count must not be negative")
+	}
+
+	return v.err("GTT list request")
+}
+
+func (val *Validator) checkGTTOrder(v *violations, exchange, symboltoken string, price, triggerPrice float64, qty, disclosedQty, timePeriod int32) {
+	if price <= 0 {
+		v.add("price", "price must be positive")
+	}
+	if triggerPrice <= 0 {
+		v.add("triggerprice", "triggerprice must be positive")
+	}
+	val.checkTickSize(v, exchange, symboltoken, fieldPrice{"price", price}, fieldPrice{"triggerprice", triggerPrice})
+
+	if qty <= 0 {
+		v.add("qty", "qty must be positive")
+	} else if info, ok := val.instrument(exchange, symboltoken); ok && info.LotSize > 1 && qty%info.LotSize != 0 {
+		v.add("qty", "qty must be a multiple of the lot size %d", info.LotSize)
+	}
+	if disclosedQty < 0 || disclosedQty > qty {
+		v.add("disclosedqty", "disclosedqty must be between 0 and qty (%d)", qty)
+	}
+	if timePeriod < 0 || timePeriod > MaxGTTTimePeriod {
+		v.add("timeperiod", "timeperiod must be between 1 and %d days (0 for the default)", MaxGTTTimePeriod)
+	}
+}
+
+// MaxTickInstruments is the most instruments one SmartWebSocket V2 session can subscribe to.
+const MaxTickInstruments = 1000
+
+// TickSubscription validates a market data subscription after tradingsymbols
+// have been resolved to tokens.
+func (val *Validator) TickSubscription(req *pb.SubscribeTicksRequest) error {
+	var v violations
+
+	if req.Mode == pb.TickMode_TICK_MODE_UNSPECIFIED {
+		v.add("mode", "mode is required")
+	} else if _, ok := pb.TickMode_name[int32(req.Mode)]; !ok {
+		v.add("mode", "unknown mode %d", req.Mode)
+	}
+	if len(req.Instruments) == 0 {
+		v.add("instruments", "at least one instrument is required")
+	} else if len(req.Instruments) > MaxTickInstruments {
+		v.add("instruments", "at most %d instruments are allowed, got %d", MaxTickInstruments, len(req.Instruments))
+	}
+	for i, inst := range req.Instruments {
+		var iv violations
+		iv.requireOneOf("exchange", inst.Exchange, exchanges)
+		iv.requireNonEmpty("symboltoken", inst.Symboltoken)
+		for _, fv := range iv {
+			fv.Field = fmt.Sprintf("instruments[%d].%s", i, fv.Field)
+			v = append(v, fv)
+		}
+	}
+
+	return v.err("tick subscription")
+}
+
+// MaxRuleConditions is the most conditions one conditional order rule may combine.
+const MaxRuleConditions = 8
+
+// TimeLayout is the format of TIME_AFTER and TIME_BEFORE conditions.
+const TimeLayout = "15:04"
+
+// CreateAlertRule validates a conditional order rule after the watched
+// instrument has been defaulted and the reference price looked up. The stored
+// order is checked like PlaceOrder, with fields prefixed by rule.order.
+func (val *Validator) CreateAlertRule(req *pb.CreateAlertRuleRequest) error {
+	var v violations
+
+	rule := req.Rule
+	if rule == nil {
+		v.add("rule", "rule is required")
+		return v.err("rule")
+	}
+	v.requireOneOf("rule.exchange", rule.Exchange, exchanges)
+	v.requireNonEmpty("rule.symboltoken", rule.Symboltoken)
+	if len(rule.Conditions) == 0 {
+		v.add("rule.conditions", "at least one condition is required")
+	} else if len(rule.Conditions) > MaxRuleConditions {
+		v.add("rule.conditions", "at most %d conditions are allowed, got %d", MaxRuleConditions, len(rule.Conditions))
+	}
+	percent := false
+	for i, cond := range rule.Conditions {
+		field := fmt.Sprintf("rule.conditions[%d]", i)
+		switch cond.Type {
+		case pb.AlertConditionType_PRICE_ABOVE, pb.AlertConditionType_PRICE_BELOW:
+			if cond.Value <= 0 {
+				v.add(field+".value", "%s needs a positive price", cond.Type)
+			}
+		case pb.AlertConditionType_PERCENT_CHANGE_ABOVE, pb.AlertConditionType_PERCENT_CHANGE_BELOW:
+			if cond.Value == 0 || math.IsNaN(cond.Value) {
+				v.add(field+".value", "%s needs a non-zero percentage", cond.Type)
+			}
+			percent = true
+		case pb.AlertConditionType_TIME_AFTER, pb.AlertConditionType_TIME_BEFORE:
+			if _, err := time.Parse(TimeLayout, cond.Time); err != nil {
+				v.add(field+".time", "%s needs a time of day as HH:MM, got %q", cond.Type, cond.Time)
+			}
+		default:
+			v.add(field+".type", "unknown condition type %s", cond.Type)
+		}
+	}
+	if percent && rule.ReferencePrice <= 0 {
+		v.add("rule.reference_price", "reference_price must be positive for percent change conditions")
+	}
+
+	if rule.Order == nil {
+		v.add("rule.order", "order is required")
+	} else {
+		for _, fv := range val.placeOrder(rule.Order) {
+			fv.Field = "rule.order." + fv.Field
+			v = append(v, fv)
+		}
+	}
+
+	return v.err("rule")
+}
+
+// MaxAlertNoteLength caps the free-text note sent with a price alert.
+const MaxAlertNoteLength = 500
+
+// CreatePriceAlert validates a price alert after its instrument has been
+// resolved.
+func (val *Validator) CreatePriceAlert(req *pb.CreatePriceAlertRequest) error {
+	var v violations
+
+	alert := req.Alert
+	if alert == nil {
+		v.add("alert", "alert is required")
+		return v.err("price alert")
+	}
+	v.requireOneOf("alert.exchange", alert.Exchange, exchanges)
+	v.requireNonEmpty("alert.symboltoken", alert.Symboltoken)
+	checkPriceAlertCondition(&v, "alert.condition", alert.Condition, true)
+	if len(alert.Note) > MaxAlertNoteLength {
+		v.add("alert.note", "note must be at most %d characters", MaxAlertNoteLength)
+	}
+
+	return v.err("price alert")
+}
+
+// UpdatePriceAlert validates a price alert edit; the condition is optional.
+func (val *Validator) UpdatePriceAlert(req *pb.UpdatePriceAlertRequest) error {
+	var v violations
+
+	v.requireNonEmpty("id", req.Id)
+	checkPriceAlertCondition(&v, "condition", req.Condition, false)
+	if len(req.Note) > MaxAlertNoteLength {
+		v.add("note", "note must be at most %d characters", MaxAlertNoteLength)
+	}
+
+	return v.err("price alert")
+}
+
+// checkPriceAlertCondition allows the price and percent change conditions;
+// time conditions only make sense for conditional orders.
+func checkPriceAlertCondition(v *violations, field string, cond *pb.AlertCondition, required bool) {
+	if cond == nil {
+		if required {
+			v.add(field, "condition is required")
+		}
+		return
+	}
+	switch cond.Type {
+	case pb.AlertConditionType_PRICE_ABOVE, pb.AlertConditionType_PRICE_BELOW:
+		if cond.Value <= 0 {
+			v.add(field+".value", "%s needs a positive price", cond.Type)
+		}
+	case pb.AlertConditionType_PERCENT_CHANGE_ABOVE, pb.AlertConditionType_PERCENT_CHANGE_BELOW:
+		if cond.Value == 0 || math.IsNaN(cond.Value) {
+			v.add(field+".value", "%s needs a non-zero percentage", cond.Type)
+		}
+	default:
+		v.add(field+".type", "price alerts support PRICE_ABOVE, PRICE_BELOW, PERCENT_CHANGE_ABOVE and PERCENT_CHANGE_BELOW, got %s", cond.Type)
+	}
+}
+
+// CreateAlertWebhook checks the webhook is an absolute http(s) URL with a
+// host. Whether the host is public is checked by the alert monitor, which
+// resolves it.
+func (val *Validator) CreateAlertWebhook(req *pb.CreateAlertWebhookRequest) error {
+	var v violations
+
+	u, err := url.Parse(req.Url)
+	switch {
+	case req.Url == "":
+		v.add("url", "url is required")
+	case len(req.Url) > 2048:
+		v.add("url", "url must be at most 2048 characters")
+	case err != nil:
+		v.add("url", "url is not valid: %v", err)
+	case u.Scheme != "http" && u.Scheme != "https":
+		v.add("url", "url must be http or https, got %q", u.Scheme)
+	case u.Hostname() == "":
+		v.add("url", "url must include a host")
+	}
+
+	return v.err("webhook")
+}
+
+// PlaceBracketOrder validates an emulated bracket: a delivery BUY entry with
+// a target above and a stop-loss below it. The entry is checked like
+// PlaceOrder, with fields prefixed by entry.
+func (val *Validator) PlaceBracketOrder(req *pb.PlaceBracketOrderRequest) error {
+	var v violations
+
+	entry := req.Entry
+	if entry == nil {
+		v.add("entry", "entry is required")
+		return v.err("bracket order")
+	}
+	for _, fv := range val.placeOrder(entry) {
+		fv.Field = "entry." + fv.Field
+		v = append(v, fv)
+	}
+	if entry.Variety != VarietyNormal {
+		v.add("entry.variety", "bracket entries must be %s orders", VarietyNormal)
+	}
+	if entry.Producttype != ProductTypeDelivery {
+		v.add("entry.producttype", "bracket orders are emulated for %s only; use ROBO for intraday", ProductTypeDelivery)
+	}
+	if entry.Transactiontype != TransactionTypeBuy {
+		v.add("entry.transactiontype", "bracket entries must be %s orders", TransactionTypeBuy)
+	}
+	if entry.Exchange != "NSE" && entry.Exchange != "BSE" {
+		v.add("entry.exchange", "bracket orders are for NSE or BSE equity, got %q", entry.Exchange)
+	}
+	if entry.Ordertype != OrderTypeMarket && entry.Ordertype != OrderTypeLimit {
+		v.add("entry.ordertype", "bracket entries must be %s or %s orders", OrderTypeMarket, OrderTypeLimit)
+	}
+
+	if req.TargetPrice <= 0 || math.IsNaN(req.TargetPrice) {
+		v.add("target_price", "target_price must be positive")
+	}
+	if req.StoplossTriggerprice <= 0 || math.IsNaN(req.StoplossTriggerprice) {
+		v.add("stoploss_triggerprice", "stoploss_triggerprice must be positive")
+	} else if req.StoplossTriggerprice >= req.TargetPrice {
+		v.add("stoploss_triggerprice", "stoploss_triggerprice (%.2f) must be below target_price (%.2f)", req.StoplossTriggerprice, req.TargetPrice)
+	}
+	if req.StoplossPrice < 0 || req.StoplossPrice > req.StoplossTriggerprice {
+		v.add("stoploss_price", "stoploss_price must be between 0 (a STOPLOSS_MARKET exit) and stoploss_triggerprice")
+	}
+	if entry.Ordertype == OrderTypeLimit && entry.Price > 0 {
+		if req.TargetPrice > 0 && req.TargetPrice <= entry.Price {
+			v.add("target_price", "target_price (%.2f) must be above the entry price (%.2f)", req.TargetPrice, entry.Price)
+		}
+		if req.StoplossTriggerprice >= entry.Price {
+			v.add("stoploss_triggerprice", "stoploss_triggerprice (%.2f) must be below the entry price (%.2f)", req.StoplossTriggerprice, entry.Price)
+		}
+	}
+	val.checkTickSize(&v, entry.Exchange, entry.Symboltoken,
+		fieldPrice{"target_price", req.TargetPrice},
+		fieldPrice{"stoploss_triggerprice", req.StoplossTriggerprice},
+		fieldPrice{"stoploss_price", req.StoplossPrice})
+
+	return v.err("bracket order")
+}
+
+// ConvertPosition validates a position conversion request on its own. The
+// quantity is checked against the open position by ConvertPositionQuantity.
+func (val *Validator) ConvertPosition(req *pb.ConvertPositionRequest) error {
+	var v violations
+
+	v.requireOneOf("exchange", req.Exchange, exchanges)
+	v.requireNonEmpty("symboltoken", req.Symboltoken)
+	v.requireOneOf("oldproducttype", req.Oldproducttype, convertibleProductTypes)
+	v.requireOneOf("newproducttype", req.Newproducttype, convertibleProductTypes)
+	if req.Oldproducttype != "" && req.Oldproducttype == req.Newproducttype {
+		v.add("newproducttype", "newproducttype must differ from oldproducttype")
+	}
+	v.requireOneOf("type", req.Type, positionTypes)
+	if req.Transactiontype != "" {
+		v.requireOneOf("transactiontype", req.Transactiontype, transactionTypes)
+	}
+	if req.Quantity <= 0 {
+		v.add("quantity", "quantity must be positive")
+	}
+
+	return v.err("position conversion")
+}
+
+// ConvertPositionQuantity checks the conversion against the open quantity of
+// the position (positive for long, negative for short, zero when there is none).
+func (val *Validator) ConvertPositionQuantity(req *pb.ConvertPositionRequest, openQty int64) error {
+	var v violations
+
+	switch {
+	case openQty == 0:
+		v.add("symboltoken", "no open %s %s position for %s in %s", req.Type, req.Oldproducttype, req.Symboltoken, req.Exchange)
+	case openQty > 0 && req.Transactiontype == TransactionTypeSell:
+		v.add("transactiontype", "transactiontype must be %s for a long position", TransactionTypeBuy)
+	case openQty < 0 && req.Transactiontype == TransactionTypeBuy:
+		v.add("transactiontype", "transactiontype must be %s for a short position", TransactionTypeSell)
+	case int64(req.Quantity) > abs(openQty):
+		v.add("quantity", "quantity (%d) exceeds the open position (%d)", req.Quantity, abs(openQty))
+	}
+
+	return v.err("position conversion")
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func (val *Validator) checkQuantity(v *violations, exchange, symboltoken string, quantity int32) {
+	if quantity <= 0 {
+		v.add("quantity", "quantity must be positive")
+		return
+	}
+	if info, ok := val.instrument(exchange, symboltoken); ok && info.LotSize > 1 && quantity%info.LotSize != 0 {
+		v.add("quantity", "quantity must be a multiple of the lot size %d", info.LotSize)
+	}
+}
+
+// fieldPrice is a price of a request and the field it is reported under.
+type fieldPrice struct {
+	field string
+	price float64
+}
+
+// checkTickSize requires prices to be whole multiples of the instrument's tick
+// size. Prices of 0 are not set and skipped.
+func (val *Validator) checkTickSize(v *violations, exchange, symboltoken string, prices ...fieldPrice) {
+	info, ok := val.instrument(exchange, symboltoken)
+	if !ok || info.TickSize <= 0 {
+		return
+	}
+	for _, p := range prices {
+		ticks := p.price / info.TickSize
+		if p.price > 0 && math.Abs(ticks-math.Round(ticks)) >= 1e-6 {
+			v.add(p.field, "%s (%g) must be a multiple of the tick size %g", p.field, p.price, info.TickSize)
+		}
+	}
+}
+
+// checkPrices enforces price and trigger price consistency for the order type.
+// transactionType may be empty when it is not known (e.g. for modifications).
+func checkPrices(v *violations, orderType, transactionType string, price, triggerPrice float64) {
+	if price < 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		v.add("price", "price must be a non-negative number")
+		return
+	}
+	if triggerPrice < 0 || math.IsNaN(triggerPrice) || math.IsInf(triggerPrice, 0) {
+		v.add("triggerprice", "triggerprice must be a non-negative number")
+		return
+	}
+
+	switch orderType {
+	case OrderTypeMarket:
+		if triggerPrice != 0 {
+			v.add("triggerprice", "triggerprice is not allowed for %s orders", orderType)
+		}
+	case OrderTypeLimit:
+		if price == 0 {
+			v.add("price", "price is required for %s orders", orderType)
+		}
+		if triggerPrice != 0 {
+			v.add("triggerprice", "triggerprice is not allowed for %s orders", orderType)
+		}
+	case OrderTypeStopLossMarket:
+		if triggerPrice == 0 {
+			v.add("triggerprice", "triggerprice is required for %s orders", orderType)
+		}
+	case OrderTypeStopLossLimit:
+		if price == 0 {
+			v.add("price", "price is required for %s orders", orderType)
+		}
+		if triggerPrice == 0 {
+			v.add("triggerprice", "triggerprice is required for %s orders", orderType)
+			return
+		}
+		// A stop-loss buy triggers on the way up and a sell on the way down,
+		// so the trigger must not be beyond the limit price.
+		if transactionType == TransactionTypeBuy && triggerPrice > price {
+			v.add("triggerprice", "triggerprice (%.2f) must not be above price (%.2f) for a BUY %s order", triggerPrice, price, orderType)
+		}
+		if transactionType == TransactionTypeSell && triggerPrice < price {
+			v.add("triggerprice", "triggerprice (%.2f) must not be below price (%.2f) for a SELL %s order", triggerPrice, price, orderType)
+		}
+	}
+}
+
+// checkVariety enforces the fields required or forbidden by each variety.
+func checkVariety(v *violations, req *pb.PlaceOrderRequest) {
+	isStopLossType := req.Ordertype == OrderTypeStopLossLimit || req.Ordertype == OrderTypeStopLossMarket
+
+	switch req.Variety {
+	case VarietyStopLoss:
+		if !isStopLossType {
+			v.add("ordertype", "%s variety requires ordertype %s or %s", VarietyStopLoss, OrderTypeStopLossLimit, OrderTypeStopLossMarket)
+		}
+	case VarietyROBO:
+		if req.Producttype != ProductTypeBO {
+			v.add("producttype", "%s variety requires producttype %s", VarietyROBO, ProductTypeBO)
+		}
+		if req.Ordertype != OrderTypeLimit && req.Ordertype != OrderTypeMarket {
+			v.add("ordertype", "%s variety requires ordertype %s or %s", VarietyROBO, OrderTypeLimit, OrderTypeMarket)
+		}
+		if req.Squareoff <= 0 {
+			v.add("squareoff", "squareoff is required for %s orders", VarietyROBO)
+		}
+		if req.Stoploss <= 0 {
+			v.add("stoploss", "stoploss is required for %s orders", VarietyROBO)
+		}
+		if req.Trailingstoploss < 0 {
+			v.add("trailingstoploss", "trailingstoploss must not be negative")
+		}
+	case VarietyNormal, VarietyAMO:
+		if isStopLossType {
+			v.add("variety", "%s orders must use the %s variety", req.Ordertype, VarietyStopLoss)
+		}
+	}
+
+	if req.Variety != VarietyROBO {
+		if req.Squareoff != 0 {
+			v.add("squareoff", "squareoff is only allowed for %s orders", VarietyROBO)
+		}
+		if req.Stoploss != 0 {
+			v.add("stoploss", "stoploss is only allowed for %s orders", VarietyROBO)
+		}
+		if req.Trailingstoploss != 0 {
+			v.add("trailingstoploss", "trailingstoploss is only allowed for %s orders", VarietyROBO)
+		}
+		if req.Producttype == ProductTypeBO {
+			v.add("producttype", "producttype %s is only allowed for %s orders", ProductTypeBO, VarietyROBO)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package validation
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/Sagar-v4/Angel-Two/protobuf/gen/broker"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// violated returns the fields of the violations carried by err, in order.
+func violated(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("error = %v, want an InvalidArgument status", err)
+	}
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, fv := range badRequest.FieldViolations {
+				fields = append(fields, fv.Field)
+			}
+		}
+	}
+	if len(fields) == 0 {
+		t.Fatalf("error %v carries no field violations", err)
+	}
+	return fields
+}
+
+func limitBuy() *pb.PlaceOrderRequest {
+	return &pb.PlaceOrderRequest{
+		Variety:         VarietyNormal,
+		Tradingsymbol:   "SBIN-EQ",
+		Symboltoken:     "3045",
+		Transactiontype: TransactionTypeBuy,
+		Exchange:        "NSE",
+		Ordertype:       OrderTypeLimit,
+		Producttype:     ProductTypeDelivery,
+		Duration:        DurationDay,
+		Price:           800.05,
+		Quantity:        10,
+	}
+}
+
+// lookup knows SBIN with a tick of 5 paise and a NIFTY future with a lot of 75.
+func lookup(exchange, symboltoken string) (InstrumentInfo, bool) {
+	switch exchange + ":" + symboltoken {
+	case "NSE:3045":
+		return InstrumentInfo{LotSize: 1, TickSize: 0.05}, true
+	case "NFO:35001":
+		return InstrumentInfo{LotSize: 75, TickSize: 0.1}, true
+	}
+	return InstrumentInfo{}, false
+}
+
+func TestPlaceOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(req *pb.PlaceOrderRequest)
+		want   []string // Fields with violations, in order
+	}{
+		{name: "valid limit order", modify: func(req *pb.PlaceOrderRequest) {}},
+		{name: "valid market order", modify: func(req *pb.PlaceOrderRequest) {
+			req.Ordertype, req.Price = OrderTypeMarket, 0
+		}},
+		{name: "missing enums", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety, req.Ordertype, req.Producttype, req.Duration, req.Transactiontype, req.Exchange = "", "", "", "", "", ""
+		}, want: []string{"variety", "ordertype", "producttype", "duration", "transactiontype", "exchange"}},
+		{name: "unknown enums", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety, req.Duration, req.Exchange = "normal", "GTC", "NYSE"
+		}, want: []string{"variety", "duration", "exchange"}},
+		{name: "missing symbol", modify: func(req *pb.PlaceOrderRequest) {
+			req.Tradingsymbol, req.Symboltoken = "", ""
+		}, want: []string{"tradingsymbol", "symboltoken"}},
+		{name: "zero quantity", modify: func(req *pb.PlaceOrderRequest) { req.Quantity = 0 }, want: []string{"quantity"}},
+		{name: "disclosed above quantity", modify: func(req *pb.PlaceOrderRequest) { req.Disclosedquantity = 11 }, want: []string{"disclosedquantity"}},
+		{name: "limit without price", modify: func(req *pb.PlaceOrderRequest) { req.Price = 0 }, want: []string{"price"}},
+		{name: "limit with trigger", modify: func(req *pb.PlaceOrderRequest) { req.Triggerprice = 799 }, want: []string{"triggerprice"}},
+		{name: "market with trigger", modify: func(req *pb.PlaceOrderRequest) {
+			req.Ordertype, req.Price, req.Triggerprice = OrderTypeMarket, 0, 799
+		}, want: []string{"triggerprice"}},
+		{name: "negative price", modify: func(req *pb.PlaceOrderRequest) { req.Price = -1 }, want: []string{"price"}},
+		{name: "NaN trigger", modify: func(req *pb.PlaceOrderRequest) { req.Triggerprice = math.NaN() }, want: []string{"triggerprice"}},
+		{name: "stop-loss limit in the normal variety", modify: func(req *pb.PlaceOrderRequest) {
+			req.Ordertype, req.Triggerprice = OrderTypeStopLossLimit, 800
+		}, want: []string{"variety"}},
+		{name: "stop-loss variety with a limit order", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety = VarietyStopLoss
+		}, want: []string{"ordertype"}},
+		{name: "stop-loss market without trigger", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety, req.Ordertype, req.Price = VarietyStopLoss, OrderTypeStopLossMarket, 0
+		}, want: []string{"triggerprice"}},
+		{name: "stop-loss limit buy triggering above price", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety, req.Ordertype, req.Triggerprice = VarietyStopLoss, OrderTypeStopLossLimit, 801
+		}, want: []string{"triggerprice"}},
+		{name: "stop-loss limit sell triggering below price", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety, req.Ordertype, req.Transactiontype, req.Triggerprice = VarietyStopLoss, OrderTypeStopLossLimit, TransactionTypeSell, 799
+		}, want: []string{"triggerprice"}},
+		{name: "valid stop-loss limit sell", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety, req.Ordertype, req.Transactiontype, req.Triggerprice = VarietyStopLoss, OrderTypeStopLossLimit, TransactionTypeSell, 801
+		}},
+		{name: "robo without its legs", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety, req.Producttype = VarietyROBO, ProductTypeBO
+		}, want: []string{"squareoff", "stoploss"}},
+		{name: "robo with another product", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety, req.Squareoff, req.Stoploss = VarietyROBO, 10, 5
+		}, want: []string{"producttype"}},
+		{name: "robo fields on a normal order", modify: func(req *pb.PlaceOrderRequest) {
+			req.Squareoff, req.Stoploss, req.Trailingstoploss = 10, 5, 1
+		}, want: []string{"squareoff", "stoploss", "trailingstoploss"}},
+		{name: "market protection on a limit order", modify: func(req *pb.PlaceOrderRequest) { req.Marketprotection = 5 }, want: []string{"marketprotection"}},
+		{name: "long ordertag", modify: func(req *pb.PlaceOrderRequest) { req.Ordertag = strings.Repeat("x", 21) }, want: []string{"ordertag"}},
+		{name: "price off the tick", modify: func(req *pb.PlaceOrderRequest) { req.Price = 800.03 }, want: []string{"price"}},
+		{name: "trigger off the tick", modify: func(req *pb.PlaceOrderRequest) {
+			req.Variety, req.Ordertype, req.Triggerprice = VarietyStopLoss, OrderTypeStopLossLimit, 800.01
+		}, want: []string{"triggerprice"}},
+		{name: "lot size multiple", modify: func(req *pb.PlaceOrderRequest) {
+			req.Exchange, req.Symboltoken, req.Producttype, req.Price, req.Quantity = "NFO", "35001", ProductTypeCarryForward, 22000.1, 150
+		}},
+		{name: "not a lot size multiple", modify: func(req *pb.PlaceOrderRequest) {
+			req.Exchange, req.Symboltoken, req.Producttype, req.Price, req.Quantity = "NFO", "35001", ProductTypeCarryForward, 22000.1, 100
+		}, want: []string{"quantity"}},
+		{name: "unknown instrument skips lot and tick checks", modify: func(req *pb.PlaceOrderRequest) {
+			req.Symboltoken, req.Price, req.Quantity = "99999", 800.03, 7
+		}},
+	}
+
+	val := New(lookup)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := limitBuy()
+			tt.modify(req)
+			if got := violated(t, val.PlaceOrder(req)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlaceOrder() violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaceOrderWithoutLookup(t *testing.T) {
+	req := limitBuy()
+	req.Price = 800.03
+	if err := New(nil).PlaceOrder(req); err != nil {
+		t.Errorf("PlaceOrder() = %v, want tick sizes unchecked without a lookup", err)
+	}
+}
+
+func TestPlaceOrderStatus(t *testing.T) {
+	req := limitBuy()
+	req.Price, req.Quantity = 0, -5
+	st, _ := status.FromError(New(lookup).PlaceOrder(req))
+
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", st.Code())
+	}
+	if want := "invalid order: quantity must be positive"; st.Message() != want {
+		t.Errorf("message = %q, want %q", st.Message(), want)
+	}
+	details := st.Details()
+	if len(details) != 1 {
+		t.Fatalf("details = %v, want one BadRequest", details)
+	}
+	badRequest, ok := details[0].(*errdetails.BadRequest)
+	if !ok {
+		t.Fatalf("detail = %T, want *errdetails.BadRequest", details[0])
+	}
+	want := []*errdetails.BadRequest_FieldViolation{
+		{Field: "quantity", Description: "quantity must be positive"},
+		{Field: "disclosedquantity", Description: "disclosedquantity must be between 0 and quantity (-5)"},
+		{Field: "price", Description: "price is required for LIMIT orders"},
+	}
+	if len(badRequest.FieldViolations) != len(want) {
+		t.Fatalf("violations = %v, want %v", badRequest.FieldViolations, want)
+	}
+	for i, fv := range badRequest.FieldViolations {
+		if fv.Field != want[i].Field || fv.Description != want[i].Description {
+			t.Errorf("violation %d = %s: %q, want %s: %q", i, fv.Field, fv.Description, want[i].Field, want[i].Description)
+		}
+	}
+}
+
+func TestModifyOrder(t *testing.T) {
+	valid := func() *pb.ModifyOrderRequest {
+		return &pb.ModifyOrderRequest{
+			Orderid:     "201020000000080",
+			Variety:     VarietyNormal,
+			Ordertype:   OrderTypeLimit,
+			Producttype: ProductTypeDelivery,
+			Duration:    DurationDay,
+			Price:       800.05,
+			Quantity:    10,
+			Exchange:    "NSE",
+			Symboltoken: "3045",
+		}
+	}
+	tests := []struct {
+		name   string
+		modify func(req *pb.ModifyOrderRequest)
+		want   []string
+	}{
+		{name: "valid", modify: func(req *pb.ModifyOrderRequest) {}},
+		{name: "exchange is optional", modify: func(req *pb.ModifyOrderRequest) { req.Exchange, req.Price = "", 800.03 }},
+		{name: "missing orderid", modify: func(req *pb.ModifyOrderRequest) { req.Orderid = "" }, want: []string{"orderid"}},
+		{name: "unknown exchange", modify: func(req *pb.ModifyOrderRequest) { req.Exchange = "LSE" }, want: []string{"exchange"}},
+		{name: "stop-loss limit without trigger", modify: func(req *pb.ModifyOrderRequest) {
+			req.Ordertype = OrderTypeStopLossLimit
+		}, want: []string{"triggerprice"}},
+		{name: "price off the tick", modify: func(req *pb.ModifyOrderRequest) { req.Price = 800.02 }, want: []string{"price"}},
+	}
+
+	val := New(lookup)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(req)
+			if got := violated(t, val.ModifyOrder(req)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ModifyOrder() violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldError(t *testing.T) {
+	err := FieldError("order", "tradingsymbol", "unknown tradingsymbol %q", "NOPE")
+	if got := violated(t, err); !reflect.DeepEqual(got, []string{"tradingsymbol"}) {
+		t.Errorf("FieldError() violations = %v", got)
+	}
+	if st, _ := status.FromError(err); st.Message() != `invalid order: unknown tradingsymbol "NOPE"` {
+		t.Errorf("FieldError() message = %q", st.Message())
+	}
+}